@@ -2,13 +2,19 @@ package cmd
 
 import (
 	"context"
+	"playbook-dispatcher/internal/common/callback"
 	"playbook-dispatcher/internal/common/config"
 	"playbook-dispatcher/internal/common/db"
 	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/notify"
+	"playbook-dispatcher/internal/common/outbox"
 	"playbook-dispatcher/internal/common/utils"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func clean(cmd *cobra.Command, args []string) error {
@@ -27,8 +33,8 @@ func clean(cmd *cobra.Command, args []string) error {
 
 		result := tx.Model(&dbModel.Run{}).
 			Where("runs.status", "running").
-			Where("runs.created_at + runs.timeout * interval '1 second' <= NOW()").
-			Select("id", "org_id", "correlation_id", "recipient").
+			Where("COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW()").
+			Select("id", "org_id", "correlation_id", "recipient", "service", "labels").
 			Find(&dbRuns)
 
 		if result.Error != nil {
@@ -41,9 +47,15 @@ func clean(cmd *cobra.Command, args []string) error {
 		}
 
 		ids := make([]string, len(dbRuns))
+		runIds := make([]uuid.UUID, len(dbRuns))
+		changes := make([]notify.StatusChange, len(dbRuns))
+		runsById := make(map[uuid.UUID]dbModel.Run, len(dbRuns))
 		for i, run := range dbRuns {
 			log.Infow("Updating timed-out run", "run_id", run.ID.String(), "org_id", run.OrgID, "correlation_id", run.CorrelationID.String(), "recipient", run.Recipient.String())
 			ids[i] = run.ID.String()
+			runIds[i] = run.ID
+			changes[i] = newTimeoutStatusChange(run, notify.TypeRun)
+			runsById[run.ID] = run
 		}
 
 		result = tx.Model(&dbModel.Run{}).
@@ -62,13 +74,38 @@ func clean(cmd *cobra.Command, args []string) error {
 			Where("runs.status", "timeout").
 			Where("run_hosts.status", "running")
 
-		result = tx.Model(&dbModel.RunHost{}).
+		var dbRunHosts []dbModel.RunHost
+
+		result = tx.Model(&dbRunHosts).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "run_id"}, {Name: "host"}, {Name: "inventory_id"}}}).
 			Where("run_hosts.id IN (?)", subQuery).
 			Update("status", "timeout")
 
 		log.Infow("Finished updating timed-out run_hosts", "rowCount", result.RowsAffected)
 
-		return result.Error
+		if result.Error != nil {
+			return result.Error
+		}
+
+		for _, runHost := range dbRunHosts {
+			// hosts of runs that timed out in an earlier cleanup have already been reported
+			if run, ok := runsById[runHost.RunID]; ok {
+				change := newTimeoutStatusChange(run, notify.TypeRunHost)
+				change.Host = &runHost.Host
+				change.InventoryID = runHost.InventoryID
+				changes = append(changes, change)
+			}
+		}
+
+		if _, err := outbox.Abandon(tx, dbModel.RunStatusTimeout, runIds...); err != nil {
+			return err
+		}
+
+		if err := callback.Enqueue(ctx, tx, runIds...); err != nil {
+			return err
+		}
+
+		return notify.Publish(ctx, tx, changes...)
 	})
 
 	if err != nil {
@@ -77,3 +114,16 @@ func clean(cmd *cobra.Command, args []string) error {
 
 	return err
 }
+
+func newTimeoutStatusChange(run dbModel.Run, changeType string) notify.StatusChange {
+	return notify.StatusChange{
+		Type:      changeType,
+		RunID:     run.ID,
+		OrgID:     run.OrgID,
+		Service:   run.Service,
+		Recipient: run.Recipient,
+		Labels:    run.Labels,
+		Status:    dbModel.RunStatusTimeout,
+		Timestamp: time.Now(),
+	}
+}
@@ -17,6 +17,10 @@ const (
 	moduleApi              = "api"
 	moduleResponseConsumer = "response-consumer"
 	moduleValidator        = "validator"
+	moduleCallbackWorker   = "callback-worker"
+	moduleScheduler        = "scheduler"
+	moduleOutboxRelay      = "outbox-relay"
+	moduleSimulator        = "simulator"
 )
 
 func init() {
@@ -30,7 +34,7 @@ func init() {
 		},
 	}
 
-	runCommand.Flags().StringSliceP("module", "m", []string{moduleApi, moduleResponseConsumer, moduleValidator}, "module(s) to run")
+	runCommand.Flags().StringSliceP("module", "m", []string{moduleApi, moduleResponseConsumer, moduleValidator, moduleCallbackWorker, moduleScheduler, moduleOutboxRelay}, "module(s) to run")
 	rootCmd.AddCommand(runCommand)
 
 	migrateCmd := &cobra.Command{
@@ -6,11 +6,15 @@ import (
 	"os"
 	"os/signal"
 	"playbook-dispatcher/internal/api"
+	callbackWorker "playbook-dispatcher/internal/callback-worker"
 	"playbook-dispatcher/internal/common/config"
 	"playbook-dispatcher/internal/common/kessel"
 	"playbook-dispatcher/internal/common/unleash"
 	"playbook-dispatcher/internal/common/utils"
+	outboxRelay "playbook-dispatcher/internal/outbox-relay"
 	responseConsumer "playbook-dispatcher/internal/response-consumer"
+	"playbook-dispatcher/internal/scheduler"
+	"playbook-dispatcher/internal/simulator"
 	"playbook-dispatcher/internal/validator"
 	"sync"
 	"syscall"
@@ -116,6 +120,14 @@ func run(cmd *cobra.Command, args []string) error {
 			startModule = responseConsumer.Start
 		case moduleValidator:
 			startModule = validator.Start
+		case moduleCallbackWorker:
+			startModule = callbackWorker.Start
+		case moduleScheduler:
+			startModule = scheduler.Start
+		case moduleOutboxRelay:
+			startModule = outboxRelay.Start
+		case moduleSimulator:
+			startModule = simulator.Start
 		default:
 			return fmt.Errorf("Unknown module %s", module)
 		}
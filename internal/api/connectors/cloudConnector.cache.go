@@ -0,0 +1,187 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+const connectionStatusCacheKeyPrefix = "playbook-dispatcher:connection-status"
+
+var connectionStatusCacheTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "cloud_connector_status_cache_total",
+	Help: "The total number of connection status lookups by cache result",
+}, []string{"result"})
+
+// ConnectionStatusCache stores the connection status of recipients for a limited time
+type ConnectionStatusCache interface {
+	Get(ctx context.Context, key string) (status ConnectionStatus, found bool, err error)
+	Set(ctx context.Context, key string, status ConnectionStatus, ttl time.Duration) error
+	Delete(ctx context.Context, key string) error
+}
+
+type cloudConnectorClientWithCache struct {
+	delegate CloudConnectorClient
+	cache    ConnectionStatusCache
+	ttl      map[ConnectionStatus]time.Duration
+}
+
+// NewConnectorClientWithCache wraps the given client with the connection status cache selected by cloud.connector.status.cache.impl.
+// The client is returned as is if the cache is disabled.
+func NewConnectorClientWithCache(cfg *viper.Viper, delegate CloudConnectorClient) CloudConnectorClient {
+	switch cfg.GetString("cloud.connector.status.cache.impl") {
+	case "memory":
+		return NewConnectorClientWithStatusCache(cfg, delegate, NewMemoryConnectionStatusCache())
+	case "redis":
+		return NewConnectorClientWithStatusCache(cfg, delegate, NewRedisConnectionStatusCache(cfg))
+	default:
+		return delegate
+	}
+}
+
+// NewConnectorClientWithStatusCache caches the connection status of recipients in the given cache.
+// Connected and disconnected recipients are cached for cloud.connector.status.cache.ttl.connected and
+// cloud.connector.status.cache.ttl.disconnected seconds, respectively. A TTL of 0 disables caching of the given status.
+func NewConnectorClientWithStatusCache(cfg *viper.Viper, delegate CloudConnectorClient, cache ConnectionStatusCache) CloudConnectorClient {
+	return &cloudConnectorClientWithCache{
+		delegate: delegate,
+		cache:    cache,
+		ttl: map[ConnectionStatus]time.Duration{
+			Connected:    time.Duration(cfg.GetInt64("cloud.connector.status.cache.ttl.connected")) * time.Second,
+			Disconnected: time.Duration(cfg.GetInt64("cloud.connector.status.cache.ttl.disconnected")) * time.Second,
+		},
+	}
+}
+
+func connectionStatusCacheKey(orgID string, recipient string) string {
+	return fmt.Sprintf("%s:%s:%s", connectionStatusCacheKeyPrefix, orgID, recipient)
+}
+
+func (this *cloudConnectorClientWithCache) SendCloudConnectorRequest(
+	ctx context.Context,
+	orgID string,
+	recipient uuid.UUID,
+	url *string,
+	directive string,
+	metadata map[string]string,
+) (*string, bool, error) {
+	id, notFound, err := this.delegate.SendCloudConnectorRequest(ctx, orgID, recipient, url, directive, metadata)
+
+	// both a successful dispatch and a recipient that cloud connector does not know tell more about the recipient than the cached status
+	if err == nil && (id != nil || notFound) {
+		this.invalidate(ctx, orgID, recipient.String())
+	}
+
+	return id, notFound, err
+}
+
+func (this *cloudConnectorClientWithCache) GetConnectionStatus(
+	ctx context.Context,
+	orgID string,
+	recipient string,
+) (ConnectionStatus, error) {
+	key := connectionStatusCacheKey(orgID, recipient)
+
+	status, found, err := this.cache.Get(ctx, key)
+	switch {
+	case err != nil:
+		// fall back to cloud connector
+		utils.GetLogFromContext(ctx).Warnw("Error reading connection status cache", "error", err, "recipient", recipient)
+		connectionStatusCacheTotal.WithLabelValues("error").Inc()
+	case found:
+		connectionStatusCacheTotal.WithLabelValues("hit").Inc()
+		return status, nil
+	default:
+		connectionStatusCacheTotal.WithLabelValues("miss").Inc()
+	}
+
+	status, err = this.delegate.GetConnectionStatus(ctx, orgID, recipient)
+	if err != nil {
+		return status, err
+	}
+
+	if ttl := this.ttl[status]; ttl > 0 {
+		if err := this.cache.Set(ctx, key, status, ttl); err != nil {
+			utils.GetLogFromContext(ctx).Warnw("Error writing connection status cache", "error", err, "recipient", recipient)
+		}
+	}
+
+	return status, nil
+}
+
+func (this *cloudConnectorClientWithCache) invalidate(ctx context.Context, orgID string, recipient string) {
+	if err := this.cache.Delete(ctx, connectionStatusCacheKey(orgID, recipient)); err != nil {
+		utils.GetLogFromContext(ctx).Warnw("Error invalidating connection status cache", "error", err, "recipient", recipient)
+	}
+}
+
+type memoryConnectionStatusCache struct {
+	cache *cache.Cache
+}
+
+// NewMemoryConnectionStatusCache keeps the connection status of recipients in the memory of the process
+func NewMemoryConnectionStatusCache() ConnectionStatusCache {
+	return &memoryConnectionStatusCache{
+		cache: cache.New(cache.NoExpiration, 1*time.Minute),
+	}
+}
+
+func (this *memoryConnectionStatusCache) Get(ctx context.Context, key string) (ConnectionStatus, bool, error) {
+	if cached, found := this.cache.Get(key); found {
+		if status, ok := cached.(ConnectionStatus); ok {
+			return status, true, nil
+		}
+
+		this.cache.Delete(key)
+	}
+
+	return "", false, nil
+}
+
+func (this *memoryConnectionStatusCache) Set(ctx context.Context, key string, status ConnectionStatus, ttl time.Duration) error {
+	this.cache.Set(key, status, ttl)
+	return nil
+}
+
+func (this *memoryConnectionStatusCache) Delete(ctx context.Context, key string) error {
+	this.cache.Delete(key)
+	return nil
+}
+
+type redisConnectionStatusCache struct {
+	client *redis.Client
+}
+
+// NewRedisConnectionStatusCache keeps the connection status of recipients in Redis so that it is shared by all replicas
+func NewRedisConnectionStatusCache(cfg *viper.Viper) ConnectionStatusCache {
+	return &redisConnectionStatusCache{
+		client: utils.NewRedisClient(cfg),
+	}
+}
+
+func (this *redisConnectionStatusCache) Get(ctx context.Context, key string) (ConnectionStatus, bool, error) {
+	value, err := this.client.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", false, nil
+	} else if err != nil {
+		return "", false, err
+	}
+
+	return ConnectionStatus(value), true, nil
+}
+
+func (this *redisConnectionStatusCache) Set(ctx context.Context, key string, status ConnectionStatus, ttl time.Duration) error {
+	return this.client.Set(ctx, key, string(status), ttl).Err()
+}
+
+func (this *redisConnectionStatusCache) Delete(ctx context.Context, key string) error {
+	return this.client.Del(ctx, key).Err()
+}
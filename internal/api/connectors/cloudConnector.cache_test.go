@@ -0,0 +1,133 @@
+package connectors
+
+import (
+	"context"
+	"fmt"
+	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+type countingConnectorClient struct {
+	status      ConnectionStatus
+	statusErr   error
+	notFound    bool
+	statusCalls int
+}
+
+func (this *countingConnectorClient) SendCloudConnectorRequest(ctx context.Context, orgID string, recipient uuid.UUID, url *string, directive string, metadata map[string]string) (*string, bool, error) {
+	if this.notFound {
+		return nil, true, nil
+	}
+
+	return utils.StringRef(uuid.New().String()), false, nil
+}
+
+func (this *countingConnectorClient) GetConnectionStatus(ctx context.Context, orgID string, recipient string) (ConnectionStatus, error) {
+	this.statusCalls++
+	return this.status, this.statusErr
+}
+
+var _ = Describe("Cloud Connector status cache", func() {
+	var (
+		delegate  *countingConnectorClient
+		client    CloudConnectorClient
+		ctx       context.Context
+		recipient uuid.UUID
+	)
+
+	BeforeEach(func() {
+		cfg := config.Get()
+		cfg.Set("cloud.connector.status.cache.ttl.connected", 60)
+		cfg.Set("cloud.connector.status.cache.ttl.disconnected", 0)
+
+		delegate = &countingConnectorClient{status: Connected}
+		client = NewConnectorClientWithStatusCache(cfg, delegate, NewMemoryConnectionStatusCache())
+		ctx = utils.SetLog(test.TestContext(), zap.NewNop().Sugar())
+		recipient = uuid.New()
+	})
+
+	It("answers repeated lookups from the cache", func() {
+		for i := 0; i < 3; i++ {
+			status, err := client.GetConnectionStatus(ctx, "1234", recipient.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(status).To(Equal(Connected))
+		}
+
+		Expect(delegate.statusCalls).To(Equal(1))
+	})
+
+	It("caches the status per organization and recipient", func() {
+		client.GetConnectionStatus(ctx, "1234", recipient.String())
+		client.GetConnectionStatus(ctx, "5678", recipient.String())
+		client.GetConnectionStatus(ctx, "1234", uuid.New().String())
+
+		Expect(delegate.statusCalls).To(Equal(3))
+	})
+
+	It("does not cache a status whose TTL is 0", func() {
+		delegate.status = Disconnected
+
+		client.GetConnectionStatus(ctx, "1234", recipient.String())
+		status, err := client.GetConnectionStatus(ctx, "1234", recipient.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status).To(Equal(Disconnected))
+
+		Expect(delegate.statusCalls).To(Equal(2))
+	})
+
+	It("does not cache errors", func() {
+		delegate.statusErr = fmt.Errorf("timeout")
+
+		_, err := client.GetConnectionStatus(ctx, "1234", recipient.String())
+		Expect(err).To(HaveOccurred())
+
+		delegate.statusErr = nil
+		status, err := client.GetConnectionStatus(ctx, "1234", recipient.String())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(status).To(Equal(Connected))
+
+		Expect(delegate.statusCalls).To(Equal(2))
+	})
+
+	It("invalidates the status on successful dispatch", func() {
+		client.GetConnectionStatus(ctx, "1234", recipient.String())
+
+		_, _, err := client.SendCloudConnectorRequest(ctx, "1234", recipient, utils.StringRef("http://example.com"), ansibleDirective, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		client.GetConnectionStatus(ctx, "1234", recipient.String())
+		Expect(delegate.statusCalls).To(Equal(2))
+	})
+
+	It("invalidates the status if the recipient is not found", func() {
+		client.GetConnectionStatus(ctx, "1234", recipient.String())
+
+		delegate.notFound = true
+		_, notFound, err := client.SendCloudConnectorRequest(ctx, "1234", recipient, utils.StringRef("http://example.com"), ansibleDirective, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notFound).To(BeTrue())
+
+		client.GetConnectionStatus(ctx, "1234", recipient.String())
+		Expect(delegate.statusCalls).To(Equal(2))
+	})
+
+	It("expires cached entries", func() {
+		cache := NewMemoryConnectionStatusCache()
+		Expect(cache.Set(ctx, "key", Connected, 10*time.Millisecond)).To(Succeed())
+
+		_, found, _ := cache.Get(ctx, "key")
+		Expect(found).To(BeTrue())
+
+		Eventually(func() bool {
+			_, found, _ := cache.Get(ctx, "key")
+			return found
+		}).Should(BeFalse())
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"playbook-dispatcher/internal/api/dispatch/protocols"
 	"playbook-dispatcher/internal/common/constants"
 	"time"
 
@@ -52,7 +53,7 @@ func NewConnectorClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoe
 	client := &ClientWithResponses{
 		ClientInterface: &Client{
 			Server: fmt.Sprintf("%s://%s:%d%s", cfg.GetString("cloud.connector.scheme"), cfg.GetString("cloud.connector.host"), cfg.GetInt("cloud.connector.port"), basePath),
-			Client: utils.NewMeasuredHttpRequestDoer(doer, "cloud-connector", "postMessage"),
+			Client: utils.NewResilientHttpRequestDoer(utils.NewMeasuredHttpRequestDoer(doer, "cloud-connector", "postMessage"), "cloud-connector", utils.ResilienceOptionsFromConfig(cfg, "cloud.connector")),
 			RequestEditors: []RequestEditorFn{func(ctx context.Context, req *http.Request) error {
 				req.Header.Set(constants.HeaderRequestId, request_id.GetReqID(ctx))
 
@@ -102,7 +103,7 @@ func (this *cloudConnectorClientImpl) SendCloudConnectorRequest(
 
 	utils.GetLogFromContext(ctx).Debugw("Sending Cloud Connector message",
 		"directive", directive,
-		"metadata", metadata,
+		"metadata", protocols.RedactMetaData(metadata),
 		"payload", url,
 		"recipient", recipientString,
 	)
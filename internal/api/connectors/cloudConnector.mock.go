@@ -49,5 +49,9 @@ func (this *cloudConnectorClientMock) GetConnectionStatus(
 		return Disconnected, nil
 	}
 
+	if recipient == "b31955fb-3064-4f56-ae44-a1c488a28587" {
+		return "", fmt.Errorf("timeout")
+	}
+
 	return Connected, nil
 }
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"playbook-dispatcher/internal/common/constants"
 	"playbook-dispatcher/internal/common/utils"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,6 +18,12 @@ const basePath = "/api/inventory/v1/hosts"
 
 type inventoryConnectorImpl struct {
 	client ClientWithResponsesInterface
+	// number of results per page requested from inventory
+	pageSize int
+	// number of host IDs looked up by a single request
+	chunkSize int
+	// number of chunks looked up in parallel
+	concurrency int
 }
 
 func keySystemProfileResults(systemProfileResults []HostSystemProfileOut) map[string]HostSystemProfileOut {
@@ -68,17 +75,19 @@ func convertInterfaceToString(v interface{}) string {
 	}
 }
 
-func createHostGetHostByIdParams(orderBy string, orderHow string) *ApiHostGetHostByIdParams {
+func createHostGetHostByIdParams(orderBy string, orderHow string, page int, pageSize int) *ApiHostGetHostByIdParams {
 	orderByParam := ApiHostGetHostByIdParamsOrderBy(orderBy)
 	orderHowParam := ApiHostGetHostByIdParamsOrderHow(orderHow)
 
 	return &ApiHostGetHostByIdParams{
 		OrderBy:  &orderByParam,
 		OrderHow: &orderHowParam,
+		Page:     &page,
+		PerPage:  &pageSize,
 	}
 }
 
-func createHostGetHostSystemProfileByIdParams(orderBy string, orderHow string) *ApiHostGetHostSystemProfileByIdParams {
+func createHostGetHostSystemProfileByIdParams(orderBy string, orderHow string, page int, pageSize int) *ApiHostGetHostSystemProfileByIdParams {
 	orderByParam := ApiHostGetHostSystemProfileByIdParamsOrderBy(orderBy)
 	orderHowParam := ApiHostGetHostSystemProfileByIdParamsOrderHow(orderHow)
 
@@ -92,6 +101,8 @@ func createHostGetHostSystemProfileByIdParams(orderBy string, orderHow string) *
 		OrderBy:  &orderByParam,
 		OrderHow: &orderHowParam,
 		Fields:   &fields,
+		Page:     &page,
+		PerPage:  &pageSize,
 	}
 }
 
@@ -99,7 +110,7 @@ func NewInventoryClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoe
 	client := &ClientWithResponses{
 		ClientInterface: &Client{
 			Server: fmt.Sprintf("%s://%s:%d%s", cfg.GetString("inventory.connector.scheme"), cfg.GetString("inventory.connector.host"), cfg.GetInt("inventory.connector.port"), basePath),
-			Client: utils.NewMeasuredHttpRequestDoer(doer, "inventory", "GetHostConnectionDetails"),
+			Client: utils.NewResilientHttpRequestDoer(utils.NewMeasuredHttpRequestDoer(doer, "inventory", "GetHostConnectionDetails"), "inventory", utils.ResilienceOptionsFromConfig(cfg, "inventory.connector")),
 			RequestEditors: []RequestEditorFn{func(ctx context.Context, req *http.Request) error {
 				req.Header.Set(constants.HeaderRequestId, request_id.GetReqID(ctx))
 
@@ -113,7 +124,10 @@ func NewInventoryClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoe
 	}
 
 	return &inventoryConnectorImpl{
-		client: client,
+		client:      client,
+		pageSize:    utils.Max(cfg.GetInt("inventory.connector.limit"), 1),
+		chunkSize:   utils.Max(cfg.GetInt("inventory.connector.batch.size"), 1),
+		concurrency: utils.Max(cfg.GetInt("inventory.connector.concurrency"), 1),
 	}
 }
 
@@ -125,73 +139,144 @@ func NewInventoryClient(cfg *viper.Viper) InventoryConnector {
 	return NewInventoryClientWithHttpRequestDoer(cfg, &httpClient)
 }
 
+// hasNextPage indicates whether more results follow the given page
+func hasNextPage(page int, pageSize int, count int, total int) bool {
+	return count > 0 && page*pageSize < total
+}
+
+// getHostDetails reads all pages of the hosts with the given IDs
 func (this *inventoryConnectorImpl) getHostDetails(
 	ctx context.Context,
-	IDs []string,
+	IDs []uuid.UUID,
 	orderBy string,
 	orderHow string,
-	limit int,
-	offset int,
 ) (details []HostOut, err error) {
+	details = []HostOut{}
 
-	clientIds, err := strSliceToUUIDSlice(IDs)
-	if err != nil {
-		return nil, err
-	}
+	for page := 1; ; page++ {
+		params := createHostGetHostByIdParams(orderBy, orderHow, page, this.pageSize)
 
-	params := createHostGetHostByIdParams(orderBy, orderHow)
+		response, err := this.client.ApiHostGetHostByIdWithResponse(ctx, IDs, params)
 
-	response, err := this.client.ApiHostGetHostByIdWithResponse(ctx, clientIds, params)
+		if err != nil {
+			return nil, err
+		}
 
-	if err != nil {
-		return nil, err
-	}
+		if response.StatusCode() == http.StatusNotFound {
+			return details, nil
+		}
 
-	if response.StatusCode() == http.StatusNotFound {
-		return []HostOut{}, nil
-	}
+		if response.JSON200 == nil {
+			return nil, utils.UnexpectedResponse(response.HTTPResponse)
+		}
 
-	if response.JSON200 == nil {
-		return nil, utils.UnexpectedResponse(response.HTTPResponse)
-	}
+		details = append(details, response.JSON200.Results...)
 
-	return response.JSON200.Results, err
+		if !hasNextPage(page, this.pageSize, len(response.JSON200.Results), response.JSON200.Total) {
+			return details, nil
+		}
+	}
 }
 
+// getSystemProfileDetails reads all pages of the system profiles of the hosts with the given IDs
 func (this *inventoryConnectorImpl) getSystemProfileDetails(
 	ctx context.Context,
-	IDs []string,
+	IDs []uuid.UUID,
 	orderBy string,
 	orderHow string,
-	limit int,
-	offset int,
 ) (details map[string]HostSystemProfileOut, err error) {
+	results := []HostSystemProfileOut{}
+
+	for page := 1; ; page++ {
+		params := createHostGetHostSystemProfileByIdParams(orderBy, orderHow, page, this.pageSize)
+
+		response, err := this.client.ApiHostGetHostSystemProfileByIdWithResponse(ctx, IDs, params)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if response.JSON200 == nil {
+			return nil, utils.UnexpectedResponse(response.HTTPResponse)
+		}
 
-	clientIds, err := strSliceToUUIDSlice(IDs)
+		results = append(results, response.JSON200.Results...)
+
+		if !hasNextPage(page, this.pageSize, len(response.JSON200.Results), response.JSON200.Total) {
+			return keySystemProfileResults(results), nil
+		}
+	}
+}
+
+// GetHostConnectionDetails looks up the hosts in chunks of inventory.connector.batch.size IDs, with at most inventory.connector.concurrency chunks in flight.
+// The details are returned in the order of the chunks, each ordered as requested.
+func (this *inventoryConnectorImpl) GetHostConnectionDetails(ctx context.Context, IDs []string, order_by string, order_how string) (details []HostDetails, err error) {
+	clientIds, err := strSliceToUUIDSlice(uniqueIDs(IDs))
 	if err != nil {
 		return nil, err
 	}
 
-	params := createHostGetHostSystemProfileByIdParams(orderBy, orderHow)
+	chunks := [][]uuid.UUID{}
+	for i := 0; i < len(clientIds); i += this.chunkSize {
+		chunks = append(chunks, clientIds[i:utils.Min(i+this.chunkSize, len(clientIds))])
+	}
+
+	// once a chunk fails the whole lookup fails so no further chunks are started and those in flight are canceled
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	response, err := this.client.ApiHostGetHostSystemProfileByIdWithResponse(ctx, clientIds, params)
+	var failure error
+	var failureOnce sync.Once
 
-	if err != nil {
-		return nil, err
+	results := make([][]HostDetails, len(chunks))
+	slots := make(chan struct{}, this.concurrency)
+	wg := sync.WaitGroup{}
+
+	for i, chunk := range chunks {
+		slots <- struct{}{}
+
+		if ctx.Err() != nil {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			result, err := this.getChunkConnectionDetails(ctx, chunk, order_by, order_how)
+			if err != nil {
+				failureOnce.Do(func() {
+					failure = err
+					cancel()
+				})
+				return
+			}
+
+			results[i] = result
+		}()
 	}
 
-	if response.JSON200 == nil {
-		return nil, utils.UnexpectedResponse(response.HTTPResponse)
+	wg.Wait()
+
+	if failure != nil {
+		return nil, failure
 	}
 
-	formatedResults := keySystemProfileResults(response.JSON200.Results)
+	details = []HostDetails{}
+	for i := range chunks {
+		details = append(details, results[i]...)
+	}
 
-	return formatedResults, nil
+	return details, nil
 }
 
-func (this *inventoryConnectorImpl) GetHostConnectionDetails(ctx context.Context, IDs []string, order_by string, order_how string, limit int, offset int) (details []HostDetails, err error) {
-
-	hostResults, err := this.getHostDetails(ctx, IDs, order_by, order_how, limit, offset)
+func (this *inventoryConnectorImpl) getChunkConnectionDetails(ctx context.Context, IDs []uuid.UUID, order_by string, order_how string) (details []HostDetails, err error) {
+	hostResults, err := this.getHostDetails(ctx, IDs, order_by, order_how)
 
 	if err != nil {
 		return nil, err
@@ -201,13 +286,13 @@ func (this *inventoryConnectorImpl) GetHostConnectionDetails(ctx context.Context
 		return []HostDetails{}, nil
 	}
 
-	systemProfileResults, err := this.getSystemProfileDetails(ctx, IDs, order_by, order_how, limit, offset)
+	systemProfileResults, err := this.getSystemProfileDetails(ctx, IDs, order_by, order_how)
 
 	if err != nil {
 		return nil, err
 	}
 
-	hostConnectionDetails := make([]HostDetails, len(IDs))
+	hostConnectionDetails := make([]HostDetails, len(hostResults))
 	for i, host := range hostResults {
 		satelliteFacts := getSatelliteFacts(host.Facts)
 		hostConnectionDetails[i] = HostDetails{
@@ -223,6 +308,21 @@ func (this *inventoryConnectorImpl) GetHostConnectionDetails(ctx context.Context
 	return hostConnectionDetails, nil
 }
 
+// uniqueIDs removes repeated IDs, keeping the first occurrence
+func uniqueIDs(IDs []string) []string {
+	result := make([]string, 0, len(IDs))
+	seen := make(map[string]bool, len(IDs))
+
+	for _, ID := range IDs {
+		if !seen[ID] {
+			seen[ID] = true
+			result = append(result, ID)
+		}
+	}
+
+	return result
+}
+
 func strSliceToUUIDSlice(strSlice []string) ([]uuid.UUID, error) {
 	uuidSlice := make([]uuid.UUID, 0, len(strSlice))
 
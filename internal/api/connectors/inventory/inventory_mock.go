@@ -3,6 +3,7 @@ package inventory
 import (
 	"context"
 	"fmt"
+	"playbook-dispatcher/internal/common/utils"
 )
 
 type inventoryConnectorMock struct {
@@ -17,14 +18,16 @@ func (this *inventoryConnectorMock) GetHostConnectionDetails(
 	IDs []string,
 	orderBy string,
 	orderHow string,
-	limit int,
-	offset int,
 ) (details []HostDetails, err error) {
 
 	if IDs[0] == "0e97ad0d-8649-4ef1-a3aa-492024cc84bf" {
 		return []HostDetails{}, fmt.Errorf("timeout")
 	}
 
+	if IDs[0] == "unavailable-host" {
+		return []HostDetails{}, &utils.ServiceUnavailableError{Component: "inventory", Reason: "circuit_open"}
+	}
+
 	ownerID := "12345"
 	satelliteInstanceID := "bd54e0e9-5310-45be-b107-fd7c96672ce5"
 	satelliteOrgID := "5"
@@ -57,6 +60,17 @@ func (this *inventoryConnectorMock) GetHostConnectionDetails(
 		return []HostDetails{nilSatelliteVersionHost}, nil
 	}
 
+	// Special case for testing a host whose connection status cannot be read
+	if IDs[0] == "unknown-status-host" {
+		unknownStatusRHCClientID := "b31955fb-3064-4f56-ae44-a1c488a28587"
+		unknownStatusHost := HostDetails{
+			ID:          "unknown-status-host",
+			OwnerID:     &ownerID,
+			RHCClientID: &unknownStatusRHCClientID,
+		}
+		return []HostDetails{unknownStatusHost}, nil
+	}
+
 	hostDetailsList := []HostDetails{hostDetails, directConnectDetails}
 
 	return hostDetailsList, nil
@@ -22,7 +22,7 @@ var _ = Describe("Inventory", func() {
 			client := NewInventoryClientWithHttpRequestDoer(config.Get(), doer)
 			ctx := utils.SetLog(test.TestContext(), zap.NewNop().Sugar())
 			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf"}
-			result, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC", 10, 0)
+			result, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC")
 			resultData := result[0]
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultData.ID).To(Equal("1234"))
@@ -43,7 +43,7 @@ var _ = Describe("Inventory", func() {
 			client := NewInventoryClientWithHttpRequestDoer(config.Get(), doer)
 			ctx := utils.SetLog(test.TestContext(), zap.NewNop().Sugar())
 			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf"}
-			_, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC", 10, 0)
+			_, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(`unexpected status code "400"`))
 		})
@@ -58,7 +58,7 @@ var _ = Describe("Inventory", func() {
 			client := NewInventoryClientWithHttpRequestDoer(config.Get(), doer)
 			ctx := utils.SetLog(test.TestContext(), zap.NewNop().Sugar())
 			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf"}
-			_, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC", 10, 0)
+			_, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(`unexpected status code "400"`))
 		})
@@ -73,7 +73,7 @@ var _ = Describe("Inventory", func() {
 			client := NewInventoryClientWithHttpRequestDoer(config.Get(), doer)
 			ctx := test.TestContext()
 			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf"}
-			result, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC", 10, 0)
+			result, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC")
 			resultData := result[0]
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultData.ID).To(Equal("1234"))
@@ -94,7 +94,7 @@ var _ = Describe("Inventory", func() {
 			client := NewInventoryClientWithHttpRequestDoer(config.Get(), doer)
 			ctx := test.TestContext()
 			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf"}
-			result, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC", 10, 0)
+			result, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC")
 			resultData := result[0]
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resultData.ID).To(Equal("1234"))
@@ -104,5 +104,83 @@ var _ = Describe("Inventory", func() {
 			Expect(*resultData.SatelliteOrgID).To(Equal("5"))
 			Expect(resultData.RHCClientID).To(BeNil())
 		})
+
+		It("reads all pages of the results", func() {
+			responses := []test.MockHttpResponse{
+				{StatusCode: 200, Body: `{"total":3,"count":2,"page":1,"per_page":2,"results":[{"id":"1234","facts":[]},{"id":"2345","facts":[]}]}`},
+				{StatusCode: 200, Body: `{"total":3,"count":1,"page":2,"per_page":2,"results":[{"id":"3456","facts":[]}]}`},
+				{StatusCode: 200, Body: `{"total":3,"count":2,"page":1,"per_page":2,"results":[{"id":"1234","system_profile":{}},{"id":"2345","system_profile":{}}]}`},
+				{StatusCode: 200, Body: `{"total":3,"count":1,"page":2,"per_page":2,"results":[{"id":"3456","system_profile":{"rhc_client_id":"7bc66a39-e719-4bc5-b10a-77bfbd3a0ead"}}]}`},
+			}
+
+			cfg := config.Get()
+			cfg.Set("inventory.connector.limit", 2)
+
+			doer := test.MockMultiResponseHttpClient(responses...)
+			client := NewInventoryClientWithHttpRequestDoer(cfg, doer)
+			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf", "0c6c2a6b-7a9c-4c3b-a2a2-4a3c5e1f9b1d", "e4c2a6b1-3b8d-4f0e-9f7a-1c2d3e4f5a6b"}
+			result, err := client.GetHostConnectionDetails(test.TestContext(), IDs, "DisplayName", "ASC")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(3))
+			Expect(result[2].ID).To(Equal("3456"))
+			Expect(*result[2].RHCClientID).To(Equal("7bc66a39-e719-4bc5-b10a-77bfbd3a0ead"))
+			Expect(doer.Request.URL.Query().Get("page")).To(Equal("2"))
+			Expect(doer.Request.URL.Query().Get("per_page")).To(Equal("2"))
+		})
+
+		It("looks up large lists of hosts in chunks", func() {
+			responses := []test.MockHttpResponse{
+				{StatusCode: 200, Body: `{"total":1,"results":[{"id":"1234","facts":[]}]}`},
+				{StatusCode: 200, Body: `{"total":1,"results":[{"id":"1234","system_profile":{}}]}`},
+				{StatusCode: 200, Body: `{"total":1,"results":[{"id":"2345","facts":[]}]}`},
+				{StatusCode: 200, Body: `{"total":1,"results":[{"id":"2345","system_profile":{}}]}`},
+			}
+
+			cfg := config.Get()
+			cfg.Set("inventory.connector.batch.size", 1)
+			cfg.Set("inventory.connector.concurrency", 1)
+
+			doer := test.MockMultiResponseHttpClient(responses...)
+			client := NewInventoryClientWithHttpRequestDoer(cfg, doer)
+			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf", "0c6c2a6b-7a9c-4c3b-a2a2-4a3c5e1f9b1d", "db0b6f08-e0ba-4248-8e0e-2de2fb843dcf"}
+			result, err := client.GetHostConnectionDetails(test.TestContext(), IDs, "DisplayName", "ASC")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+			Expect(result[0].ID).To(Equal("1234"))
+			Expect(result[1].ID).To(Equal("2345"))
+			Expect(doer.Request.URL.Path).To(ContainSubstring("0c6c2a6b-7a9c-4c3b-a2a2-4a3c5e1f9b1d"))
+		})
+
+		It("does not look up further chunks once a chunk fails", func() {
+			// a request beyond the given responses would panic
+			responses := []test.MockHttpResponse{
+				{StatusCode: 400, Body: `{}`},
+			}
+
+			cfg := config.Get()
+			cfg.Set("inventory.connector.batch.size", 1)
+			cfg.Set("inventory.connector.concurrency", 1)
+
+			doer := test.MockMultiResponseHttpClient(responses...)
+			client := NewInventoryClientWithHttpRequestDoer(cfg, doer)
+			ctx := utils.SetLog(test.TestContext(), zap.NewNop().Sugar())
+			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf", "0c6c2a6b-7a9c-4c3b-a2a2-4a3c5e1f9b1d", "e4c2a6b1-3b8d-4f0e-9f7a-1c2d3e4f5a6b"}
+			_, err := client.GetHostConnectionDetails(ctx, IDs, "DisplayName", "ASC")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unexpected status code "400"`))
+		})
+
+		It("leaves out hosts that do not exist", func() {
+			responses := []test.MockHttpResponse{
+				{StatusCode: 200, Body: `{"total":0,"results":[]}`},
+			}
+
+			doer := test.MockMultiResponseHttpClient(responses...)
+			client := NewInventoryClientWithHttpRequestDoer(config.Get(), doer)
+			IDs := []string{"db0b6f08-e0ba-4248-8e0e-2de2fb843dcf"}
+			result, err := client.GetHostConnectionDetails(test.TestContext(), IDs, "DisplayName", "ASC")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeEmpty())
+		})
 	})
 })
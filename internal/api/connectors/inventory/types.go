@@ -20,5 +20,6 @@ type HostDetails struct {
 }
 
 type InventoryConnector interface {
-	GetHostConnectionDetails(ctx context.Context, IDs []string, order_how string, order_by string, limit int, offset int) ([]HostDetails, error)
+	// GetHostConnectionDetails reads the connection details of the given hosts. Hosts that do not exist are left out.
+	GetHostConnectionDetails(ctx context.Context, IDs []string, order_how string, order_by string) ([]HostDetails, error)
 }
@@ -27,7 +27,7 @@ func NewSourcesClientWithHttpRequestDoer(cfg *viper.Viper, doer HttpRequestDoer)
 	client := &ClientWithResponses{
 		ClientInterface: &Client{
 			Server: fmt.Sprintf("%s://%s:%d%s", cfg.GetString("sources.scheme"), cfg.GetString("sources.host"), cfg.GetInt("sources.port"), basePath),
-			Client: utils.NewMeasuredHttpRequestDoer(doer, "sources", "postMessage"),
+			Client: utils.NewResilientHttpRequestDoer(utils.NewMeasuredHttpRequestDoer(doer, "sources", "postMessage"), "sources", utils.ResilienceOptionsFromConfig(cfg, "sources")),
 			RequestEditors: []RequestEditorFn{func(ctx context.Context, req *http.Request) error {
 				req.Header.Set(constants.HeaderRequestId, request_id.GetReqID(ctx))
 
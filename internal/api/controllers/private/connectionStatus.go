@@ -0,0 +1,60 @@
+package private
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/utils"
+	"sync"
+)
+
+type connectionStatusLookup struct {
+	orgId     string
+	recipient string
+}
+
+// getConnectionStatuses looks up the connection status of the given recipients in parallel, with at most cloud.connector.status.concurrency
+// lookups in flight. Each lookup takes from the shared rate limit bucket. A failed lookup is reported as unknown instead of failing the remaining ones.
+func (this *controllers) getConnectionStatuses(ctx context.Context, lookups []connectionStatusLookup) []ConnectionStatus {
+	results := make([]ConnectionStatus, len(lookups))
+	slots := make(chan struct{}, utils.Max(this.config.GetInt("cloud.connector.status.concurrency"), 1))
+	wg := sync.WaitGroup{}
+
+	for i, lookup := range lookups {
+		slots <- struct{}{}
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			results[i] = this.getConnectionStatus(ctx, lookup)
+		}()
+	}
+
+	wg.Wait()
+	return results
+}
+
+func (this *controllers) getConnectionStatus(ctx context.Context, lookup connectionStatusLookup) ConnectionStatus {
+	// take from the rate limit bucket
+	// TODO: consider moving this to the httpClient level (e.g. as an HttpRequestDoer decorator)
+	if err := this.rateLimiter.Wait(ctx); err != nil {
+		instrumentation.CloudConnectorStatusError(ctx, err, lookup.orgId, lookup.recipient)
+		return Unknown
+	}
+
+	status, err := this.cloudConnectorClient.GetConnectionStatus(ctx, lookup.orgId, lookup.recipient)
+	if err != nil {
+		instrumentation.CloudConnectorStatusError(ctx, err, lookup.orgId, lookup.recipient)
+		return Unknown
+	}
+
+	if status == connectors.Connected {
+		return Connected
+	}
+
+	return Disconnected
+}
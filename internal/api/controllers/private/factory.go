@@ -6,7 +6,10 @@ import (
 	"playbook-dispatcher/internal/api/connectors/inventory"
 	"playbook-dispatcher/internal/api/connectors/sources"
 	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/api/dispatch/registry"
+	"playbook-dispatcher/internal/common/callback"
 	"playbook-dispatcher/internal/common/config"
+	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/RedHatInsights/tenant-utils/pkg/tenantid"
 
@@ -29,6 +32,9 @@ func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudCo
 			rateLimiter:              rateLimiter,
 			translator:               translator,
 			dispatchManager:          dispatch.NewDispatchManager(config, cloudConnectorClient, rateLimiter, database),
+			callbackSecrets:          callback.BuildSecretsFromEnv(),
+			outboundHosts:            utils.ParseHostAllowlist(config.GetString("outbound.allowed.hosts")),
+			playbookRegistry:         registry.New(config, database),
 		},
 	}
 }
@@ -43,6 +49,9 @@ type controllers struct {
 	rateLimiter              *rate.Limiter
 	translator               tenantid.Translator
 	dispatchManager          dispatch.DispatchManager
+	callbackSecrets          map[string]string
+	outboundHosts            utils.HostAllowlist
+	playbookRegistry         *registry.Registry
 }
 
 // workaround for https://github.com/deepmap/oapi-codegen/issues/42
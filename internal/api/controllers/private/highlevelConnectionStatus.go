@@ -1,12 +1,13 @@
 package private
 
 import (
+	"context"
 	"net/http"
-	"playbook-dispatcher/internal/api/connectors"
 	"playbook-dispatcher/internal/api/connectors/inventory"
 	"playbook-dispatcher/internal/api/connectors/sources"
 	"playbook-dispatcher/internal/api/controllers/public"
 	"playbook-dispatcher/internal/common/utils"
+	"sort"
 
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -34,26 +35,13 @@ func (this *controllers) ApiInternalHighlevelConnectionStatus(ctx echo.Context)
 		return ctx.NoContent(http.StatusBadRequest)
 	}
 
-	if len(input.Hosts) > 50 {
-		utils.GetLogFromEcho(ctx).Infow("More than 50 hosts requested")
-
-		return ctx.JSON(http.StatusBadRequest, map[string]string{
-			"message": "maximum input length exceeded",
-		})
-	}
-
-	hostConnectorDetails, err := this.inventoryConnectorClient.GetHostConnectionDetails(
-		ctx.Request().Context(),
-		input.Hosts,
-		this.config.GetString("inventory.connector.ordered.by"),
-		this.config.GetString("inventory.connector.ordered.how"),
-		this.config.GetInt("inventory.connector.limit"),
-		this.config.GetInt("inventory.connector.offset"),
-	)
+	hostConnectorDetails, err := this.getHostConnectionDetails(ctx.Request().Context(), input.Hosts)
 
 	utils.GetLogFromEcho(ctx).Infow("returned from inventory", "data", hostConnectorDetails, "error", err)
 
-	if err != nil {
+	if utils.IsServiceUnavailable(err) {
+		return serviceUnavailable(ctx, err)
+	} else if err != nil {
 		utils.GetLogFromEcho(ctx).Error(err)
 		return ctx.NoContent(http.StatusBadRequest)
 	}
@@ -76,23 +64,15 @@ func (this *controllers) ApiInternalHighlevelConnectionStatus(ctx echo.Context)
 	}
 
 	if len(satellite) > 0 {
-		satelliteResponses, err = getSatelliteStatus(ctx, this.cloudConnectorClient, this.sourcesConnectorClient, input.OrgId, satellite)
-
-		utils.GetLogFromEcho(ctx).Infow("satellite status", "data", satelliteResponses, "error", err)
+		satelliteResponses = this.getSatelliteStatus(ctx, input.OrgId, satellite)
 
-		if err != nil {
-			utils.GetLogFromEcho(ctx).Errorf("Error retrieving Satellite status: %s", err)
-		}
+		utils.GetLogFromEcho(ctx).Infow("satellite status", "data", satelliteResponses)
 	}
 
 	if len(directConnected) > 0 {
-		directConnectedResponses, err = getDirectConnectStatus(ctx, this.cloudConnectorClient, input.OrgId, directConnected)
-
-		utils.GetLogFromEcho(ctx).Infow("direct connect status", "data", directConnectedResponses, "error", err)
+		directConnectedResponses = this.getDirectConnectStatus(ctx, input.OrgId, directConnected)
 
-		if err != nil {
-			utils.GetLogFromEcho(ctx).Errorf("Error retrieving Direct Connect status: %s", err)
-		}
+		utils.GetLogFromEcho(ctx).Infow("direct connect status", "data", directConnectedResponses)
 	}
 
 	highLevelStatus := HighLevelRecipientStatus(concatResponses(satelliteResponses, directConnectedResponses, noRHCResponses))
@@ -100,6 +80,27 @@ func (this *controllers) ApiInternalHighlevelConnectionStatus(ctx echo.Context)
 	return ctx.JSON(http.StatusOK, highLevelStatus)
 }
 
+// getHostConnectionDetails reads the connection details of the given hosts from inventory.
+// The details are sorted by host ID so that the hosts are grouped the same way regardless of the order inventory returns them in.
+func (this *controllers) getHostConnectionDetails(ctx context.Context, hosts []string) ([]inventory.HostDetails, error) {
+	details, err := this.inventoryConnectorClient.GetHostConnectionDetails(
+		ctx,
+		hosts,
+		this.config.GetString("inventory.connector.ordered.by"),
+		this.config.GetString("inventory.connector.ordered.how"),
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(details, func(i, j int) bool {
+		return details[i].ID < details[j].ID
+	})
+
+	return details, nil
+}
+
 func sortHostsByRecipient(details []inventory.HostDetails) (satelliteDetails []inventory.HostDetails, directConnectedDetails []inventory.HostDetails, noRhc []inventory.HostDetails) {
 	var satelliteConnectedHosts []inventory.HostDetails
 	var directConnectedHosts []inventory.HostDetails
@@ -119,7 +120,7 @@ func sortHostsByRecipient(details []inventory.HostDetails) (satelliteDetails []i
 	return satelliteConnectedHosts, directConnectedHosts, hostsNotConnected
 }
 
-func formatConnectionResponse(satID *string, satOrgID *string, rhcClientID *string, orgID OrgId, hosts []string, recipientType string, status string) RecipientWithConnectionInfo {
+func formatConnectionResponse(satID *string, satOrgID *string, rhcClientID *string, orgID OrgId, hosts []string, recipientType string, status ConnectionStatus) RecipientWithConnectionInfo {
 	formatedHosts := make([]HostId, len(hosts))
 	var formatedSatID SatelliteId
 	var formatedSatOrgID SatelliteOrgId
@@ -148,48 +149,35 @@ func formatConnectionResponse(satID *string, satOrgID *string, rhcClientID *stri
 		RecipientType: RecipientType(recipientType),
 		SatId:         formatedSatID,
 		SatOrgId:      formatedSatOrgID,
-		Status:        RecipientWithConnectionInfoStatus(status),
+		Status:        status,
 		Systems:       formatedHosts,
 	}
 
 	return connectionInfo
 }
 
-func getDirectConnectStatus(ctx echo.Context, client connectors.CloudConnectorClient, orgId OrgId, hostDetails []inventory.HostDetails) ([]RecipientWithConnectionInfo, error) {
-	responses := []RecipientWithConnectionInfo{}
-	for _, host := range hostDetails {
-		status, err := client.GetConnectionStatus(ctx.Request().Context(), string(orgId), *host.RHCClientID)
-
-		if err != nil {
-			utils.GetLogFromEcho(ctx).Error(err)
-			return nil, ctx.NoContent(http.StatusInternalServerError)
-		}
+func (this *controllers) getDirectConnectStatus(ctx echo.Context, orgId OrgId, hostDetails []inventory.HostDetails) []RecipientWithConnectionInfo {
+	lookups := make([]connectionStatusLookup, len(hostDetails))
+	for i, host := range hostDetails {
+		lookups[i] = connectionStatusLookup{orgId: string(orgId), recipient: *host.RHCClientID}
+	}
 
-		var connectionStatus string
-		if status == connectors.Connected {
-			connectionStatus = "connected"
-		} else {
-			connectionStatus = "disconnected"
-		}
+	statuses := this.getConnectionStatuses(ctx.Request().Context(), lookups)
 
-		responses = append(responses, formatConnectionResponse(nil, nil, host.RHCClientID, orgId, []string{host.ID}, string(DirectConnect), connectionStatus))
+	responses := make([]RecipientWithConnectionInfo, len(hostDetails))
+	for i, host := range hostDetails {
+		responses[i] = formatConnectionResponse(nil, nil, host.RHCClientID, orgId, []string{host.ID}, string(DirectConnect), statuses[i])
 	}
 
-	return responses, nil
+	return responses
 }
 
-func getSatelliteStatus(ctx echo.Context, client connectors.CloudConnectorClient, sourceClient sources.SourcesConnector, orgId OrgId, hostDetails []inventory.HostDetails) ([]RecipientWithConnectionInfo, error) {
+func (this *controllers) getSatelliteStatus(ctx echo.Context, orgId OrgId, hostDetails []inventory.HostDetails) []RecipientWithConnectionInfo {
 	hostsGroupedBySatellite := groupHostsBySatellite(hostDetails)
 
-	hostsGroupedBySatellite = getSourceInfo(ctx, hostsGroupedBySatellite, sourceClient)
+	hostsGroupedBySatellite = getSourceInfo(ctx, hostsGroupedBySatellite, this.sourcesConnectorClient)
 
-	responses, err := createSatelliteConnectionResponses(ctx, hostsGroupedBySatellite, client, orgId)
-	if err != nil {
-		utils.GetLogFromEcho(ctx).Error("error occured creating satellite connection response")
-		return nil, ctx.NoContent(http.StatusInternalServerError)
-	}
-
-	return responses, nil
+	return this.createSatelliteConnectionResponses(ctx, hostsGroupedBySatellite, orgId)
 }
 
 func groupHostsBySatellite(hostDetails []inventory.HostDetails) map[string]*rhcSatellite {
@@ -235,29 +223,32 @@ func getSourceInfo(ctx echo.Context, hostsGroupedBySatellite map[string]*rhcSate
 	return hostsGroupedBySatellite
 }
 
-func createSatelliteConnectionResponses(ctx echo.Context, hostsGroupedBySatellite map[string]*rhcSatellite, cloudConnector connectors.CloudConnectorClient, orgId OrgId) ([]RecipientWithConnectionInfo, error) {
-	responses := []RecipientWithConnectionInfo{}
+func (this *controllers) createSatelliteConnectionResponses(ctx echo.Context, hostsGroupedBySatellite map[string]*rhcSatellite, orgId OrgId) []RecipientWithConnectionInfo {
+	satellites := []*rhcSatellite{}
+	lookups := []connectionStatusLookup{}
 
-	for _, satellite := range hostsGroupedBySatellite {
-		if satellite.RhcClientID != nil {
-			status, err := cloudConnector.GetConnectionStatus(ctx.Request().Context(), satellite.SatelliteOrgID, *satellite.RhcClientID)
-			if err != nil {
-				utils.GetLogFromEcho(ctx).Error(err)
-				return nil, ctx.NoContent(http.StatusInternalServerError)
-			}
+	keys := make([]string, 0, len(hostsGroupedBySatellite))
+	for key := range hostsGroupedBySatellite {
+		keys = append(keys, key)
+	}
 
-			var connectionStatus string
-			if status == connectors.Connected {
-				connectionStatus = "connected"
-			} else {
-				connectionStatus = "disconnected"
-			}
+	sort.Strings(keys)
 
-			responses = append(responses, formatConnectionResponse(&satellite.SatelliteInstanceID, &satellite.SatelliteOrgID, satellite.RhcClientID, orgId, satellite.Hosts, string(Satellite), connectionStatus))
+	for _, key := range keys {
+		if satellite := hostsGroupedBySatellite[key]; satellite.RhcClientID != nil {
+			satellites = append(satellites, satellite)
+			lookups = append(lookups, connectionStatusLookup{orgId: satellite.SatelliteOrgID, recipient: *satellite.RhcClientID})
 		}
 	}
 
-	return responses, nil
+	statuses := this.getConnectionStatuses(ctx.Request().Context(), lookups)
+
+	responses := make([]RecipientWithConnectionInfo, len(satellites))
+	for i, satellite := range satellites {
+		responses[i] = formatConnectionResponse(&satellite.SatelliteInstanceID, &satellite.SatelliteOrgID, satellite.RhcClientID, orgId, satellite.Hosts, string(Satellite), statuses[i])
+	}
+
+	return responses
 }
 
 func getRHCStatus(hostDetails []inventory.HostDetails, orgID OrgId) RecipientWithConnectionInfo {
@@ -267,7 +258,7 @@ func getRHCStatus(hostDetails []inventory.HostDetails, orgID OrgId) RecipientWit
 		hostIDs[i] = host.ID
 	}
 
-	return formatConnectionResponse(nil, nil, nil, orgID, hostIDs, "none", "rhc_not_configured")
+	return formatConnectionResponse(nil, nil, nil, orgID, hostIDs, "none", RhcNotConfigured)
 }
 
 func concatResponses(satellite []RecipientWithConnectionInfo, directConnect []RecipientWithConnectionInfo, noRHC []RecipientWithConnectionInfo) []RecipientWithConnectionInfo {
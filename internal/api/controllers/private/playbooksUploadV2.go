@@ -0,0 +1,41 @@
+package private
+
+import (
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/dispatch/registry"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (this *controllers) ApiInternalV2PlaybooksUpload(ctx echo.Context) error {
+	var input PlaybookUploadInputV2
+
+	err := utils.ReadRequestBody(ctx, &input)
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Error(err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	if !this.config.GetBool("playbook.registry.enabled") {
+		err := fmt.Errorf("The playbook registry is not enabled")
+		instrumentation.InvalidPlaybookRequest(ctx, err)
+		return invalidRequest(ctx, err)
+	}
+
+	playbook, err := this.playbookRegistry.Upload(ctx.Request().Context(), string(input.OrgId), []byte(input.Content), input.Signature)
+	if registry.IsRejected(err) {
+		instrumentation.InvalidPlaybookRequest(ctx, err)
+		return invalidRequest(ctx, err)
+	} else if err != nil {
+		instrumentation.PlaybookCreateError(ctx.Request().Context(), err, string(input.OrgId))
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	instrumentation.PlaybookUploaded(ctx, playbook.OrgID, playbook.Digest)
+	return ctx.JSON(http.StatusCreated, PlaybookUploaded{
+		Digest: playbook.Digest,
+	})
+}
@@ -1,6 +1,7 @@
 package private
 
 import (
+	"context"
 	"encoding/json"
 	"testing"
 
@@ -59,6 +60,45 @@ var _ = Describe("Validation", func() {
 	)
 })
 
+var _ = Describe("Extra vars validation", func() {
+	DescribeTable("validateExtraVars",
+		func(runInputJson string, expected string) {
+			runInput := RunInputV2{}
+			Expect(json.Unmarshal([]byte(runInputJson), &runInput)).To(Succeed())
+
+			err := validateExtraVars(context.Background(), runInput)
+
+			if expected == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(expected))
+			}
+		},
+
+		Entry("no extra vars", `{}`, ""),
+		Entry("extra vars", `{"extra_vars": {"packages": ["openssl"], "reboot": false}}`, ""),
+		Entry(
+			"matching schema",
+			`{"extra_vars": {"reboot": false, "token": "s3cr3t"}, "extra_vars_schema": {"type": "object", "properties": {"reboot": {"type": "boolean"}}, "required": ["reboot"]}, "secret_vars": ["token"]}`,
+			"",
+		),
+		Entry("schema without extra vars", `{"extra_vars_schema": {"type": "object"}}`, "extra_vars_schema and secret_vars require extra_vars to be defined"),
+		Entry("secret vars without extra vars", `{"secret_vars": ["token"]}`, "extra_vars_schema and secret_vars require extra_vars to be defined"),
+		Entry("invalid name", `{"extra_vars": {"not-valid": 1}}`, "Invalid extra var name: not-valid"),
+		Entry("unknown secret var", `{"extra_vars": {"package": "openssl"}, "secret_vars": ["token"]}`, "Secret var not defined in extra_vars: token"),
+		Entry(
+			"missing required var",
+			`{"extra_vars": {"package": "openssl"}, "extra_vars_schema": {"type": "object", "required": ["reboot"]}}`,
+			`extra_vars do not match extra_vars_schema: /: "reboot" value is required`,
+		),
+		Entry(
+			"mismatching type",
+			`{"extra_vars": {"token": "s3cr3t"}, "extra_vars_schema": {"type": "object", "properties": {"token": {"type": "integer"}}}, "secret_vars": ["token"]}`,
+			"extra_vars do not match extra_vars_schema: /token: type should be integer, got string",
+		),
+	)
+})
+
 var _ = Describe("Blocklisted OrgIDs", func() {
 	DescribeTable("validateFields",
 		func(orgID string, result bool) {
@@ -2,7 +2,6 @@ package private
 
 import (
 	"net/http"
-	"playbook-dispatcher/internal/api/connectors"
 	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/labstack/echo/v4"
@@ -17,35 +16,27 @@ func (this *controllers) ApiInternalV2RecipientsStatus(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusBadRequest)
 	}
 
+	lookups := make([]connectionStatusLookup, len(input))
+	for i, recipient := range input {
+		lookups[i] = connectionStatusLookup{orgId: string(recipient.OrgId), recipient: recipient.Recipient.String()}
+	}
+
 	// get connection status from Cloud Connector
+	statuses := this.getConnectionStatuses(ctx.Request().Context(), lookups)
+
 	results := make([]RecipientStatus, len(input))
 	for i, recipient := range input {
-		// take from the rate limit bucket
-		// TODO: consider moving this to the httpClient level (e.g. as an HttpRequestDoer decorator)
-		err = this.rateLimiter.Wait(ctx.Request().Context())
-
-		if err != nil {
-			utils.GetLogFromEcho(ctx).Error(err)
-			return ctx.NoContent(http.StatusInternalServerError)
-		}
-
-		// TODO: parallelize this
-		status, err := this.cloudConnectorClient.GetConnectionStatus(ctx.Request().Context(), string(recipient.OrgId), recipient.Recipient.String())
-		if err != nil {
-			utils.GetLogFromEcho(ctx).Error(err)
-			return ctx.NoContent(http.StatusInternalServerError)
-		}
-
-		results[i] = recipientStatusResponse(recipient, status == connectors.Connected)
+		results[i] = recipientStatusResponse(recipient, statuses[i])
 	}
 
 	return ctx.JSON(http.StatusOK, results)
 }
 
-func recipientStatusResponse(recipient RecipientWithOrg, connected bool) RecipientStatus {
+func recipientStatusResponse(recipient RecipientWithOrg, status ConnectionStatus) RecipientStatus {
 	return RecipientStatus{
 		Recipient: recipient.Recipient,
 		OrgId:     recipient.OrgId,
-		Connected: connected,
+		Connected: status == Connected,
+		Status:    status,
 	}
 }
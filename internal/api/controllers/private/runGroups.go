@@ -0,0 +1,76 @@
+package private
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// createRunGroups creates a run group for each organization the runs of a dispatch request belong to
+func createRunGroups(ctx context.Context, db *gorm.DB, input RunInputV2List, service string) (map[string]uuid.UUID, error) {
+	groups := make(map[string]uuid.UUID)
+
+	for _, run := range input {
+		orgID := string(run.OrgId)
+		if _, ok := groups[orgID]; ok {
+			continue
+		}
+
+		group := dbModel.RunGroup{
+			ID:      uuid.New(),
+			OrgID:   orgID,
+			Service: service,
+		}
+
+		if dbResult := db.WithContext(ctx).Create(&group); dbResult.Error != nil {
+			instrumentation.RunGroupCreateError(ctx, dbResult.Error, orgID)
+			return nil, dbResult.Error
+		}
+
+		groups[orgID] = group.ID
+	}
+
+	return groups, nil
+}
+
+// assignRunGroups fills in the group of each created run and drops the groups none of the runs ended up in.
+// The group is read from the database since a replayed run keeps the group it was originally created in.
+func assignRunGroups(ctx context.Context, db *gorm.DB, result RunCreatedList, groups map[string]uuid.UUID) error {
+	runIDs := []uuid.UUID{}
+	for _, created := range result {
+		if created.Id != nil {
+			runIDs = append(runIDs, *created.Id)
+		}
+	}
+
+	var runs []dbModel.Run
+	if len(runIDs) > 0 {
+		if dbResult := db.WithContext(ctx).Select("id", "group_id").Where("id IN ?", runIDs).Find(&runs); dbResult.Error != nil {
+			return dbResult.Error
+		}
+	}
+
+	runGroups := make(map[uuid.UUID]*uuid.UUID, len(runs))
+	for _, run := range runs {
+		runGroups[run.ID] = run.GroupID
+	}
+
+	for _, created := range result {
+		if created.Id != nil {
+			created.GroupId = runGroups[*created.Id]
+		}
+	}
+
+	groupIDs := make([]uuid.UUID, 0, len(groups))
+	for _, groupID := range groups {
+		groupIDs = append(groupIDs, groupID)
+	}
+
+	return db.WithContext(ctx).
+		Where("id IN ?", groupIDs).
+		Where("NOT EXISTS (SELECT 1 FROM runs WHERE runs.group_id = run_groups.id)").
+		Delete(&dbModel.RunGroup{}).Error
+}
@@ -0,0 +1,48 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/api/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+func (this *controllers) ApiInternalV2RunGroupsCancel(ctx echo.Context) error {
+	var input RunGroupCancelInputV2
+
+	err := utils.ReadRequestBody(ctx, &input)
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Error(err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	var runIDs []uuid.UUID
+	dbResult := this.database.WithContext(ctx.Request().Context()).
+		Model(&dbModel.Run{}).
+		Where("runs.org_id = ?", input.OrgId).
+		Where("runs.group_id = ?", input.GroupId).
+		Where("runs.status IN ?", []string{dbModel.RunStatusRunning, dbModel.RunStatusScheduled}).
+		Pluck("runs.id", &runIDs)
+
+	if dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Canceling runs of run group", "org_id", input.OrgId, "group_id", input.GroupId, "count", len(runIDs))
+
+	cancelInputs := make(CancelInputV2List, len(runIDs))
+	for i, runID := range runIDs {
+		cancelInputs[i] = CancelInputV2{
+			OrgId:     input.OrgId,
+			Principal: input.Principal,
+			RunId:     public.RunId(runID),
+		}
+	}
+
+	return ctx.JSON(http.StatusMultiStatus, this.cancelRuns(ctx, cancelInputs))
+}
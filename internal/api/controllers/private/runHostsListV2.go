@@ -48,10 +48,10 @@ func (apii *controllers) ApiInternalV2RunHostsList(ctx echo.Context, params ApiI
 			status := *params.Filter.Status
 			switch status {
 			case dbModel.RunStatusTimeout:
-				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW()")
+				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW()")
 			case dbModel.RunStatusRunning:
 				queryBuilder.Where("run_hosts.status = ?", status)
-				queryBuilder.Where("runs.created_at + runs.timeout * interval '1 second' > NOW()")
+				queryBuilder.Where("COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' > NOW()")
 			default:
 				queryBuilder.Where("run_hosts.status = ?", status)
 			}
@@ -84,6 +84,10 @@ func (apii *controllers) ApiInternalV2RunHostsList(ctx echo.Context, params ApiI
 
 			queryBuilder.Where("run_hosts.inventory_id = ?", inventoryId)
 		}
+
+		whereStats(queryBuilder, "changed", params.Filter.Changed)
+		whereStats(queryBuilder, "failures", params.Filter.Failed)
+		whereStats(queryBuilder, "unreachable", params.Filter.Unreachable)
 	}
 
 	var total int64
@@ -134,6 +138,18 @@ func (apii *controllers) ApiInternalV2RunHostsList(ctx echo.Context, params ApiI
 				if host.InventoryID != nil {
 					runHost.InventoryId = host.InventoryID
 				}
+			case fieldStats:
+				if host.Stats != nil {
+					runHost.Stats = &public.RunHostStats{
+						Ok:          host.Stats.Ok,
+						Changed:     host.Stats.Changed,
+						Failures:    host.Stats.Failures,
+						Unreachable: host.Stats.Unreachable,
+						Skipped:     host.Stats.Skipped,
+						Rescued:     host.Stats.Rescued,
+						Ignored:     host.Stats.Ignored,
+					}
+				}
 			}
 		}
 
@@ -144,7 +160,7 @@ func (apii *controllers) ApiInternalV2RunHostsList(ctx echo.Context, params ApiI
 		Data: hosts,
 		Meta: public.Meta{
 			Count: len(hosts),
-			Total: int(total),
+			Total: utils.IntRef(int(total)),
 		},
 		Links: createLinks("/internal/v2/run_hosts", middleware.GetQueryString(ctx), getLimit(params.Limit), getOffset(params.Offset), int(total)),
 	})
@@ -159,10 +175,11 @@ const (
 	fieldStdout      = "stdout"
 	fieldLinks       = "links"
 	fieldInventoryId = "inventory_id"
+	fieldStats       = "stats"
 )
 
 var (
-	runHostFields        = utils.IndexStrings(fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId)
+	runHostFields        = utils.IndexStrings(fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId, fieldStats)
 	defaultRunHostFields = []string{fieldHost, fieldRun, fieldStatus}
 )
 
@@ -209,7 +226,7 @@ func createLinks(base string, queryString string, limit, offset, total int) publ
 
 	links := public.Links{
 		First: createLink(base, queryString, limit, 0),
-		Last:  createLink(base, queryString, limit, lastPage*limit),
+		Last:  utils.StringRef(createLink(base, queryString, limit, lastPage*limit)),
 	}
 
 	if offset > 0 {
@@ -246,6 +263,8 @@ func mapHostFieldsToSql(field string) string {
 		return "run_hosts.inventory_id"
 	case fieldInventoryId:
 		return "run_hosts.inventory_id"
+	case fieldStats:
+		return "run_hosts.stats"
 	default:
 		panic("unknown field " + field)
 	}
@@ -260,6 +279,19 @@ func inventoryLink(inventoryID *uuid.UUID) *string {
 	return &link
 }
 
+func whereStats(queryBuilder *gorm.DB, counter string, value *bool) {
+	if value == nil {
+		return
+	}
+
+	operator := "="
+	if *value {
+		operator = ">"
+	}
+
+	queryBuilder.Where(fmt.Sprintf("(run_hosts.stats->>'%s')::int %s 0", counter, operator))
+}
+
 func addLabelFilterToQueryAsWhereClause(queryBuilder *gorm.DB, labelFilters map[string][]string) (*gorm.DB, error) {
 	labels := make(map[string]string)
 
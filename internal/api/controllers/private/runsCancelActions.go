@@ -5,6 +5,7 @@ import (
 	"playbook-dispatcher/internal/api/controllers/public"
 	"playbook-dispatcher/internal/api/dispatch"
 	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/google/uuid"
 )
@@ -53,6 +54,10 @@ func handleRunCancelError(err error) *RunCanceled {
 		return runCancelError(http.StatusBadRequest)
 	}
 
+	if utils.IsServiceUnavailable(err) {
+		return runCancelError(http.StatusServiceUnavailable)
+	}
+
 	return runCancelError(http.StatusInternalServerError)
 }
 
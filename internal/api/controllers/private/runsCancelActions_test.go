@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/utils"
 )
 
 func TestHandleRunCancelError(t *testing.T) {
@@ -39,6 +40,11 @@ func TestHandleRunCancelError(t *testing.T) {
 			err:      &dispatch.RunCancelTypeError{},
 			expected: http.StatusBadRequest,
 		},
+		{
+			name:     "ServiceUnavailableError returns 503",
+			err:      &utils.ServiceUnavailableError{Component: "cloud-connector", Reason: "circuit_open"},
+			expected: http.StatusServiceUnavailable,
+		},
 		{
 			name:     "Unknown error returns 500",
 			err:      errors.New("some other error"),
@@ -0,0 +1,62 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	"playbook-dispatcher/internal/api/instrumentation"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// cancelFilterLimit is the maximum number of runs canceled by a single request.
+// Runs are canceled concurrently so the limit bounds the number of requests made to cloud connector at once.
+const cancelFilterLimit = 1000
+
+func (this *controllers) ApiInternalV2RunsCancelFilter(ctx echo.Context) error {
+	var input CancelFilterInputV2
+
+	err := utils.ReadRequestBody(ctx, &input)
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Error(err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	queryBuilder := this.database.WithContext(ctx.Request().Context()).
+		Model(&dbModel.Run{}).
+		Where("runs.org_id = ?", input.OrgId).
+		Where("runs.status IN ?", []string{dbModel.RunStatusRunning, dbModel.RunStatusScheduled}).
+		Where("runs.service = ?", input.Filter.Service)
+
+	if labels := getLabels(input.Filter.Labels); len(labels) > 0 {
+		queryBuilder.Where("runs.labels @> ?", string(utils.MustMarshal(labels)))
+	}
+
+	// one more run is read to tell whether the limit has been exceeded
+	var runIDs []uuid.UUID
+	if dbResult := queryBuilder.Order("runs.created_at").Order("runs.id").Limit(cancelFilterLimit+1).Pluck("runs.id", &runIDs); dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	truncated := len(runIDs) > cancelFilterLimit
+	if truncated {
+		runIDs = runIDs[:cancelFilterLimit]
+		ctx.Response().Header().Set("Truncated", "true")
+	}
+
+	utils.GetLogFromEcho(ctx).Infow("Canceling runs matching filter", "org_id", input.OrgId, "service", input.Filter.Service, "count", len(runIDs), "truncated", truncated)
+
+	cancelInputs := make(CancelInputV2List, len(runIDs))
+	for i, runID := range runIDs {
+		cancelInputs[i] = CancelInputV2{
+			OrgId:     input.OrgId,
+			Principal: input.Principal,
+			RunId:     public.RunId(runID),
+		}
+	}
+
+	return ctx.JSON(http.StatusMultiStatus, this.cancelRuns(ctx, cancelInputs))
+}
@@ -18,8 +18,12 @@ func (this *controllers) ApiInternalV2RunsCancel(ctx echo.Context) error {
 		return ctx.NoContent(http.StatusBadRequest)
 	}
 
+	return ctx.JSON(http.StatusMultiStatus, this.cancelRuns(ctx, input))
+}
+
+func (this *controllers) cancelRuns(ctx echo.Context, input CancelInputV2List) RunCanceledList {
 	// process individual requests concurrently
-	result := input.PMapRunCanceled(func(cancelInputV2 CancelInputV2) *RunCanceled {
+	return input.PMapRunCanceled(func(cancelInputV2 CancelInputV2) *RunCanceled {
 		context := utils.WithOrgId(ctx.Request().Context(), string(cancelInputV2.OrgId))
 		context = utils.WithRequestType(context, instrumentation.LabelAnsibleRequest)
 
@@ -32,6 +36,4 @@ func (this *controllers) ApiInternalV2RunsCancel(ctx echo.Context) error {
 
 		return runCanceled(runID)
 	})
-
-	return ctx.JSON(http.StatusMultiStatus, result)
 }
@@ -1,20 +1,30 @@
 package private
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
 
 	"playbook-dispatcher/internal/api/controllers/public"
 	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/api/dispatch/preflight"
+	"playbook-dispatcher/internal/api/dispatch/quota"
+	"playbook-dispatcher/internal/api/dispatch/registry"
 	"playbook-dispatcher/internal/common/model/generic"
 	"playbook-dispatcher/internal/common/utils"
 
 	"github.com/RedHatInsights/tenant-utils/pkg/tenantid"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
+	"github.com/qri-io/jsonschema"
 	"github.com/spf13/viper"
 )
 
+// extra vars need to be valid Ansible variable names
+var extraVarNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
 func getLabels(input *public.Labels) map[string]string {
 	if input == nil {
 		return map[string]string{}
@@ -76,22 +86,65 @@ func RunInputV2GenericMap(
 	principal := string(runInput.Principal)
 
 	result := generic.RunInput{
-		Recipient:     parsedRecipient,
-		OrgId:         string(runInput.OrgId),
-		Url:           string(runInput.Url),
-		Labels:        getLabels(runInput.Labels),
-		Timeout:       (*int)(runInput.Timeout),
-		Hosts:         parsedHosts,
-		Name:          &playbookName,
-		WebConsoleUrl: (*string)(runInput.WebConsoleUrl),
-		Principal:     &principal,
-		SatId:         parsedSatID,
+		Recipient:         parsedRecipient,
+		OrgId:             string(runInput.OrgId),
+		Url:               string(runInput.Url),
+		Labels:            getLabels(runInput.Labels),
+		Timeout:           (*int)(runInput.Timeout),
+		Hosts:             parsedHosts,
+		Name:              &playbookName,
+		WebConsoleUrl:     (*string)(runInput.WebConsoleUrl),
+		Principal:         &principal,
+		SatId:             parsedSatID,
+		NotBefore:         runInput.NotBefore,
+		NotAfter:          runInput.NotAfter,
+		IdempotencyKey:    runInput.IdempotencyKey,
+		ConcurrencyPolicy: (*string)(runInput.ConcurrencyPolicy),
+		PlaybookDigest:    runInput.PlaybookDigest,
+		PlaybookSignature: runInput.PlaybookSignature,
+		Preflight:         runInput.Preflight != nil && *runInput.Preflight,
+		Mode:              (*string)(runInput.Mode),
 	}
 
 	if runInput.RecipientConfig != nil {
 		result.SatOrgId = runInput.RecipientConfig.SatOrgId
 	}
 
+	if runInput.Callback != nil {
+		result.Callback = &generic.RunCallbackInput{
+			Url:       runInput.Callback.Url,
+			SecretRef: runInput.Callback.SecretRef,
+		}
+	}
+
+	if runInput.ExtraVars != nil {
+		result.ExtraVars = &generic.RunExtraVarsInput{
+			Values: *runInput.ExtraVars,
+		}
+
+		if runInput.SecretVars != nil {
+			result.ExtraVars.Secrets = *runInput.SecretVars
+		}
+	}
+
+	if runInput.RetryPolicy != nil {
+		result.RetryPolicy = &generic.RunRetryPolicyInput{
+			MaxAttempts: runInput.RetryPolicy.MaxAttempts,
+			Backoff:     runInput.RetryPolicy.Backoff,
+			Statuses:    retryPolicyStatuses(runInput.RetryPolicy.Statuses),
+		}
+	}
+
+	return result
+}
+
+func retryPolicyStatuses(input []RetryPolicyStatuses) []string {
+	result := make([]string, len(input))
+
+	for i, status := range input {
+		result[i] = string(status)
+	}
+
 	return result
 }
 
@@ -108,6 +161,10 @@ func validateSatelliteFields(runInput RunInputV2) error {
 		return nil
 	}
 
+	if runInput.Mode != nil && *runInput.Mode == public.RunModeCheck {
+		return fmt.Errorf("Check mode is not supported for Satellite")
+	}
+
 	if runInput.Hosts == nil {
 		return fmt.Errorf("Hosts need to be defined")
 	}
@@ -125,6 +182,98 @@ func validateSatelliteFields(runInput RunInputV2) error {
 	return nil
 }
 
+// callback keys are named after the PSK principal that owns them so that a caller cannot have callbacks signed with the key of another service
+func validateCallback(runInput RunInputV2, secrets map[string]string, principal string, allowedHosts utils.HostAllowlist) error {
+	if runInput.Callback == nil {
+		return nil
+	}
+
+	if _, ok := secrets[runInput.Callback.SecretRef]; !ok {
+		return fmt.Errorf("Unknown callback secret_ref: %s", runInput.Callback.SecretRef)
+	}
+
+	if runInput.Callback.SecretRef != principal {
+		return fmt.Errorf("Callback secret_ref %s does not belong to %s", runInput.Callback.SecretRef, principal)
+	}
+
+	if err := allowedHosts.CheckURL(runInput.Callback.Url, true); err != nil {
+		return fmt.Errorf("Invalid callback URL: %w", err)
+	}
+
+	return nil
+}
+
+func validateSchedule(runInput RunInputV2) error {
+	if runInput.NotAfter == nil {
+		return nil
+	}
+
+	if runInput.NotBefore == nil {
+		return fmt.Errorf("not_after requires not_before to be defined")
+	}
+
+	if !runInput.NotAfter.After(*runInput.NotBefore) {
+		return fmt.Errorf("not_after needs to be later than not_before")
+	}
+
+	return nil
+}
+
+func validatePlaybookReference(runInput RunInputV2, registryEnabled bool) error {
+	if runInput.PlaybookDigest == nil && runInput.PlaybookSignature == nil {
+		return nil
+	}
+
+	if !registryEnabled {
+		return fmt.Errorf("playbook_digest and playbook_signature require the playbook registry to be enabled")
+	}
+
+	return nil
+}
+
+// the values of the extra vars are left out of the errors as they may be secret
+func validateExtraVars(ctx context.Context, runInput RunInputV2) error {
+	if runInput.ExtraVars == nil {
+		if runInput.ExtraVarsSchema != nil || runInput.SecretVars != nil {
+			return fmt.Errorf("extra_vars_schema and secret_vars require extra_vars to be defined")
+		}
+
+		return nil
+	}
+
+	for name := range *runInput.ExtraVars {
+		if !extraVarNamePattern.MatchString(name) {
+			return fmt.Errorf("Invalid extra var name: %s", name)
+		}
+	}
+
+	if runInput.SecretVars != nil {
+		for _, name := range *runInput.SecretVars {
+			if _, ok := (*runInput.ExtraVars)[name]; !ok {
+				return fmt.Errorf("Secret var not defined in extra_vars: %s", name)
+			}
+		}
+	}
+
+	if runInput.ExtraVarsSchema == nil {
+		return nil
+	}
+
+	var schema jsonschema.Schema
+	if err := json.Unmarshal(utils.MustMarshal(runInput.ExtraVarsSchema), &schema); err != nil {
+		return fmt.Errorf("Invalid extra_vars_schema: %w", err)
+	}
+
+	errors, err := schema.ValidateBytes(ctx, utils.MustMarshal(runInput.ExtraVars))
+	if err != nil {
+		return err
+	} else if len(errors) > 0 {
+		return fmt.Errorf("extra_vars do not match extra_vars_schema: %s: %s", errors[0].PropertyPath, errors[0].Message)
+	}
+
+	return nil
+}
+
 func runCreateError(code int, message string) *RunCreated {
 	return &RunCreated{
 		Code:    code,
@@ -145,6 +294,30 @@ func handleRunCreateError(err error) *RunCreated {
 		return runCreateError(http.StatusBadRequest, "Block listed org")
 	}
 
+	if _, ok := err.(*dispatch.RecipientBusyError); ok {
+		return runCreateError(http.StatusConflict, "Another playbook run is running on the recipient")
+	}
+
+	if _, ok := err.(*dispatch.IdempotencyKeyMismatchError); ok {
+		return runCreateError(http.StatusUnprocessableEntity, err.Error())
+	}
+
+	if quotaErr, ok := err.(*quota.ExceededError); ok {
+		return runCreateError(http.StatusTooManyRequests, quotaErr.Error())
+	}
+
+	if _, ok := err.(*registry.FetchError); ok {
+		return runCreateError(http.StatusBadGateway, err.Error())
+	}
+
+	if registry.IsRejected(err) {
+		return runCreateError(http.StatusBadRequest, err.Error())
+	}
+
+	if _, ok := err.(*preflight.LintError); ok {
+		return runCreateError(http.StatusBadRequest, err.Error())
+	}
+
 	return runCreateError(http.StatusInternalServerError, "Unexpected error during processing")
 }
 
@@ -160,3 +333,10 @@ func invalidRequest(ctx echo.Context, err error) error {
 		Message: err.Error(),
 	})
 }
+
+func serviceUnavailable(ctx echo.Context, err error) error {
+	utils.GetLogFromEcho(ctx).Warnw("Failing request fast", "error", err)
+	return ctx.JSON(http.StatusServiceUnavailable, Error{
+		Message: err.Error(),
+	})
+}
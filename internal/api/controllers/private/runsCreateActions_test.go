@@ -7,6 +7,8 @@ import (
 
 	"playbook-dispatcher/internal/api/controllers/public"
 	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/api/dispatch/preflight"
+	"playbook-dispatcher/internal/api/dispatch/registry"
 	"playbook-dispatcher/internal/common/model/generic"
 	"playbook-dispatcher/internal/common/utils"
 
@@ -40,6 +42,30 @@ func TestHandleRunCreateError(t *testing.T) {
 			expectedCode: http.StatusBadRequest,
 			expectedMsg:  "Block listed org",
 		},
+		{
+			name:         "InvalidSignatureError returns 400",
+			err:          &registry.InvalidSignatureError{Digest: "sha256:1234"},
+			expectedCode: http.StatusBadRequest,
+			expectedMsg:  "Playbook signature not valid: sha256:1234",
+		},
+		{
+			name:         "URLNotAllowedError returns 400",
+			err:          &registry.URLNotAllowedError{URL: "http://169.254.169.254", Reason: "Host not allowed: 169.254.169.254"},
+			expectedCode: http.StatusBadRequest,
+			expectedMsg:  "Playbook cannot be fetched from http://169.254.169.254: Host not allowed: 169.254.169.254",
+		},
+		{
+			name:         "FetchError returns 502",
+			err:          &registry.FetchError{URL: "http://example.com", Reason: "timeout"},
+			expectedCode: http.StatusBadGateway,
+			expectedMsg:  "Playbook cannot be fetched from http://example.com: timeout",
+		},
+		{
+			name:         "LintError returns 400",
+			err:          &preflight.LintError{Reason: "play 0 does not define hosts"},
+			expectedCode: http.StatusBadRequest,
+			expectedMsg:  "Playbook preflight failed: play 0 does not define hosts",
+		},
 		{
 			name:         "Unknown error returns 500",
 			err:          errors.New("some other error"),
@@ -91,7 +117,10 @@ func TestRunInputV2GenericMap(t *testing.T) {
 			SatId:    &satIdString,
 			SatOrgId: &satOrgId,
 		},
-		Labels: &public.Labels{"foo": "bar"},
+		Labels:     &public.Labels{"foo": "bar"},
+		Preflight:  utils.BoolRef(true),
+		ExtraVars:  &ExtraVars{"package": "openssl", "token": "s3cr3t"},
+		SecretVars: &SecretVars{"token"},
 	}
 
 	cfg := viper.New()
@@ -130,4 +159,10 @@ func TestRunInputV2GenericMap(t *testing.T) {
 	if v, ok := result.Labels["foo"]; !ok || v != "bar" {
 		t.Errorf("Labels: got %v, want foo=bar", result.Labels)
 	}
+	if !result.Preflight {
+		t.Errorf("Preflight: got %v, want true", result.Preflight)
+	}
+	if result.ExtraVars == nil || result.ExtraVars.Values["package"] != "openssl" || len(result.ExtraVars.Secrets) != 1 || result.ExtraVars.Secrets[0] != "token" {
+		t.Errorf("ExtraVars: got %v, want package=openssl with secret token", result.ExtraVars)
+	}
 }
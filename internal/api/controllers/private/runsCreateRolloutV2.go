@@ -0,0 +1,180 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultRolloutPause            = 0
+	defaultRolloutSuccessThreshold = 100
+	defaultRolloutFailureThreshold = 0
+)
+
+func (this *controllers) ApiInternalV2RunsCreateRollout(ctx echo.Context, params ApiInternalV2RunsCreateRolloutParams) error {
+	var input RolloutInputV2
+
+	err := utils.ReadRequestBody(ctx, &input)
+	if err != nil {
+		utils.GetLogFromEcho(ctx).Error(err)
+		return ctx.NoContent(http.StatusBadRequest)
+	}
+
+	runs := RunInputV2List(input.Runs)
+
+	for _, run := range runs {
+		if err := this.validateRunInputV2(ctx, run); err != nil {
+			return invalidRequest(ctx, err)
+		}
+	}
+
+	if err := validateRollout(input); err != nil {
+		instrumentation.InvalidRolloutRequest(ctx, err)
+		return invalidRequest(ctx, err)
+	}
+
+	applyIdempotencyKey(runs, params.IdempotencyKey)
+
+	groups, err := createRunGroups(ctx.Request().Context(), this.database, runs, middleware.GetPSKPrincipal(ctx.Request().Context()))
+	if err != nil {
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	waves := splitWaves(runs, input.Strategy)
+	groupID := groups[string(runs[0].OrgId)]
+
+	if err := createRollout(ctx.Request().Context(), this.database, groupID, input.Strategy, len(waves)); err != nil {
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	// only the canary wave is dispatched right away, the runs of the remaining waves are held until the scheduler releases them
+	result := RunCreatedList{}
+	rejected := 0
+	for wave, waveRuns := range waves {
+		created := this.dispatchRunsV2(ctx, waveRuns, groups, utils.IntRef(wave))
+		result = append(result, created...)
+
+		if wave == 0 {
+			rejected = countRejected(created)
+		}
+	}
+
+	if err := startRollout(ctx.Request().Context(), this.database, groupID, len(waves), rejected); err != nil {
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if err := assignRunGroups(ctx.Request().Context(), this.database, result, groups); err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusMultiStatus, result)
+}
+
+func validateRollout(input RolloutInputV2) error {
+	if input.Strategy.Canary != nil && input.Strategy.CanaryPercentage != nil {
+		return fmt.Errorf("canary and canary_percentage cannot be used together")
+	}
+
+	for _, run := range input.Runs {
+		if run.OrgId != input.Runs[0].OrgId {
+			return fmt.Errorf("all runs of a rollout need to belong to the same organization")
+		}
+
+		// not_before is used to release the runs of a wave
+		if run.NotBefore != nil {
+			return fmt.Errorf("not_before cannot be used together with a rollout")
+		}
+	}
+
+	return nil
+}
+
+// splitWaves splits the runs into the canary wave followed by waves of wave_size runs, keeping the order of the request
+func splitWaves(input RunInputV2List, strategy RolloutStrategy) []RunInputV2List {
+	canary := 1
+	if strategy.Canary != nil {
+		canary = *strategy.Canary
+	} else if strategy.CanaryPercentage != nil {
+		canary = (len(input)**strategy.CanaryPercentage + 99) / 100
+	}
+
+	canary = utils.Min(canary, len(input))
+
+	waves := []RunInputV2List{input[:canary]}
+	for i := canary; i < len(input); i += strategy.WaveSize {
+		waves = append(waves, input[i:utils.Min(i+strategy.WaveSize, len(input))])
+	}
+
+	return waves
+}
+
+// createRollout stores the progress of the rollout of the given run group.
+// The rollout is pending, i.e. the scheduler does not move it forward, until the runs of all the waves are created.
+func createRollout(ctx context.Context, db *gorm.DB, groupID uuid.UUID, strategy RolloutStrategy, waves int) error {
+	rollout := dbModel.Rollout{
+		GroupID:          groupID,
+		WaveSize:         strategy.WaveSize,
+		Pause:            valueOrDefault(strategy.Pause, defaultRolloutPause),
+		SuccessThreshold: valueOrDefault(strategy.SuccessThreshold, defaultRolloutSuccessThreshold),
+		FailureThreshold: valueOrDefault(strategy.FailureThreshold, defaultRolloutFailureThreshold),
+		Status:           dbModel.RolloutStatusPending,
+		Waves:            waves,
+	}
+
+	if dbResult := db.WithContext(ctx).Create(&rollout); dbResult.Error != nil {
+		instrumentation.RolloutCreateError(ctx, dbResult.Error, groupID)
+		return dbResult.Error
+	}
+
+	return nil
+}
+
+// startRollout hands the rollout over to the scheduler once the runs of all the waves are created.
+// A rollout that consists of the canary wave only is completed right away.
+func startRollout(ctx context.Context, db *gorm.DB, groupID uuid.UUID, waves int, rejected int) error {
+	status := dbModel.RolloutStatusInProgress
+	if waves == 1 {
+		status = dbModel.RolloutStatusCompleted
+	}
+
+	dbResult := db.WithContext(ctx).
+		Model(&dbModel.Rollout{}).
+		Where("group_id = ?", groupID).
+		Where("status = ?", dbModel.RolloutStatusPending).
+		Updates(map[string]interface{}{"status": status, "rejected": rejected})
+
+	if dbResult.Error != nil {
+		instrumentation.RolloutCreateError(ctx, dbResult.Error, groupID)
+		return dbResult.Error
+	}
+
+	return nil
+}
+
+func countRejected(runs RunCreatedList) (result int) {
+	for _, run := range runs {
+		if run.Code != http.StatusCreated {
+			result++
+		}
+	}
+
+	return
+}
+
+func valueOrDefault(value *int, defaultValue int) int {
+	if value == nil {
+		return defaultValue
+	}
+
+	return *value
+}
@@ -1,6 +1,7 @@
 package private
 
 import (
+	"fmt"
 	"net/http"
 	"playbook-dispatcher/internal/api/instrumentation"
 	"playbook-dispatcher/internal/api/middleware"
@@ -11,7 +12,7 @@ import (
 )
 
 //go:generate fungen -types RunInputV2,*RunCreated:RunCreatedV2  -methods PMap -package private -filename utils.v2.gen.go
-func (this *controllers) ApiInternalV2RunsCreate(ctx echo.Context) error {
+func (this *controllers) ApiInternalV2RunsCreate(ctx echo.Context, params ApiInternalV2RunsCreateParams) error {
 	var input RunInputV2List
 
 	err := utils.ReadRequestBody(ctx, &input)
@@ -21,15 +22,63 @@ func (this *controllers) ApiInternalV2RunsCreate(ctx echo.Context) error {
 	}
 
 	for _, run := range input {
-		err = validateSatelliteFields(run)
-		if err != nil {
-			instrumentation.InvalidSatelliteRequest(ctx, err)
+		if err := this.validateRunInputV2(ctx, run); err != nil {
 			return invalidRequest(ctx, err)
 		}
 	}
 
-	// process individual requests concurrently
-	result := input.PMapRunCreatedV2(func(runInputV2 RunInputV2) *RunCreated {
+	applyIdempotencyKey(input, params.IdempotencyKey)
+
+	groups, err := createRunGroups(ctx.Request().Context(), this.database, input, middleware.GetPSKPrincipal(ctx.Request().Context()))
+	if err != nil {
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	result := this.dispatchRunsV2(ctx, input, groups, nil)
+
+	if err := assignRunGroups(ctx.Request().Context(), this.database, result, groups); err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	return ctx.JSON(http.StatusMultiStatus, result)
+}
+
+func (this *controllers) validateRunInputV2(ctx echo.Context, run RunInputV2) error {
+	if err := validateSatelliteFields(run); err != nil {
+		instrumentation.InvalidSatelliteRequest(ctx, err)
+		return err
+	}
+
+	principal := middleware.GetPSKPrincipal(ctx.Request().Context())
+
+	if err := validateCallback(run, this.callbackSecrets, principal, this.outboundHosts); err != nil {
+		instrumentation.InvalidCallbackRequest(ctx, err)
+		return err
+	}
+
+	if err := validateSchedule(run); err != nil {
+		instrumentation.InvalidScheduleRequest(ctx, err)
+		return err
+	}
+
+	if err := validatePlaybookReference(run, this.config.GetBool("playbook.registry.enabled")); err != nil {
+		instrumentation.InvalidPlaybookRequest(ctx, err)
+		return err
+	}
+
+	if err := validateExtraVars(ctx.Request().Context(), run); err != nil {
+		instrumentation.InvalidExtraVarsRequest(ctx, err)
+		return err
+	}
+
+	return nil
+}
+
+// dispatchRunsV2 processes individual requests concurrently. Each run joins the run group of its organization.
+// The runs of a rollout wave are given the index of the wave.
+func (this *controllers) dispatchRunsV2(ctx echo.Context, input RunInputV2List, groups map[string]uuid.UUID, wave *int) RunCreatedList {
+	return input.PMapRunCreatedV2(func(runInputV2 RunInputV2) *RunCreated {
 		context := utils.WithOrgId(ctx.Request().Context(), string(runInputV2.OrgId))
 		context = utils.WithRequestType(context, getRequestTypeLabel(runInputV2))
 
@@ -46,6 +95,8 @@ func (this *controllers) ApiInternalV2RunsCreate(ctx echo.Context) error {
 		}
 
 		runInput := RunInputV2GenericMap(runInputV2, runInputV2.Recipient, hosts, parsedSatID, this.config)
+		runInput.GroupId = utils.UUIDRef(groups[string(runInputV2.OrgId)])
+		runInput.RolloutWave = wave
 
 		runID, _, err := this.dispatchManager.ProcessRun(context, runInput.OrgId, middleware.GetPSKPrincipal(context), runInput)
 
@@ -55,8 +106,20 @@ func (this *controllers) ApiInternalV2RunsCreate(ctx echo.Context) error {
 
 		return runCreated(runID)
 	})
+}
 
-	return ctx.JSON(http.StatusMultiStatus, result)
+// applyIdempotencyKey derives the key of runs that do not define their own idempotency_key from the Idempotency-Key header.
+// The position of the run within the request is appended so that each run of a retried request maps to its original run.
+func applyIdempotencyKey(input RunInputV2List, key *IdempotencyKey) {
+	if key == nil {
+		return
+	}
+
+	for i := range input {
+		if input[i].IdempotencyKey == nil {
+			input[i].IdempotencyKey = utils.StringRef(fmt.Sprintf("%s:%d", *key, i))
+		}
+	}
 }
 
 func getRequestTypeLabel(run RunInputV2) string {
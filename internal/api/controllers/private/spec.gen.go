@@ -28,15 +28,27 @@ type ServerInterface interface {
 	// Cancel Playbook Runs
 	// (POST /internal/v2/cancel)
 	ApiInternalV2RunsCancel(ctx echo.Context) error
+	// Cancel Playbook Runs matching a filter
+	// (POST /internal/v2/cancel/filter)
+	ApiInternalV2RunsCancelFilter(ctx echo.Context) error
 	// Obtain Connection Status of recipient(s) based on a list of host IDs
 	// (POST /internal/v2/connection_status)
 	ApiInternalHighlevelConnectionStatus(ctx echo.Context) error
 	// Dispatch Playbooks
 	// (POST /internal/v2/dispatch)
-	ApiInternalV2RunsCreate(ctx echo.Context) error
+	ApiInternalV2RunsCreate(ctx echo.Context, params ApiInternalV2RunsCreateParams) error
+	// Dispatch Playbooks in waves
+	// (POST /internal/v2/dispatch/rollout)
+	ApiInternalV2RunsCreateRollout(ctx echo.Context, params ApiInternalV2RunsCreateRolloutParams) error
+	// Upload a Playbook to the playbook registry
+	// (POST /internal/v2/playbooks)
+	ApiInternalV2PlaybooksUpload(ctx echo.Context) error
 	// Obtain connection status of recipient(s)
 	// (POST /internal/v2/recipients/status)
 	ApiInternalV2RecipientsStatus(ctx echo.Context) error
+	// Cancel a run group
+	// (POST /internal/v2/run_groups/cancel)
+	ApiInternalV2RunGroupsCancel(ctx echo.Context) error
 	// List hosts involved in Playbook runs
 	// (GET /internal/v2/run_hosts)
 	ApiInternalV2RunHostsList(ctx echo.Context, params ApiInternalV2RunHostsListParams) error
@@ -68,6 +80,15 @@ func (w *ServerInterfaceWrapper) ApiInternalV2RunsCancel(ctx echo.Context) error
 	return err
 }
 
+// ApiInternalV2RunsCancelFilter converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunsCancelFilter(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunsCancelFilter(ctx)
+	return err
+}
+
 // ApiInternalHighlevelConnectionStatus converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiInternalHighlevelConnectionStatus(ctx echo.Context) error {
 	var err error
@@ -81,8 +102,66 @@ func (w *ServerInterfaceWrapper) ApiInternalHighlevelConnectionStatus(ctx echo.C
 func (w *ServerInterfaceWrapper) ApiInternalV2RunsCreate(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2RunsCreateParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunsCreate(ctx, params)
+	return err
+}
+
+// ApiInternalV2RunsCreateRollout converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunsCreateRollout(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiInternalV2RunsCreateRolloutParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey IdempotencyKey
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Idempotency-Key, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Idempotency-Key: %s", err))
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.ApiInternalV2RunsCreate(ctx)
+	err = w.Handler.ApiInternalV2RunsCreateRollout(ctx, params)
+	return err
+}
+
+// ApiInternalV2PlaybooksUpload converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2PlaybooksUpload(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2PlaybooksUpload(ctx)
 	return err
 }
 
@@ -95,6 +174,15 @@ func (w *ServerInterfaceWrapper) ApiInternalV2RecipientsStatus(ctx echo.Context)
 	return err
 }
 
+// ApiInternalV2RunGroupsCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiInternalV2RunGroupsCancel(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiInternalV2RunGroupsCancel(ctx)
+	return err
+}
+
 // ApiInternalV2RunHostsList converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiInternalV2RunHostsList(ctx echo.Context) error {
 	var err error
@@ -173,9 +261,13 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.POST(baseURL+"/internal/dispatch", wrapper.ApiInternalRunsCreate)
 	router.POST(baseURL+"/internal/v2/cancel", wrapper.ApiInternalV2RunsCancel)
+	router.POST(baseURL+"/internal/v2/cancel/filter", wrapper.ApiInternalV2RunsCancelFilter)
 	router.POST(baseURL+"/internal/v2/connection_status", wrapper.ApiInternalHighlevelConnectionStatus)
 	router.POST(baseURL+"/internal/v2/dispatch", wrapper.ApiInternalV2RunsCreate)
+	router.POST(baseURL+"/internal/v2/dispatch/rollout", wrapper.ApiInternalV2RunsCreateRollout)
+	router.POST(baseURL+"/internal/v2/playbooks", wrapper.ApiInternalV2PlaybooksUpload)
 	router.POST(baseURL+"/internal/v2/recipients/status", wrapper.ApiInternalV2RecipientsStatus)
+	router.POST(baseURL+"/internal/v2/run_groups/cancel", wrapper.ApiInternalV2RunGroupsCancel)
 	router.GET(baseURL+"/internal/v2/run_hosts", wrapper.ApiInternalV2RunHostsList)
 	router.GET(baseURL+"/internal/version", wrapper.ApiInternalVersion)
 
@@ -184,65 +276,120 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9Q7W1MbObN/RTXnPOxW2WAMZBM/HUJ2T6gvCSkI2a3apSjNqMdWMpYmksbApvjvX7Wk",
-	"0VxtjxPYyxvY6lbfb2p/jRK5zKUAYXQ0+xrlVNElGFDuvyLOeHLzhi+5wf8Z6ETx3HApoln0lt7xZbEk",
-	"oljGoIhMiQJdZEYTI4kCUygRjSKOR78UoO6jUSToEqJZlFmEo0gnC1hShzmlRWai2fFkFC0d4mg2neB/",
-	"XLj/DkaRuc8RngsDc1DRw8OopPE8TTX0EHkmGE+oAU3MAog2VBku5iSXmuMJpBq/sAQSBRk1fAXIAH6K",
-	"ssnAANFg8CQ3sERE1JAlNcmiAl3DqHRU9XJaZ22yibWLQryW2vzCIWO6y+ErSLkATVL7PZIegxc/MMKF",
-	"JVKBzqXQsPcH6gTu8kwyiGZGFdBPucPWoDxXMgdlODgiqGny83u0kNryaqgpEFQVIroeRVZqeBQE8hrO",
-	"4de109owWeDnGReftRXoCoSR6v6GM8TjJaSN4mIePYQPqFL03grMfyDjT5AYPKHNfYafMID8PHzalmtm",
-	"QHXlepJl8laTVCqS2iNoNzHVwIgUZEUVl4UmieL4FR0qVXvXeqk2eJ59jf5XQRrNov/Zr9x038Hqfc/G",
-	"WQlyxt4VWUbjDJBJlO7sayTKjzxVrevsJR3BZjSGTA+8/6IQb+z5+u0a1IonMBDFpTtdIejXpTWUgRjt",
-	"4W0Iu8aBgvOuYq96SdkFfClA29CSSGFA2D9pnmcYWLgU+5+0tLKulLqJwp+VkujfD6OWwb2kjJSXPYyi",
-	"X6SKOWMgnv7mkyQBrcuoN+crEBgxZKESIFwTIQ2h6A7ArIg8QrzvlIoEsjORF+bjtGvPUs0HWPK5mp8x",
-	"65mKi4TnNNsG8T4cdKY+3F0uCnHGvKK/FFwBw5DkUYxKguukXPfYjhNlh90laE3n0A0mr4slRZlShvZI",
-	"AMFJeRpDB8VMg0nVpQTinJFkIOZmgTo4iDoRsMVDia6P3td8vngDK8guIOE5B2EugzuF+LxJegHuV24W",
-	"p1IISJC1M5HKbigeRRhYz1hPOmYgDE85aEKJgkQqVqZgBBmHYEbKCGKz5BsrhnoJUAUrhNNIlbOijk4w",
-	"2zT5fHKSlvTuzF127LK8/++gK6idHKSl8GCrjsU+vQeZrOUZ+ZRqTgX/08YUV970xIEYMinmGCUiy2EQ",
-	"wGSrPN7X3bpJyZUGhcmxFHmhQREsghRNbKV2y42rsyrpV97yaeHque0qCfZ7KkXK511CVHlgrHNIeMoT",
-	"ktijhXJykfakjto5VFPjNbhGxqrk7ZIayDJugHChDQbOsjwrCs7I6mh/dYz1xpKaBpeUHsYHKaXj42fp",
-	"4fiIHRyNn0+Pn4+fHRyzgwOYTibPJtEo8pAzpGjM2RiRRj2iQIIrs9tGdMM2UBlcVIw0yDyYHh4db9NE",
-	"XyLuiUk0y87TaPb7DkHpXCF3be9PXKgCtqk1uF2AWYAilCQhsmHMBW1onHG9AFbZYTCUSraxlBlQ0XHQ",
-	"6vKub17XGf9gv9vipYjAdVkeivweFDEir7iCxJDT8soReScFXKOGfNmta1pj9rQ/jPWpFDZxDPWinizw",
-	"vbm/kuvgRB7IacDfGC/NQaZjRe+9Yju1QeCO5qYrDQIM/Fb17KaONSmUQlWrQhAHUTpm3Q5LFVcGhyrW",
-	"9X/VIrkR0tyUQQ36eyp9r8s0Oagu8Im+rxtr1Fc1YkPaamks6KAh14qkILLrTTGkDAV/rzluZ7+XiUK4",
-	"ehp66pjE9pZta/E2gV9WhuF6iFpsnk6m3QnDoxTOlqqAaR1TCqh5fJ4O+njalZ/R+qLdFvnkbU+VfiXg",
-	"Lree5Ut5VthyPVcSOylXkWyu1i3ra+Rl26mutGiSyGKwQZ740w+jqgTeGBH9vbae3nkK4EYAjxHHDV+C",
-	"LHaA/uABHkZRobKBcFcq2+ilpawdzk16el0Kt2k85/YPmmX3I8KFq82wrKCxLAyxGiFcrGS2qqZ07zN6",
-	"H0v52Ub7hAoSA5rUijNge3+IDwuuG7i4xnqZYeOeKxhji46ZA8Fv8IZQvOu9P8RbqUCuQI0INyXyEjqx",
-	"Dtqsf2IwtwACm6I2OkIFsyyQMK9yg8WQMlqGKzSPM7BIenpjRGR7AKrJZyFvBZJ04mAaN1x5crkrjO6t",
-	"0DwdZXZUkEtldDnoLD0WJZP5weOWIqc9g2un57Id5KFPdJ2Sx17dmabx0U+T6WRMn6VsfPT8iI2fT+Lj",
-	"MaOTCT2ih5M4ndbr9rUFexEHCm6WVNA5qF7aLmsHyVt3cDuZhy/iQzqZvhgfH05fjI8myU9jyqbT8cHx",
-	"0TQ+TuPUlfVbyOwr7NvNbukyfZOivzRGuXHsIKDSJ98hyOCGvXyV+M7B1qOVxEnoegcVxb5J/muj8Si6",
-	"hRgp1TKDm+HAv0J86oC2BfWe4Z6j0lvEmjCv60XZsGlZrZDr9wNdq4kGo/QgPRjrfcm/ZxLRaoqeZBrR",
-	"ufQjKM3d2Lx5m/+ivOrk/VkD4Wq6PXW0Si97Ra4gcZp2LzDbWDQgqLXW3WZs/mpvIyc9efaEoDNrQ5c5",
-	"uV2A0zcIo+7JLdW+BmB19TFqYIxA0foL+56fet6d1oG/CQGcMsZdyfS+kRg6kC2uAhhZgqGMGuprrHZF",
-	"tUdOa1VP810vL1QuNei9qCcEhGdw8XkDpSnNdOeBLeWqr+QJD80ZF5/Ldxd7luR0Du1Xafuq3qeDjA7G",
-	"jkd3Qy7gbihyPLob8lzBisu++UfvBeXxXS5pJQKnCi+z6/VqfgvuYXujlts1Ybu+D+/v6N0WctRpfUOE",
-	"qKPqLlOUqOrB6HjS1/oaafpG7Pbjni0Nu8KAxl/fYghXHBwc9S4lNHtY1yK5izfIdHB8D8GvirvHhwfP",
-	"py8m3xoQG/Xbtne5+ktE3ggdV1WfpUHUH0jq5zCLwp0BheHIz63IDyFB/bjX4OwXfkdOFTc8oRk5/fiz",
-	"HpxgLtzj/iONBxKpnNPJ3cZBpxWcq3J9CrmhQ4mostU3TW7+LdX/99bx37RNsfPOxEUh/APM99b9OdvN",
-	"DK4cwMnf2zWsC14dS+8+Ygr+pYByLIHhrBysuDWxW6k+lyNM95BULZlsdPLXfmDSbZW7RKCJhnvDhIS6",
-	"+YgvlTtDlWjA/GPrfCIrC6NhloJMuVqqWlMaBvl9Ju0XzLqTusLkhSG5kqxIgJH4HkO5wMxYyiuUkVJ0",
-	"JykDBiF9zG/Y/SoVvKWI3n6TXr+3N6jpbFliT+O5i+6D0pe+xBoAY6uxdt1hefBoShKuNwpjmNfKlNBG",
-	"yzBkOrd2DW6XnmaNpvtYuagnk231lHV+I8ntgicLQr3hBha5JpQxBVo3G7/tvF6ueb089e+V1VtlR6Ll",
-	"Y6V3smgU6cIuoCEFlGeFsv2mzyejKCknKdcbKfpQZaywk3r4bDLpNIxLrHuQMg2JFEwTmhpQXkT2samw",
-	"o3XMKZyBwmaR8gwYYYVbDQ6khU3lZ5Oj55MtG72B2Msqnbfmt+4Lv4Kj+Hxub68iTkuSw4rF9nbl7GsL",
-	"cGiv3lqqrG30fosqh95aFQe7TjRsy+uLkV3HGleqb1np4o31qLJpKtXRcB07TlyHtll19F5glZ9LLkzY",
-	"yNT+ScY79S3ExBc8yLaCanMq5YKRpVTQ8+bUbWo+2KkDZAzNXfoHKxIXhiz4fJHdE13M56ANsL0ui5vX",
-	"fGwd4bZCEikMTaz6YEl5Fs2iT/JPSP9PAVtQs5fIZXesEyz9Fdc5FlGgbLQq18Ds/GZdmtaYp90TW1iB",
-	"ICtOyWkmC1buyEhlpz3c2Gas78Iz4Vs5NwtclZPD6GBvsjex/UAOguY8mkWHe5O9Q+zyqVnYuLjPPfQ+",
-	"8xhtMu6t3cKdusZDoZG3Fsn26U0bqQB5U66OZHgQo5ZbX7RTLEw3oWCNTnJeMlMNnyOXVUGbl5Ld77Rm",
-	"PHRk7V6yd1mJfOjsYE8nPz3aCnR98t6zCH3+H6T1aDJZhycQtl/bDLcr0cVySdV9TZeVJu2ByhxW030X",
-	"B9fbg3s8qIyBIN39BrFJ1R+n1evFUyu7uQj+D9N4eIt5GpU7/E1t9Sg9vK3fVE1Mv/5fFjxjmmRcm8bG",
-	"3w/6RxsAeGd1sb6wWz+sgNAV5S7TbjCV1xjwYQVZtdV3GX7H8412s21vrLa13WsEk8e7bd36+xMZxHls",
-	"KBekkiW5DPVwQz/h90Q0KNuW7Gevegzon5VHfHD5SzPJPy+ybM4lOyeGYBx6f1uMOHv0GPBxGtxDf7fz",
-	"7/6rErfAvas+J09IVW0c2qLjCYNGbQVL9waNHqvxC1qWs3nfz2Av7COSroUZN1qw+1LuSQN9v7uWVu84",
-	"9R65EhloBMJC35bVLrq4l1Rd/tbULZ0RnSugjNBESa3JssgMzzNo43wnyRLUHNFIRRiwImgQS/4cFHYe",
-	"bjBnFlxXa2hjwvdgj/C0HHP+RniT/Hq/o8mJjXovkUpBzK0kuogram95lhG449qMiBTQlMxvVbNhkeAB",
-	"bEFeukWzzVHSJrs33E4L67+wXvPzhurIfu9PVR9GO8PZH/MOh3O/+B5+3v/6+uH6CXN4e7L5eF6IIIfb",
-	"QaofZDb9FhW7zXPaPlvtoMy3/2p9zg1RsOKa+/HzyfszO+CIC54Zkiq53Jyp/W1PqJzyiiF58P/BkMZ5",
-	"bOlLf1izC4Bdtd/VmkX70cP1w38DAAD///osl/u6QAAA",
+	"H4sIAOQ41GoC/+09a3PbRpJ/BcXbD3YVKYEUpcj+dLLsrHXxqyzbu3W2jzsEBiQiEEDwkMyk9N+vu+eB",
+	"ATAgQFlysldXlUrJ5Dx6enr63c0/Rl6ySZOYx0U+evrHKGUZ2/CCZ+Jf5TIKvcWrcBMW+G+f514WpkWY",
+	"xKOno9fsW7gpN05cbpY8c5LAyXheRkXuFAn8WZRZPBqPQhz6W8mzLfwjhsXhnxEtOB7l3ppvmFg5YDB1",
+	"9PTYHY82YuHR05mL/wpj8a/peFRsU5wfxgVf8Wx0eztWML4NgpxbgLyI/dBjBQeg1tzJC5YVYbxy0iQP",
+	"cQRCjV8QgAB0xIrwmuMB8FPETQTYcGBpHBkWfIMLscLZsMJbV1M7DpoIqKwnNY/m7jra+zJ+meTFzyGP",
+	"/Lx9wuc8CGM4X0DfI+hLLtHPfSeMCUi4GbjlnB98wTvh39Io8WG7Iiu5HXKxWg3yNEtSDujjAghW1M/z",
+	"ebQGKHFGwYoSp2ZlPPoKyyPWcCiP8ax6HH5tjM4LPynx8yiMr3JC6DWQZZJtF6EvB+a4nsRUXmRwk6Nb",
+	"/QHLMrYlxMkPkuWv3CtwRF5sI/zE5zx9qz9t4jcCum/j9yyKkhtAb5IBinEI0s+S5YBcoJ9rloVJmTsw",
+	"Ab9iQ7FLe3Vj11uzeMX9NjRv42gLl+pFpc8dRGTu3ITF2nkE4OEfgMLHTsHyK0mnciEiAhzuMM9LMh/P",
+	"IIk8jRhSvsfSkcbcMkkizmJEUsDC6M6AiMkSnn13rt0/7P+3jAcw4j8OK5Z1KPCXH8qrvFBTLvw3ZRSx",
+	"Jdz6raA0WCBWH8mbqaNcbNIiLpjBo3zg/kBKr2i8uXvOs+vQ4wOXuBSjqwXs9EyPZuCKNNiEqIwzzoAy",
+	"CBcDLpYo6YZnXNwu/hEncL3GOnve7tBHipcnWRcd9xnz33N4TDmxei8BbhnTnyxNI2T0cIbDX/OE7rt6",
+	"XLuw9CLLEuS3sFUdEbCXozaDL39OsmXo+zx++J3PPI/nuULkCiRSjBw8KTOPO2GOyHcYsiV4mDBZkszH",
+	"mF3De1O3+sAgOpKsCUR8R7S84/OUxyCG4E8AtDRAwjXksrjrOYs9HnUx3Usewf0LoQ2vN0a6YrHv4AJ+",
+	"iTzlHZAXkNMVfq2knkdrcpQW9be91yMWL/jOL1cS7W9lmCHf/KxX+WqheRMLF3FaFp9mbWEQaCTtgqKG",
+	"UFg6yVYDGOfbbHVBRJQCw/PClEV9M97pgc2Dyh3NtcYK+O7Ddx77BxyARMNw8QLsHfdqHFsuMbad33rs",
+	"JPbKLOOxt32XwLLbmho1ooc9GndoeTfIitfwoDlQfRjAo0jgjWS154AvTz2aROl/AE8IZyIODh+yeKt0",
+	"X8Hj4R/MuQRNOYpAk6mt99iBPXFkEW44DvTDPEX9F7RJgtaZ0NdNGNQweK0ZX7HMj5CrPZJHffwFpAfi",
+	"pGM2MjkP5EvB/S8xcOGSdwy84mmh9NyKPwgRCRyoCKMaG6kzDsBqmK+FVizVU3UBAjr4gza3ap5wlTGM",
+	"gSu61BJ5l/0h7h3OpecpOOVd6HsC1JbxVZzcxPLU7SleUkY+oQlYn49GG9gTiCzjLHIWsUS4DvOf2dpb",
+	"wOQFfBaEqzKjD+We1sMKWdB6phu4VLayKBIvyw1DucV8UhA4TnfUaFSTGVpXaEgKM8gRWzkRj1egSMLN",
+	"TkctMBpvTy1ne2cvvhUZ+8SEKct8nww+Fr0zoBd6YEP/ScU4Uu0R8txJWY7qvpTHmoBY7nDcA0fmcGGG",
+	"pcCcHMCNjMFCQmn7OvydjLO8APQI0zn2hWnBqjm4Er9Gy5RgQbiUASdxhxa7dwUYyIkBI1fIietkHFYA",
+	"dhKwKOe3u7BzqRWBO+Dovy7fvnEElxRaIiFkgQhxGKiJ1ywKfXzCDlsxPO7IAsrLcLV+BeeM3ivqr16T",
+	"Nh53sWc97x9geFRv8iIOkrZ9CPsBw7uwmDQXoNwVIVi+ADu+RNBn1bvEKRNtXSjdZ0Qm/CuiV9M/Ub0Z",
+	"nJcjVEJMtR4P8d7aOR8cJPj6Qmw2baCGPC/yu2PXdfdVIrrUAXFI2xOF48FiBYrCX/jWYosoOrviW+V3",
+	"uZK8VEkXIblqQiFnAZdOqGyLDxP+SkmSVLPIWCWRAQ+S1l9uq38r5RZHSdESVrDCx7EPYk94WQQ4SRau",
+	"QgS1Lp6qF652xjceFuI9NF7zyGUn/vFyeTo55lN3Ml8enU7YE+944jJ/zpZzfsJPmbgmdcOz4+PeG3+T",
+	"FGeBVc3W+EXJDmJlkzoMR4KaEQKOWIe+La5izXIpfcA8MWT9liMbSIEMkCmCkgf6BpiUwP1KwUVXnFQW",
+	"ugGUQEsOjI43cTFzZycTwMLs+IM7e+q68N9/oz6ZZBuGehKylQnCPbIf+RmtOujMAgB56C5dpDohQPpR",
+	"qRXx3ipJ5znnH2Z7n1OzlU62IWhzxeLwd2Gh0d1Z7EowepJ4haZUncKmbi+BqeM/D1fSMm9Ycy/P4BpP",
+	"AIX4vWJg0kBtvN8cuFnlt0w1YvkqhA23NdTlawbLPp0Hs+Wpd+T7U3bkucExPJQpP1o+CeZLN3CDGfNP",
+	"vLnPZ3wKf7tecMqn3vHyJ/bEP+KzYOqixs4KIHuE9X/kop/dyRM2Cb7+cTK//dtox6Evw1UM92ojtWcs",
+	"5yfzCbCMxEfewwtGL+SFD892+gR0BDl1J0pGg/H/MY0S5ncaVIZHoA7nebWtSc39G+9pouUmqnaaaC3c",
+	"dgoXdShzdZuoqeOIW2Sxr6l3CGiS1ptwyUWsEMCqEag6tihFgCGGcZ2XAB8JuGCpQZZsnDKLyAcCn3hX",
+	"8KHkWrstL5J+lRKKb185kJwoFI8RHxnMQQsThROJ67F02oIGmiWoA+POJAbjHM+QLyriJW0PbU4ymGhP",
+	"q0P3nWmh1xHwEQQu+sYVCYKkyByMhWTMo4BNJa61nlPxgV/XIqzTT7BaUzwnq6cNiDbAJnkKfwah5ygD",
+	"SbDPhEbmLRdTzgr5FDpYcabOVhnZqB2gD0Rxu7IMfed6fnh97Ej2b56SsaPlNGBscnwSHE3m/nQ+OZ0d",
+	"n05Opsf+dMpnrnvimoIDIJqE/gQXtfEvBLh6v31A10SI1Ir0QWpgTmdH8+O+m7BZJBbtH0zxt/ASP++h",
+	"/gOzgcUsrE8avjss9Ju1UEyYaWwDXaOWsITHgi9R06EmFCupD3PQt9wHTW5i2utyyTZn+Wri7gN916MP",
+	"4ALC6FReoc/6LsfOc9jdK5xztffYeQNwfzW8Crlx8T6NloMxwgVjEcihD9Fisn2vJ7C6msFuPQ1Obf6i",
+	"kNgcRH2Eevmw+qHVCJeisfYaB02sxOodiQ1mbnNldw4ytKXlbIu51jyixtvQgrqBVY2n2tkrkHYQfIN6",
+	"8MX/uSTTf3z7IUCfNT3AVislpQHkCiJzliJsTZHPyiIBpg98LAJLKyGRUkjfJpeBWTiIYuZC8xf4JSPt",
+	"BeilYnnkd8yJ+U19A8O8k14wMpMrBoLqAQbuqwFpxq8pNI6q9QbsoTKnzAuGHtCFcJpL2xz33eF/FvZj",
+	"wTIwHHPDZU3ajDyk33/IlsheMu8qCYKa+/3Ebfq63uj0lpwDPxYpFjcsLEwFLAgz0KWEv8H5gN4JjuFP",
+	"PynJgUiwYajUyctljjHFWI02ZeeRa2a/nJ7M3Z4kERq9kAjOh6ToqLFjGeJV5ASbFGHDe1EDzQBsakI1",
+	"s0GlsG4xBeU34r715bW9C7+VLAqDrfSmEq5MeD7XgtgAAxjImD5iyzfBLAQ0C8bdc/bykpVxCDcov0b3",
+	"aMsrbV6KgQ0rF0iiCIDoNNowSjHcEVrGaqHbujdv14nowkC95att7wYC2ks1vBXrVF+MBeA7TnxpbGmP",
+	"dSFd3rBr+Vc9bkPkk4mVyNNscCjyq33QrxKXcB55LGbZ9jGqdnkoA17VyxXfUnBM/LmAK/Dg6GwFnEz8",
+	"+bgNglAEKUVgLN53gCDdkMWC28oxMf8m4ACr6ffGUSSsekgjckbMPC8pI2BRrDOer5PIVzA1TXYBmTE9",
+	"T5yAZWIBjk4IZcelDEjSAS6V5mSxIQwKn2sWIYcQ4XSbwMmVB/Cad7kAxwLyjeCQLHbkK7z7GUQyUd2g",
+	"1ewCkLZKZPaJyH/LQjytmZUSFrkUNlKo+gmXccakRBmWV3u0hIUgizaxVuKhDr806SRh4cUeOOcsluE6",
+	"iye0RXc10TDdnQg5HrWmt0F9V5H0AHDHQA5ljCRTpoNAr8Pr1gSG2wd+izqauZK7jjKEemT0YEtXbIru",
+	"GtEPPIJVFNOL2g32Ll2iuMFHRPzOhOJkf52gxS1qQNFJ7gWbMRfanmQuJlI7uJl5sCd7Uohmn3u9QWLK",
+	"BEjFmRuE3rrz3QnHprirYLIKujI+B4Uc1cwdCr4nhwC018mV4vgtFZ8UFwoDBhRm0sGED8TMt+jVRGSj",
+	"Ww7fLCnbL1+fnU8uX56h8117LkAxn+Rrhl52DHuFyjfgYwQM6BJY5AJUALk0eh3xoolUUWSsiyIV7kDk",
+	"p5RTqr8H9iATTiiJQjkYzXAdz2zctdrWcruGX7ABu+RFdGh5rRKZEiMHzhsFW5Wx/e7yF0en6GjXO8wE",
+	"2GqurIxvuC/i7nm/bxEwZXFsvn9VBwyvCIUehVmqrQirTw8P5ScHoHsdquDHBO5/olRl08OHO/Y72kyC",
+	"FTMMbHfSrcykswQRfMsL1CkpPm/oReYpZ67VWriHHCyCSq/UdSiRTXTfZ7JyqxUIz3SvU/0dZwhvxL7I",
+	"GHcn4VDSjvPaknXzEXh0Sg4+mZrjl5R/AqhB6SHc6bupifDWgWw6Tk+G33ch6cfnN2pwB6f6KXusfXRU",
+	"TMvB7qYzOfp2XGWMDLEDKf1k7yz2KgH2ez2pysIePPuDnFCx0wHzPmbRTh+cwrVYc9c9vVTItcvqaItu",
+	"E8F+MTbAlqQ4kjMKpXd0XUWua8IbtA3K/sqSaxC3wtwK89pamC0tpRkKOZSfGJXA6QsSsipQjzL/NehZ",
+	"yTXPxujmk4ur2SJnsh7EUNolay9Hkpw+0fUWQkBrh0ODcMF8XkacFrHk/JE6gAIbjCmRvgggnYk5tR0+",
+	"SnCl+rGtKlRCneGY8TTJilbGBGImkkVEPTK5WUPSDP2o7KnQr7sN5erVnkGwnP/kztwJOwn8yfx07k9O",
+	"3eXxxGeuy+bsyF0Gs5po7oq6lUsNwWIDSijICytsl8ZA57UY2A/m0ZPlEXNnTybHR/C/uev9NGH+bDaZ",
+	"Hs9ny+NgGYjYXA+Ytuhc02NkuJra8tTQenvYlFaQbymUrxKjF6n2i/fENBqp1LeIDZWC2FvdoDNEa9MW",
+	"A2sjGhmUd2bORmbZ4or3HrqRNHd37r6Ras8w1vwaR9+qErZBsxQPRA2epibFggUDahl0xpqctNS5XD2z",
+	"ZNLXYPVAVY9qLUFCvLhbMoixwPfkuqBaYeSK7NZW1MC7l0DcW7jU00kVgwKmMgeDliiyoW/eDJvdaltm",
+	"yHO/pKHqvf9A3WQ8uuFLxE6egOQcPvkffHkuJvWpONbSG2Hs0XvtUHpy084bGmHQc+xSITfMrMFLyimW",
+	"Fc04+b9Pck0jSP8gCTbtTSv6tjpRKGRhJOjfgLiiFP2Si2x98ZZA3RIrmV8BzTGyFW+6cl6x0EO4hWIn",
+	"SlZ5Q4vsUdSa1/6JZ3koKhXrB5FfKLSdvbuoIed61q8UNowq2iLFSlWiWlu9Q/u6QPwyenn7ZcrKrSW9",
+	"n1k06DMjL1njGnYnZ2WuKqIGJwjvKIy2VER3TX+lFQx7jYilbrpxKj3N2fCCYfMAaT01SYlCDdqeqVfd",
+	"p2WWJhjMt1WR6GYV2D2gE1Kqhxm3CiwzmzGj20FgYoNKaxDxwtTwlaveEdT7wnYHERu8Og7tXvzAebsJ",
+	"C3yFoaTCpACMthtwyBRPMsAObCChf34gSOTK3+u8KvNj4AY6UWSPTRqiUFzg126qeM0L1ksUTeOwaejr",
+	"phrIDGhmK0hYMRRzqfYFqaVM3nVsDebQFbeX7Lr5jSox0UisEc2/ZGH/gub/i3KM8fD12N103hv5ECfd",
+	"gfHBgk9z0oqJHx9NT2dP3Lty13eUYiR8owMAaAeTKwO7EYLBoDK8QjA0qCUMciczeUbmfBxYLOyhnLZm",
+	"MvWVVJoZ02mNh36sXEki2qBPlNbLk1AfwNTryJGJd84jrXU8rkdDfg6/OedZSFlmzvmnF/lgSfte9N+4",
+	"Jw+oxPJwxf1MTiAXQyZ4ULJfyOG8micMRSmHF2woHJXI38tFUYFQc1Z8l+ecvBSLvNxsZEbDsBXIX3Ep",
+	"Z90pSvHv4qe4o+/AzGwcurPBqu7BFL9jw4o9G8rArlUK8XeZ0am/3yP6KCac/blGeJfIM1iNpaEJJn2C",
+	"NVqJbAqTi/HjqjMZhemnLdHSlZfZmxfUxcPakWrKaqzSATIdURCR85sku1IBUAFl1ZlnJ+u/Yx3+C11a",
+	"31F/LxJtpY0qUmqE0ZrVTdaDQQXzRXKFrX1Gn9+/eH52/uHF86+j3VetGOoARIpkRZC3xLaHhAnqXNde",
+	"N96RKVE2AytMhFWkT6EVixkNCJv0whspq2u4KBGGWtWda9hMxaz23OySpnwXo5P96drBwbJIwTiA+/FL",
+	"TyTRqFYjCtf68crkmFrwZkDsxYa4FklUd6aIo0fn7N/pUiG6kS6mO3uZLWRknxKDv1EBoaiqD+GTsgBM",
+	"czBHMJtf94XqSHfStQsSZbiDNS2zapTXmVSY278NV3GSdU1Nruyfw2pe2TUpvwrTtOvLRtO1HvuKqm/V",
+	"4YyTNHPY1ZYVZNXBvvZecN7d13GQ67bBpix+vH0Yg+YIG2mtD5hDhn2rCBfPIJdRIAxAxmWlEXflFw6I",
+	"9ROPF3xAEL7ucNlKKNaudy2erC4A0UxhwEBV6GAOnHZkXCGD6h+omxf0by6zTmsDrelehsa4e8WBro/+",
+	"OzEVgPm4/23uAqtBagLGCqMVHsZG3Yk68nhktKircFtdcR2U3TS7h+7RQMYe6kejneU+HuAO8WM7ymud",
+	"gKe6oAlAG9VMmIkH12vp04FVut+4V6K651zIXFCsk3fQeKyUHmqMJrTXyUQMmEz8MAjGTngAsknMQ67b",
+	"KDq5oWZb2H1G6paYmYKvHEQ/VdNji0VVOaAKj8QhaBtrkZHNihvgsCJpi4njsklLw1WFFXy+D+Igb2SL",
+	"9l52VxOzc9m2rOpV1iIp48gP+Q5sUH+o+Immn6OTdpb72YaqPIzke7PXjWwRh9ijEiGfY5KxbGDrl6In",
+	"tAZfJ66fuPPTAaUBdYPbYh2qXprofczC1Yp2t/shB7vdmq1kW1G4oU7JRgdZo7Tuoa57KGSVQ2DfQBpF",
+	"WqQDYt9o2seuTG98mc16qXbCdteydU9DRyo5EEiaAGnp9rC5zPGTzOGGLx3p5MBjy3oM6qcBOrUvCrLa",
+	"SYxtF7IooOMRVRUkqloB+d06XK1Bhc/LFWa9CAN7r5x0sjBFoT+2bwErnWhqA4SD3TyS33nwn0AWcFbM",
+	"g29HE/VreK6LCkTdsmrbRdXSHUYYmRRCZ9DtFZzrkDnnUVL6qu1BQqUARViQ69u24UUsHeciBH2tAtaj",
+	"6YF74JINkfKYpSGm5cFHR6LN0Jr462EoZx+qugjSxK1Wvd4zN84g6jsaIIsWudhACc+WCf8MVb+J8AXW",
+	"wlHwVPfrRR1idJaG6jBV/sZI6Dlwwc8Sf7tXQ+G9SlX3KlS9bTWEnrk/3VuzYzN5xdLy+O0vCOvcdbvW",
+	"0YAdGm2qqe2xMiz0XVY3SQMqcrieHQpe2U0PIv+mIgYH4bYTxK6r/jSrEoAe+rLrdQB/sRvX6UwPc+Vi",
+	"/fptdV36YdXqeffd9/TEfm/UJwsFot0CrqqHEiPE1nY6OnDOYAKqnVPXdUVx3SPKWop8dAdT/P0xacZK",
+	"0mN1r3IXHwylw5/V7yLclRqHNsjWpPin09d4tObMlz+48gEw6zFrm6JLTjo/qkMYyCcxriqxhW5Dv6oi",
+	"rlXqjvJKkUhEC43qxhJqJs2zWtP06lCtfv23D/IOqnQFJoG1vAxdxrConLf21/GsDPEnUFTZoY6mPcof",
+	"y8y0Zqsnsw+iORjwUnWu30G82Lo2wta1re46D0PDjX6yVvJ172+3rsa898cqx6Nj96h/iuUnDurU9XZZ",
+	"sDB2qmtwLrWxWrta/cstVf87sqcvnlu48l9LOZOcUqln5m81fW7C9UtV2atbBunet4otf4nPyG1BtoRo",
+	"NN3oXmtWPQqxoXsmUL9ATkXAWObTKGKQ2Z8IiuBv1Y/fGPULEyxgGA+kxmbdw+3XH6Si/vVUlt1K6t4a",
+	"pyKMQ9kL4V7IPZTNDNq/DCMZruy7oFrGDHsfX+K3SpJZu7EYdf3NtjAZlkk47IbJFk2t+REjd5AAOuM7",
+	"u/h+iRHcZp8WHiflam2HTKc5ig1k0wTRNn5nZxndZ0F06K014KqpdQgR2uFV93kdjxDPcRBnke15/p/B",
+	"fBeDGdBE6UfqoD/aqtWvv81sUs2HOrnMJ55VzSpbTZKr31+oGEY9GNDRO9rBdjZC52t3pA6FPwjxfdB4",
+	"jp5QsJEJBTzjrXi1bGxXLx3rfXEaV6Ij8QMpjPbW0FaSmz7Qpna6M0p+vo/4xCZmRML4AbJ67/AWLWrN",
+	"EGDvsS0u7t12AKart/9uo2H/38kQjXL3VWTcB4TKSCpswPEQTEtaDNaf4jEv1EI1ZbwgqZoP9tUxkPFD",
+	"fses5rPR0vuOzj3KUPt+D1+PcLE0+vi/47Uz0/asdKArvle2X599L38gpLI1RfSbWhuI1Hzdcb2WwVDv",
+	"EOh8jOmns2BGkYUUsBAUIXwmufqJV9EfwslTUYvnZQlM2pRREaZRs+ug8yZxNjxb0Y+EZaBs+aW+Dwym",
+	"AGWhLqkSWajAT3aMmIhYOf76mLCd/+mEdfDTGlGfEck/kz+XcYMNw5YVtDchvA3+LcypZSCvY+afVRhH",
+	"urFEcOfZEIWWnCWvwtyiy9qoohpyaP1lWOwqvuc8+g3d4fPEDy0PHy9/9FjoqQ/kA2omjN2n62c+xPVT",
+	"/e5m/YXixfa9nOabrYpKV/0/Fr0KseTrOsxDmYN49u6CQsfLEgiCfpNht7tG7vaAl6O2GOII+DsvnNp4",
+	"DJbabTtdpofxSllI/nR0iK3m/xeCDeBCMXwAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -4,11 +4,58 @@
 package private
 
 import (
+	"time"
+
 	externalRef0 "playbook-dispatcher/internal/api/controllers/public"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ConcurrencyPolicy.
+const (
+	Allow  ConcurrencyPolicy = "allow"
+	Queue  ConcurrencyPolicy = "queue"
+	Reject ConcurrencyPolicy = "reject"
+)
+
+// Valid indicates whether the value is a known member of the ConcurrencyPolicy enum.
+func (e ConcurrencyPolicy) Valid() bool {
+	switch e {
+	case Allow:
+		return true
+	case Queue:
+		return true
+	case Reject:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ConnectionStatus.
+const (
+	Connected        ConnectionStatus = "connected"
+	Disconnected     ConnectionStatus = "disconnected"
+	RhcNotConfigured ConnectionStatus = "rhc_not_configured"
+	Unknown          ConnectionStatus = "unknown"
+)
+
+// Valid indicates whether the value is a known member of the ConnectionStatus enum.
+func (e ConnectionStatus) Valid() bool {
+	switch e {
+	case Connected:
+		return true
+	case Disconnected:
+		return true
+	case RhcNotConfigured:
+		return true
+	case Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for RecipientType.
 const (
 	DirectConnect RecipientType = "directConnect"
@@ -30,21 +77,21 @@ func (e RecipientType) Valid() bool {
 	}
 }
 
-// Defines values for RecipientWithConnectionInfoStatus.
+// Defines values for RetryPolicyStatuses.
 const (
-	Connected        RecipientWithConnectionInfoStatus = "connected"
-	Disconnected     RecipientWithConnectionInfoStatus = "disconnected"
-	RhcNotConfigured RecipientWithConnectionInfoStatus = "rhc_not_configured"
+	Failure     RetryPolicyStatuses = "failure"
+	Timeout     RetryPolicyStatuses = "timeout"
+	Unreachable RetryPolicyStatuses = "unreachable"
 )
 
-// Valid indicates whether the value is a known member of the RecipientWithConnectionInfoStatus enum.
-func (e RecipientWithConnectionInfoStatus) Valid() bool {
+// Valid indicates whether the value is a known member of the RetryPolicyStatuses enum.
+func (e RetryPolicyStatuses) Valid() bool {
 	switch e {
-	case Connected:
+	case Failure:
 		return true
-	case Disconnected:
+	case Timeout:
 		return true
-	case RhcNotConfigured:
+	case Unreachable:
 		return true
 	default:
 		return false
@@ -57,6 +104,7 @@ const (
 	InventoryId ApiInternalV2RunHostsListParamsFieldsData = "inventory_id"
 	Links       ApiInternalV2RunHostsListParamsFieldsData = "links"
 	Run         ApiInternalV2RunHostsListParamsFieldsData = "run"
+	Stats       ApiInternalV2RunHostsListParamsFieldsData = "stats"
 	Status      ApiInternalV2RunHostsListParamsFieldsData = "status"
 	Stdout      ApiInternalV2RunHostsListParamsFieldsData = "stdout"
 )
@@ -72,6 +120,8 @@ func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 		return true
 	case Run:
 		return true
+	case Stats:
+		return true
 	case Status:
 		return true
 	case Stdout:
@@ -81,6 +131,27 @@ func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 	}
 }
 
+// CancelFilter Selects the running and scheduled Playbook runs to be canceled
+type CancelFilter struct {
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Service Service that triggered the given Playbook run
+	Service externalRef0.Service `json:"service"`
+}
+
+// CancelFilterInputV2 defines model for CancelFilterInputV2.
+type CancelFilterInputV2 struct {
+	// Filter Selects the running and scheduled Playbook runs to be canceled
+	Filter CancelFilter `json:"filter"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
+
 // CancelInputV2 defines model for CancelInputV2.
 type CancelInputV2 struct {
 	// OrgId Identifies the organization that the given resource belongs to
@@ -93,12 +164,29 @@ type CancelInputV2 struct {
 	RunId externalRef0.RunId `json:"run_id"`
 }
 
+// ConcurrencyPolicy Defines what happens if another Playbook run is running on the recipient (or on any of the hosts of a Satellite Playbook run) at the time of dispatch.
+// allow - the Playbook run is dispatched regardless (default)
+// reject - the Playbook run is not created
+// queue - the Playbook run is kept in the scheduled status until the running Playbook runs finish
+type ConcurrencyPolicy string
+
+// ConnectionStatus Indicates the current connection status of the recipient.
+// unknown - the connection status could not be determined
+type ConnectionStatus string
+
 // Error defines model for Error.
 type Error struct {
 	// Message Human readable error message
 	Message string `json:"message"`
 }
 
+// ExtraVars Optional variables passed to the Playbook as extra vars.
+// Allows for a single Playbook to be parameterized instead of rendering a Playbook for every variation.
+type ExtraVars map[string]interface{}
+
+// ExtraVarsSchema Optional JSON schema that extra_vars are validated against
+type ExtraVarsSchema map[string]interface{}
+
 // HighLevelRecipientStatus defines model for HighLevelRecipientStatus.
 type HighLevelRecipientStatus = []RecipientWithConnectionInfo
 
@@ -113,9 +201,49 @@ type HostsWithOrgId struct {
 	OrgId OrgId `json:"org_id"`
 }
 
+// IdempotencyKey Optional key that makes the dispatch of a Playbook run safe to retry.
+// A repeated dispatch with the same key by the same service within the idempotency window returns the original Playbook run instead of dispatching it again.
+type IdempotencyKey = string
+
+// NotAfter Optional timestamp after which a scheduled Playbook run that has not been dispatched yet expires.
+// Can only be used together with not_before.
+type NotAfter = time.Time
+
+// NotBefore Optional timestamp before which the Playbook run is not dispatched.
+// Until then the Playbook run is kept in the scheduled status.
+type NotBefore = time.Time
+
 // OrgId Identifies the organization that the given resource belongs to
 type OrgId = string
 
+// PlaybookDigest SHA-256 digest of the content of a Playbook stored in the playbook registry
+type PlaybookDigest = string
+
+// PlaybookSignature Base64-encoded detached Ed25519 signature of the content of a Playbook
+type PlaybookSignature = string
+
+// PlaybookUploadInputV2 defines model for PlaybookUploadInputV2.
+type PlaybookUploadInputV2 struct {
+	// Content Content of the Playbook
+	Content string `json:"content"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Signature Base64-encoded detached Ed25519 signature of the content of a Playbook
+	Signature PlaybookSignature `json:"signature"`
+}
+
+// PlaybookUploaded defines model for PlaybookUploaded.
+type PlaybookUploaded struct {
+	// Digest SHA-256 digest of the content of a Playbook stored in the playbook registry
+	Digest PlaybookDigest `json:"digest"`
+}
+
+// Preflight If set, the Playbook is fetched from url and checked before the Playbook run is dispatched.
+// A Playbook that is not a list of plays defining hosts, tasks or roles and the insights_signature vars is rejected.
+type Preflight = bool
+
 // Principal Username of the user interacting with the service
 type Principal = string
 
@@ -138,6 +266,10 @@ type RecipientStatus struct {
 
 	// Recipient Identifier of the host to which a given Playbook is addressed
 	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// Status Indicates the current connection status of the recipient.
+	// unknown - the connection status could not be determined
+	Status ConnectionStatus `json:"status"`
 }
 
 // RecipientType Identifies the type of recipient [Satellite, Direct Connected, None]
@@ -160,14 +292,12 @@ type RecipientWithConnectionInfo struct {
 	// SatOrgId Identifier of the organization within Satellite
 	SatOrgId SatelliteOrgId `json:"sat_org_id"`
 
-	// Status Indicates the current run status of the recipient
-	Status  RecipientWithConnectionInfoStatus `json:"status"`
-	Systems []HostId                          `json:"systems"`
+	// Status Indicates the current connection status of the recipient.
+	// unknown - the connection status could not be determined
+	Status  ConnectionStatus `json:"status"`
+	Systems []HostId         `json:"systems"`
 }
 
-// RecipientWithConnectionInfoStatus Indicates the current run status of the recipient
-type RecipientWithConnectionInfoStatus string
-
 // RecipientWithOrg defines model for RecipientWithOrg.
 type RecipientWithOrg struct {
 	// OrgId Identifies the organization that the given resource belongs to
@@ -177,6 +307,71 @@ type RecipientWithOrg struct {
 	Recipient externalRef0.RunRecipient `json:"recipient"`
 }
 
+// RetryPolicy Optional policy for retrying the Playbook run automatically once it finishes with one of the given statuses.
+// Each retry is a new Playbook run dispatched to the same recipient and linked to the previous attempt using parent_run_id.
+// A retry of a Satellite Playbook run only targets the hosts that finished with one of the given statuses.
+type RetryPolicy struct {
+	// Backoff Number of seconds to wait before the first retry. The delay doubles with each subsequent retry.
+	Backoff *int `json:"backoff,omitempty"`
+
+	// MaxAttempts Maximum number of attempts, including the initial Playbook run
+	MaxAttempts int `json:"max_attempts"`
+
+	// Statuses Statuses of a finished Playbook run that qualify for a retry
+	Statuses []RetryPolicyStatuses `json:"statuses"`
+}
+
+// RetryPolicyStatuses defines model for RetryPolicy.Statuses.
+type RetryPolicyStatuses string
+
+// RolloutInputV2 defines model for RolloutInputV2.
+type RolloutInputV2 struct {
+	Runs []RunInputV2 `json:"runs"`
+
+	// Strategy Defines the waves the Playbook runs of a rollout are dispatched in.
+	// The first wave (canary) consists of the first canary (or canary_percentage percent) Playbook runs of the request, each following wave of the next wave_size Playbook runs.
+	// The next wave is dispatched once success_threshold percent of the Playbook runs dispatched so far succeeded and the pause elapsed.
+	// The rollout halts, canceling the Playbook runs that have not been dispatched yet, once more than failure_threshold percent of the Playbook runs dispatched so far failed.
+	// A Playbook run that is going to be retried according to its retry policy does not count as failed.
+	Strategy RolloutStrategy `json:"strategy"`
+}
+
+// RolloutStrategy Defines the waves the Playbook runs of a rollout are dispatched in.
+// The first wave (canary) consists of the first canary (or canary_percentage percent) Playbook runs of the request, each following wave of the next wave_size Playbook runs.
+// The next wave is dispatched once success_threshold percent of the Playbook runs dispatched so far succeeded and the pause elapsed.
+// The rollout halts, canceling the Playbook runs that have not been dispatched yet, once more than failure_threshold percent of the Playbook runs dispatched so far failed.
+// A Playbook run that is going to be retried according to its retry policy does not count as failed.
+type RolloutStrategy struct {
+	// Canary Number of Playbook runs in the canary wave. Cannot be used together with canary_percentage.
+	Canary *int `json:"canary,omitempty"`
+
+	// CanaryPercentage Percentage of Playbook runs in the canary wave, rounded up. Cannot be used together with canary.
+	CanaryPercentage *int `json:"canary_percentage,omitempty"`
+
+	// FailureThreshold Percentage of the Playbook runs dispatched so far that may fail before the rollout halts
+	FailureThreshold *int `json:"failure_threshold,omitempty"`
+
+	// Pause Number of seconds to wait between waves
+	Pause *int `json:"pause,omitempty"`
+
+	// SuccessThreshold Percentage of the Playbook runs dispatched so far that need to succeed before the next wave is dispatched
+	SuccessThreshold *int `json:"success_threshold,omitempty"`
+
+	// WaveSize Number of Playbook runs in each wave following the canary wave
+	WaveSize int `json:"wave_size"`
+}
+
+// RunCallback Optional callback invoked once the Playbook run reaches a final status.
+// The payload is signed using HMAC-SHA256 with the pre-shared key identified by secret_ref.
+// The url needs to use https and its host needs to be on the allowlist of the dispatcher.
+type RunCallback struct {
+	// SecretRef Name of the pre-shared key used to sign the callback payload. Needs to match the PSK principal of the caller.
+	SecretRef string `json:"secret_ref"`
+
+	// Url URL the callback is sent to
+	Url string `json:"url"`
+}
+
 // RunCanceled defines model for RunCanceled.
 type RunCanceled struct {
 	// Code status code of the request
@@ -191,6 +386,9 @@ type RunCreated struct {
 	// Code status code of the request
 	Code int `json:"code"`
 
+	// GroupId Unique identifier of a run group
+	GroupId *externalRef0.RunGroupId `json:"group_id,omitempty"`
+
 	// Id Unique identifier of a Playbook run
 	Id *externalRef0.RunId `json:"id,omitempty"`
 
@@ -198,6 +396,18 @@ type RunCreated struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// RunGroupCancelInputV2 defines model for RunGroupCancelInputV2.
+type RunGroupCancelInputV2 struct {
+	// GroupId Unique identifier of a run group
+	GroupId externalRef0.RunGroupId `json:"group_id"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
+
 // RunInput defines model for RunInput.
 type RunInput struct {
 	// Account Identifier of the tenant
@@ -239,20 +449,62 @@ type RunInputHosts = []struct {
 
 // RunInputV2 defines model for RunInputV2.
 type RunInputV2 struct {
+	// Callback Optional callback invoked once the Playbook run reaches a final status.
+	// The payload is signed using HMAC-SHA256 with the pre-shared key identified by secret_ref.
+	Callback *RunCallback `json:"callback,omitempty"`
+
+	// ConcurrencyPolicy Defines what happens if another Playbook run is running on the recipient (or on any of the hosts of a Satellite Playbook run) at the time of dispatch.
+	// allow - the Playbook run is dispatched regardless (default)
+	// reject - the Playbook run is not created
+	// queue - the Playbook run is kept in the scheduled status until the running Playbook runs finish
+	ConcurrencyPolicy *ConcurrencyPolicy `json:"concurrency_policy,omitempty"`
+
+	// ExtraVars Optional variables passed to the Playbook as extra vars.
+	// Allows for a single Playbook to be parameterized instead of rendering a Playbook for every variation.
+	ExtraVars *ExtraVars `json:"extra_vars,omitempty"`
+
+	// ExtraVarsSchema Optional JSON schema that extra_vars are validated against
+	ExtraVarsSchema *ExtraVarsSchema `json:"extra_vars_schema,omitempty"`
+
 	// Hosts Optionally, information about hosts involved in the Playbook run can be provided.
 	// This information is used to pre-allocate run_host resources.
 	// Moreover, it can be used to create a connection between a run_host resource and host inventory.
 	Hosts *RunInputHosts `json:"hosts,omitempty"`
 
+	// IdempotencyKey Optional key that makes the dispatch of a Playbook run safe to retry.
+	// A repeated dispatch with the same key by the same service within the idempotency window returns the original Playbook run instead of dispatching it again.
+	IdempotencyKey *IdempotencyKey `json:"idempotency_key,omitempty"`
+
 	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
 	Labels *externalRef0.Labels `json:"labels,omitempty"`
 
+	// Mode Mode in which the Playbook is executed. In the check mode Ansible is run with --check --diff, i.e. the changes the Playbook would make are reported but not applied.
+	Mode *externalRef0.RunMode `json:"mode,omitempty"`
+
 	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
 	Name externalRef0.PlaybookName `json:"name"`
 
+	// NotAfter Optional timestamp after which a scheduled Playbook run that has not been dispatched yet expires.
+	// Can only be used together with not_before.
+	NotAfter *NotAfter `json:"not_after,omitempty"`
+
+	// NotBefore Optional timestamp before which the Playbook run is not dispatched.
+	// Until then the Playbook run is kept in the scheduled status.
+	NotBefore *NotBefore `json:"not_before,omitempty"`
+
 	// OrgId Identifier of the tenant
 	OrgId externalRef0.OrgId `json:"org_id"`
 
+	// PlaybookDigest SHA-256 digest of the content of a Playbook stored in the playbook registry
+	PlaybookDigest *PlaybookDigest `json:"playbook_digest,omitempty"`
+
+	// PlaybookSignature Base64-encoded detached Ed25519 signature of the content of a Playbook
+	PlaybookSignature *PlaybookSignature `json:"playbook_signature,omitempty"`
+
+	// Preflight If set, the Playbook is fetched from url and checked before the Playbook run is dispatched.
+	// A Playbook that is not a list of plays defining hosts, tasks or roles and the insights_signature vars is rejected.
+	Preflight *Preflight `json:"preflight,omitempty"`
+
 	// Principal Username of the user interacting with the service
 	Principal Principal `json:"principal"`
 
@@ -262,6 +514,15 @@ type RunInputV2 struct {
 	// RecipientConfig recipient-specific configuration options
 	RecipientConfig *RecipientConfig `json:"recipient_config,omitempty"`
 
+	// RetryPolicy Optional policy for retrying the Playbook run automatically once it finishes with one of the given statuses.
+	// Each retry is a new Playbook run dispatched to the same recipient and linked to the previous attempt using parent_run_id.
+	// A retry of a Satellite Playbook run only targets the hosts that finished with one of the given statuses.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+
+	// SecretVars Names of extra_vars whose values are secret.
+	// Secret values are redacted when the Playbook run is read and in logs.
+	SecretVars *SecretVars `json:"secret_vars,omitempty"`
+
 	// Timeout Amount of seconds after which the run is considered failed due to timeout
 	Timeout *externalRef0.RunTimeout `json:"timeout,omitempty"`
 
@@ -284,6 +545,10 @@ type SatelliteId = string
 // SatelliteOrgId Identifier of the organization within Satellite
 type SatelliteOrgId = string
 
+// SecretVars Names of extra_vars whose values are secret.
+// Secret values are redacted when the Playbook run is read and in logs.
+type SecretVars = []string
+
 // Version Version of the API
 type Version = string
 
@@ -293,6 +558,9 @@ type BadRequest = Error
 // Forbidden defines model for Forbidden.
 type Forbidden = Error
 
+// ServiceUnavailable defines model for ServiceUnavailable.
+type ServiceUnavailable = Error
+
 // ApiInternalRunsCreateJSONBody defines parameters for ApiInternalRunsCreate.
 type ApiInternalRunsCreateJSONBody = []RunInput
 
@@ -302,6 +570,20 @@ type ApiInternalV2RunsCancelJSONBody = []CancelInputV2
 // ApiInternalV2RunsCreateJSONBody defines parameters for ApiInternalV2RunsCreate.
 type ApiInternalV2RunsCreateJSONBody = []RunInputV2
 
+// ApiInternalV2RunsCreateParams defines parameters for ApiInternalV2RunsCreate.
+type ApiInternalV2RunsCreateParams struct {
+	// IdempotencyKey Key identifying the dispatch request.
+	// Applies to every Playbook run in the request that does not define its own idempotency_key.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ApiInternalV2RunsCreateRolloutParams defines parameters for ApiInternalV2RunsCreateRollout.
+type ApiInternalV2RunsCreateRolloutParams struct {
+	// IdempotencyKey Key identifying the dispatch request.
+	// Applies to every Playbook run in the request that does not define its own idempotency_key.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // ApiInternalV2RecipientsStatusJSONBody defines parameters for ApiInternalV2RecipientsStatus.
 type ApiInternalV2RecipientsStatusJSONBody = []RecipientWithOrg
 
@@ -329,11 +611,23 @@ type ApiInternalRunsCreateJSONRequestBody = ApiInternalRunsCreateJSONBody
 // ApiInternalV2RunsCancelJSONRequestBody defines body for ApiInternalV2RunsCancel for application/json ContentType.
 type ApiInternalV2RunsCancelJSONRequestBody = ApiInternalV2RunsCancelJSONBody
 
+// ApiInternalV2RunsCancelFilterJSONRequestBody defines body for ApiInternalV2RunsCancelFilter for application/json ContentType.
+type ApiInternalV2RunsCancelFilterJSONRequestBody = CancelFilterInputV2
+
 // ApiInternalHighlevelConnectionStatusJSONRequestBody defines body for ApiInternalHighlevelConnectionStatus for application/json ContentType.
 type ApiInternalHighlevelConnectionStatusJSONRequestBody = HostsWithOrgId
 
 // ApiInternalV2RunsCreateJSONRequestBody defines body for ApiInternalV2RunsCreate for application/json ContentType.
 type ApiInternalV2RunsCreateJSONRequestBody = ApiInternalV2RunsCreateJSONBody
 
+// ApiInternalV2RunsCreateRolloutJSONRequestBody defines body for ApiInternalV2RunsCreateRollout for application/json ContentType.
+type ApiInternalV2RunsCreateRolloutJSONRequestBody = RolloutInputV2
+
+// ApiInternalV2PlaybooksUploadJSONRequestBody defines body for ApiInternalV2PlaybooksUpload for application/json ContentType.
+type ApiInternalV2PlaybooksUploadJSONRequestBody = PlaybookUploadInputV2
+
 // ApiInternalV2RecipientsStatusJSONRequestBody defines body for ApiInternalV2RecipientsStatus for application/json ContentType.
 type ApiInternalV2RecipientsStatusJSONRequestBody = ApiInternalV2RecipientsStatusJSONBody
+
+// ApiInternalV2RunGroupsCancelJSONRequestBody defines body for ApiInternalV2RunGroupsCancel for application/json ContentType.
+type ApiInternalV2RunGroupsCancelJSONRequestBody = RunGroupCancelInputV2
@@ -20,6 +20,14 @@ func getLimit(limit *Limit) int {
 	return defaultLimit
 }
 
+func getIncludeTotal(includeTotal *IncludeTotal) bool {
+	if includeTotal != nil {
+		return *includeTotal
+	}
+
+	return true
+}
+
 func getOffset(offset *Offset) int {
 	if offset != nil {
 		return int(*offset)
@@ -50,12 +58,15 @@ func parseFields(input map[string][]string, key string, knownFields map[string]s
 	return result, nil
 }
 
-func createLinks(base string, queryString string, limit, offset, total int) Links {
-	lastPage := int(math.Floor(float64(utils.Max(total-1, 0)) / float64(limit)))
-
+func createLinks(base string, queryString string, limit, offset int, total *int, hasMore bool) Links {
 	links := Links{
 		First: createLink(base, queryString, limit, 0),
-		Last:  createLink(base, queryString, limit, lastPage*limit),
+	}
+
+	if total != nil {
+		lastPage := int(math.Floor(float64(utils.Max(*total-1, 0)) / float64(limit)))
+		last := createLink(base, queryString, limit, lastPage*limit)
+		links.Last = &last
 	}
 
 	if offset > 0 {
@@ -63,7 +74,7 @@ func createLinks(base string, queryString string, limit, offset, total int) Link
 		links.Previous = &previous
 	}
 
-	if offset+limit < total {
+	if hasMore {
 		next := createLink(base, queryString, limit, offset+limit)
 		links.Next = &next
 	}
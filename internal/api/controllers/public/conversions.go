@@ -24,11 +24,19 @@ const (
 	fieldInventoryId   = "inventory_id"
 	fieldName          = "name"
 	fieldWebConsoleUrl = "web_console_url"
+	fieldHostsSummary  = "hosts_summary"
+	fieldStats         = "stats"
+	fieldParentRunId   = "parent_run_id"
+	fieldAttempt       = "attempt"
+	fieldGroupId       = "group_id"
+	fieldExtraVars     = "extra_vars"
+	fieldMode          = "mode"
 )
 
 var (
-	runFields     = utils.IndexStrings(fieldId, fieldOrgId, fieldRecipient, fieldUrl, fieldLabels, fieldTimeout, fieldStatus, fieldCreatedAt, fieldUpdatedAt, fieldService, fieldCorrelationId, fieldName, fieldWebConsoleUrl)
-	runHostFields = utils.IndexStrings(fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId)
+	runFields       = utils.IndexStrings(fieldId, fieldOrgId, fieldRecipient, fieldUrl, fieldLabels, fieldTimeout, fieldStatus, fieldCreatedAt, fieldUpdatedAt, fieldService, fieldCorrelationId, fieldName, fieldWebConsoleUrl, fieldParentRunId, fieldAttempt, fieldGroupId, fieldExtraVars, fieldMode)
+	singleRunFields = utils.IndexStrings(fieldId, fieldOrgId, fieldRecipient, fieldUrl, fieldLabels, fieldTimeout, fieldStatus, fieldCreatedAt, fieldUpdatedAt, fieldService, fieldCorrelationId, fieldName, fieldWebConsoleUrl, fieldHostsSummary, fieldParentRunId, fieldAttempt, fieldGroupId, fieldExtraVars, fieldMode)
+	runHostFields   = utils.IndexStrings(fieldHost, fieldRun, fieldStatus, fieldStdout, fieldLinks, fieldInventoryId, fieldStats)
 )
 
 var defaultRunFields = []string{
@@ -90,6 +98,22 @@ func dbRuntoApiRun(r *dbModel.Run, fields []string) *Run {
 		case fieldCorrelationId:
 			value := RunCorrelationId(r.CorrelationID.String())
 			run.CorrelationId = &value
+		case fieldParentRunId:
+			run.ParentRunId = r.ParentRunID
+		case fieldAttempt:
+			value := RunAttempt(r.Attempt)
+			run.Attempt = &value
+		case fieldGroupId:
+			run.GroupId = r.GroupID
+		case fieldExtraVars:
+			// secret values are stored so that the run can be retried but are never returned
+			if r.ExtraVars != nil {
+				value := RunExtraVars(utils.RedactValues(r.ExtraVars.Values, r.ExtraVars.Secrets))
+				run.ExtraVars = &value
+			}
+		case fieldMode:
+			value := RunMode(r.Mode)
+			run.Mode = &value
 		default:
 			panic("unknown field " + field)
 		}
@@ -0,0 +1,107 @@
+package public
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// cursor identifies the last entity of a page in keyset pagination.
+// Lists are ordered by created_at (in the requested direction) with id as the tie-breaker.
+type cursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        uuid.UUID `json:"id"`
+}
+
+func encodeCursor(createdAt time.Time, id uuid.UUID) string {
+	value, _ := json.Marshal(cursor{CreatedAt: createdAt, ID: id})
+	return base64.RawURLEncoding.EncodeToString(value)
+}
+
+// validates the cursor parameter, an empty cursor denotes the first page
+func parseCursor(value *Cursor, offset *Offset) (*cursor, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if offset != nil {
+		return nil, errors.New("cursor cannot be combined with offset")
+	}
+
+	result, err := decodeCursor(*value)
+	if err != nil {
+		return nil, errors.New("invalid cursor")
+	}
+
+	return result, nil
+}
+
+// an empty value denotes the first page, in which case nil is returned
+func decodeCursor(value string) (*cursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &cursor{}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if result.CreatedAt.IsZero() || result.ID == uuid.Nil {
+		return nil, errors.New("incomplete cursor")
+	}
+
+	return result, nil
+}
+
+// restricts the query to entities that follow the cursor in the (created_at, id) order
+func whereAfterCursor(queryBuilder *gorm.DB, table string, descending bool, after *cursor) {
+	if after == nil {
+		return
+	}
+
+	operator := ">"
+	if descending {
+		operator = "<"
+	}
+
+	queryBuilder.Where(
+		fmt.Sprintf("(%[1]s.created_at %[2]s ? OR %[1]s.created_at = ? AND %[1]s.id > ?)", table, operator),
+		after.CreatedAt, after.CreatedAt, after.ID,
+	)
+}
+
+func createCursorLinks(base string, queryString string, limit int, next *string) Links {
+	links := Links{
+		First: createCursorLink(base, queryString, limit, ""),
+	}
+
+	if next != nil {
+		link := createCursorLink(base, queryString, limit, *next)
+		links.Next = &link
+	}
+
+	return links
+}
+
+func createCursorLink(base string, queryString string, limit int, value string) string {
+	query, _ := url.ParseQuery(queryString)
+
+	query.Del("offset")
+	query.Set("limit", strconv.Itoa(limit))
+	query.Set("cursor", value)
+
+	return fmt.Sprintf("%s?%s", base, query.Encode())
+}
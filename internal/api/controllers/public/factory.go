@@ -2,15 +2,19 @@ package public
 
 import (
 	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/common/notify"
 
+	"github.com/spf13/viper"
 	"gorm.io/gorm"
 )
 
-func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudConnectorClient) ServerInterfaceWrapper {
+func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudConnectorClient, statusChanges *notify.Listener, config *viper.Viper) ServerInterfaceWrapper {
 	return ServerInterfaceWrapper{
 		Handler: &controllers{
 			database:             database,
 			cloudConnectorClient: cloudConnectorClient,
+			statusChanges:        statusChanges,
+			config:               config,
 		},
 	}
 }
@@ -19,4 +23,6 @@ func CreateController(database *gorm.DB, cloudConnectorClient connectors.CloudCo
 type controllers struct {
 	database             *gorm.DB
 	cloudConnectorClient connectors.CloudConnectorClient
+	statusChanges        *notify.Listener
+	config               *viper.Viper
 }
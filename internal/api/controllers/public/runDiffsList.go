@@ -0,0 +1,87 @@
+package public
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+)
+
+func (this *controllers) ApiRunDiffsList(ctx echo.Context, id RunIdPath) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+
+	// tenant isolation
+	queryBuilder := this.database.WithContext(ctx.Request().Context()).
+		Table("runs").
+		Select("id").
+		Where("runs.id = ?", id).
+		Where("org_id = ?", identity.Identity.OrgID)
+
+	// rbac + kessel
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("service IN ?", allowedServices)
+	}
+
+	var dbRuns []dbModel.Run
+	dbResult := queryBuilder.Limit(1).Find(&dbRuns)
+
+	if dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if len(dbRuns) == 0 {
+		return ctx.JSON(http.StatusNotFound, &Error{Message: "Run not found"})
+	}
+
+	var dbRunHosts []dbModel.RunHost
+	dbResult = this.database.WithContext(ctx.Request().Context()).
+		Table("run_hosts").
+		Select("host", "diffs").
+		Where("run_id = ?", id).
+		Order("host").
+		Find(&dbRunHosts)
+
+	if dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := RunDiffs{Data: []TaskDiff{}}
+
+	for _, runHost := range dbRunHosts {
+		for _, diff := range runHost.Diffs {
+			item := TaskDiff{
+				Host:    runHost.Host,
+				Play:    diff.Play,
+				Task:    diff.Task,
+				Changes: make([]DiffChange, len(diff.Changes)),
+			}
+
+			for i, change := range diff.Changes {
+				item.Changes[i] = DiffChange{
+					Before:       optionalValue(change.Before),
+					After:        optionalValue(change.After),
+					BeforeHeader: change.BeforeHeader,
+					AfterHeader:  change.AfterHeader,
+					Prepared:     change.Prepared,
+				}
+			}
+
+			response.Data = append(response.Data, item)
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, &response)
+}
+
+func optionalValue(value interface{}) *interface{} {
+	if value == nil {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,166 @@
+package public
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/common/notify"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+)
+
+type statusChangePredicate func(change *notify.StatusChange) bool
+
+func (this *controllers) ApiRunEventsStream(ctx echo.Context, params ApiRunEventsStreamParams) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+
+	// tenant isolation
+	predicates := []statusChangePredicate{func(change *notify.StatusChange) bool {
+		return change.OrgID == identity.Identity.OrgID
+	}}
+
+	// rbac + kessel
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		services := utils.IndexStrings(allowedServices...)
+		predicates = append(predicates, func(change *notify.StatusChange) bool {
+			_, ok := services[change.Service]
+			return ok
+		})
+	}
+
+	if params.Filter != nil {
+		if params.Filter.Status != nil && *params.Filter.Status != "" {
+			status := string(*params.Filter.Status)
+			predicates = append(predicates, func(change *notify.StatusChange) bool {
+				return change.Status == status
+			})
+		}
+
+		if params.Filter.Recipient != nil && *params.Filter.Recipient != "" {
+			recipient, err := uuid.Parse(*params.Filter.Recipient)
+			if err != nil {
+				instrumentation.PlaybookApiRequestError(ctx, err)
+				return echo.NewHTTPError(http.StatusBadRequest, "Unable to parse recipient!")
+			}
+
+			predicates = append(predicates, func(change *notify.StatusChange) bool {
+				return change.Recipient == recipient
+			})
+		}
+
+		if params.Filter.Service != nil && *params.Filter.Service != "" {
+			service := *params.Filter.Service
+			predicates = append(predicates, func(change *notify.StatusChange) bool {
+				return change.Service == service
+			})
+		}
+	}
+
+	if labelFilters := middleware.GetDeepObject(ctx, "filter", "labels"); len(labelFilters) > 0 {
+		labels := make(map[string]string)
+
+		// same as with the runs resource the last value wins for duplicate keys
+		for key, values := range labelFilters {
+			for _, value := range values {
+				labels[key] = value
+			}
+		}
+
+		predicates = append(predicates, func(change *notify.StatusChange) bool {
+			for key, value := range labels {
+				if actual, ok := change.Labels[key]; !ok || actual != value {
+					return false
+				}
+			}
+
+			return true
+		})
+	}
+
+	changes, unsubscribe := this.statusChanges.Subscribe(this.config.GetInt("run.events.buffer"))
+	defer unsubscribe()
+
+	response := ctx.Response()
+	response.Header().Set(echo.HeaderContentType, "text/event-stream")
+	response.Header().Set(echo.HeaderCacheControl, "no-cache")
+	response.Header().Set(echo.HeaderConnection, "keep-alive")
+	response.WriteHeader(http.StatusOK)
+	response.Flush()
+
+	keepalive := time.NewTicker(time.Duration(this.config.GetInt("run.events.keepalive.interval")) * time.Second)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Request().Context().Done():
+			return nil
+		case <-keepalive.C:
+			if _, err := fmt.Fprint(response, ": keepalive\n\n"); err != nil {
+				return nil
+			}
+
+			response.Flush()
+		case change, ok := <-changes:
+			if !ok {
+				// the listener has been stopped
+				return nil
+			}
+
+			if !matchesAll(&change, predicates) {
+				continue
+			}
+
+			data, err := json.Marshal(statusChangeToApiStatusChange(&change))
+			if err != nil {
+				utils.GetLogFromEcho(ctx).Errorw("Error serializing status change", "error", err)
+				continue
+			}
+
+			if _, err := fmt.Fprintf(response, "event: %s\ndata: %s\n\n", change.Type, data); err != nil {
+				return nil
+			}
+
+			response.Flush()
+		}
+	}
+}
+
+func matchesAll(change *notify.StatusChange, predicates []statusChangePredicate) bool {
+	for _, predicate := range predicates {
+		if !predicate(change) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func statusChangeToApiStatusChange(change *notify.StatusChange) *RunStatusChange {
+	orgId := OrgId(change.OrgID)
+	service := Service(change.Service)
+	labels := Labels{}
+	if change.Labels != nil {
+		labels = change.Labels
+	}
+
+	return &RunStatusChange{
+		Type:        RunStatusChangeType(change.Type),
+		Status:      RunStatus(change.Status),
+		Host:        change.Host,
+		InventoryId: change.InventoryID,
+		Timestamp:   change.Timestamp,
+		Run: Run{
+			Id:        &change.RunID,
+			OrgId:     &orgId,
+			Recipient: &change.Recipient,
+			Service:   &service,
+			Labels:    &labels,
+		},
+	}
+}
@@ -0,0 +1,128 @@
+package public
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"gorm.io/gorm"
+)
+
+type statusCount struct {
+	Status string
+	Count  int
+}
+
+func (this *controllers) ApiRunGet(ctx echo.Context, id RunIdPath, params ApiRunGetParams) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+	db := this.database.WithContext(ctx.Request().Context())
+
+	fields, err := parseFields(middleware.GetDeepObject(ctx, "fields"), "data", singleRunFields, defaultRunFields)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	includeHostsSummary := false
+	selectedFields := []string{}
+	for _, field := range fields {
+		if field == fieldHostsSummary {
+			includeHostsSummary = true
+		} else {
+			selectedFields = append(selectedFields, field)
+		}
+	}
+
+	// tenant isolation
+	queryBuilder := db.Table("runs").Where("runs.id = ?", id).Where("org_id = ?", identity.Identity.OrgID)
+
+	// rbac + kessel
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("service IN ?", allowedServices)
+	}
+
+	// the id is always selected so that the existence of the run can be determined even if no other fields are requested
+	columns := utils.MapStrings(selectedFields, mapFieldsToSql)
+	if _, ok := utils.IndexStrings(selectedFields...)[fieldId]; !ok {
+		columns = append(columns, fieldId)
+	}
+
+	queryBuilder.Select(columns)
+
+	var dbRuns []dbModel.Run
+	dbResult := queryBuilder.Limit(1).Find(&dbRuns)
+
+	if dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if len(dbRuns) == 0 {
+		return ctx.JSON(http.StatusNotFound, &Error{Message: "Run not found"})
+	}
+
+	response := dbRuntoApiRun(&dbRuns[0], selectedFields)
+
+	if includeHostsSummary {
+		summary, err := getRunHostsSummary(db, id)
+		if err != nil {
+			instrumentation.PlaybookRunReadError(ctx, err)
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		response.HostsSummary = summary
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+func getRunHostsSummary(db *gorm.DB, runId RunIdPath) (*RunHostsSummary, error) {
+	var counts []statusCount
+
+	// set status to "timeout" on read if the run has expired
+	result := db.Table("run_hosts").
+		Select(`CASE WHEN run_hosts.status='running' AND COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW() THEN 'timeout' ELSE run_hosts.status END as status, COUNT(*) as count`).
+		Joins("INNER JOIN runs on runs.id = run_hosts.run_id").
+		Where("run_hosts.run_id = ?", runId).
+		Group("1").
+		Scan(&counts)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	summary := countByStatus(counts)
+	return &summary, nil
+}
+
+func countByStatus(counts []statusCount) RunHostsSummary {
+	summary := RunHostsSummary{}
+
+	for _, count := range counts {
+		summary.Total += count.Count
+
+		switch count.Status {
+		case dbModel.RunStatusRunning:
+			summary.Running += count.Count
+		case dbModel.RunStatusSuccess:
+			summary.Success += count.Count
+		case dbModel.RunStatusFailure:
+			summary.Failure += count.Count
+		case dbModel.RunStatusTimeout:
+			summary.Timeout += count.Count
+		case dbModel.RunStatusCanceled:
+			summary.Canceled += count.Count
+		case dbModel.RunStatusScheduled:
+			summary.Scheduled += count.Count
+		case dbModel.RunStatusExpired:
+			summary.Expired += count.Count
+		case dbModel.RunStatusUnreachable:
+			summary.Unreachable += count.Count
+		}
+	}
+
+	return summary
+}
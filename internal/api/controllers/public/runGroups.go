@@ -0,0 +1,237 @@
+package public
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"gorm.io/gorm"
+)
+
+// set status to "timeout" on read if the run has expired
+const groupRunStatusSql = `CASE WHEN runs.status='running' AND COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW() THEN 'timeout' ELSE runs.status::text END`
+
+// a run that has been retried is superseded by its retry and does not count towards the status of the group
+const groupRunLatestAttemptSql = `NOT EXISTS (SELECT 1 FROM runs AS retry WHERE retry.parent_run_id = runs.id)`
+
+// the group is running until all of its runs finish, afterwards its status depends on how many of its runs succeeded
+const runGroupStatusSql = `(
+	SELECT CASE
+		WHEN COUNT(*) FILTER (WHERE group_runs.status IN ('running', 'scheduled')) > 0 THEN 'running'
+		WHEN COUNT(*) FILTER (WHERE group_runs.status = 'success') = COUNT(*) THEN 'success'
+		WHEN COUNT(*) FILTER (WHERE group_runs.status = 'success') > 0 THEN 'partial'
+		ELSE 'failure'
+	END
+	FROM (
+		SELECT ` + groupRunStatusSql + ` AS status FROM runs WHERE runs.group_id = run_groups.id AND ` + groupRunLatestAttemptSql + `
+	) AS group_runs
+)`
+
+var runGroupFields = []string{
+	"run_groups.id",
+	"run_groups.org_id",
+	"run_groups.service",
+	"run_groups.created_at",
+	runGroupStatusSql + " AS status",
+	"rollouts.status AS rollout_status",
+	"rollouts.wave AS rollout_wave",
+	"rollouts.waves AS rollout_waves",
+}
+
+type runGroupWithStatus struct {
+	ID        uuid.UUID
+	OrgID     string
+	Service   string
+	CreatedAt time.Time
+	Status    string
+
+	RolloutStatus *string
+	RolloutWave   *int
+	RolloutWaves  *int
+}
+
+type groupStatusCount struct {
+	GroupID uuid.UUID
+	Status  string
+	Count   int
+}
+
+func (this *controllers) ApiRunGroupsList(ctx echo.Context, params ApiRunGroupsListParams) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+	db := this.database.WithContext(ctx.Request().Context())
+
+	// tenant isolation
+	queryBuilder := db.Table("run_groups").Where("run_groups.org_id = ?", identity.Identity.OrgID)
+
+	// rbac + kessel
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("run_groups.service IN ?", allowedServices)
+	}
+
+	if params.Filter != nil {
+		if params.Filter.Status != nil && *params.Filter.Status != "" {
+			queryBuilder.Where(runGroupStatusSql+" = ?", string(*params.Filter.Status))
+		}
+
+		if params.Filter.Service != nil && *params.Filter.Service != "" {
+			queryBuilder.Where("run_groups.service = ?", *params.Filter.Service)
+		}
+	}
+
+	var total *int
+	if getIncludeTotal(params.IncludeTotal) {
+		var count int64
+		if countResult := queryBuilder.Count(&count); countResult.Error != nil {
+			instrumentation.PlaybookRunReadError(ctx, countResult.Error)
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		total = utils.IntRef(int(count))
+	}
+
+	limit := getLimit(params.Limit)
+	offset := getOffset(params.Offset)
+
+	var groups []runGroupWithStatus
+	dbResult := queryBuilder.
+		Select(runGroupFields).
+		Joins("LEFT JOIN rollouts ON rollouts.group_id = run_groups.id").
+		Order("run_groups.created_at desc").
+		Order("run_groups.id"). // secondary criteria to guarantee stable sorting
+		Offset(offset).
+		Limit(limit + 1). // fetch one extra row to find out whether there is a next page
+		Scan(&groups)
+
+	if dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	hasMore := len(groups) > limit
+	if hasMore {
+		groups = groups[:limit]
+	}
+
+	summaries, err := getRunGroupsSummaries(db, groups)
+	if err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := make([]RunGroup, len(groups))
+	for i, group := range groups {
+		response[i] = dbRunGroupToApiRunGroup(group, summaries[group.ID])
+	}
+
+	return ctx.JSON(http.StatusOK, &RunGroups{
+		Data: response,
+		Meta: Meta{
+			Count: len(response),
+			Total: total,
+		},
+		Links: createLinks("/api/playbook-dispatcher/v1/run_groups", middleware.GetQueryString(ctx), limit, offset, total, hasMore),
+	})
+}
+
+func (this *controllers) ApiRunGroupGet(ctx echo.Context, id RunGroupIdPath) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+	db := this.database.WithContext(ctx.Request().Context())
+
+	// tenant isolation
+	queryBuilder := db.Table("run_groups").Where("run_groups.id = ?", id).Where("run_groups.org_id = ?", identity.Identity.OrgID)
+
+	// rbac + kessel
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("run_groups.service IN ?", allowedServices)
+	}
+
+	var groups []runGroupWithStatus
+	dbResult := queryBuilder.
+		Select(runGroupFields).
+		Joins("LEFT JOIN rollouts ON rollouts.group_id = run_groups.id").
+		Limit(1).
+		Scan(&groups)
+
+	if dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if len(groups) == 0 {
+		return ctx.JSON(http.StatusNotFound, &Error{Message: "Run group not found"})
+	}
+
+	summaries, err := getRunGroupsSummaries(db, groups)
+	if err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	response := dbRunGroupToApiRunGroup(groups[0], summaries[groups[0].ID])
+	return ctx.JSON(http.StatusOK, &response)
+}
+
+// counts the runs of the given groups by their status
+func getRunGroupsSummaries(db *gorm.DB, groups []runGroupWithStatus) (map[uuid.UUID][]statusCount, error) {
+	result := make(map[uuid.UUID][]statusCount, len(groups))
+
+	if len(groups) == 0 {
+		return result, nil
+	}
+
+	ids := make([]uuid.UUID, len(groups))
+	for i, group := range groups {
+		ids[i] = group.ID
+	}
+
+	var counts []groupStatusCount
+	dbResult := db.Table("runs").
+		Select("runs.group_id", groupRunStatusSql+" AS status", "COUNT(*) AS count").
+		Where("runs.group_id IN ?", ids).
+		Where(groupRunLatestAttemptSql).
+		Group("1, 2").
+		Scan(&counts)
+
+	if dbResult.Error != nil {
+		return nil, dbResult.Error
+	}
+
+	for _, count := range counts {
+		result[count.GroupID] = append(result[count.GroupID], statusCount{Status: count.Status, Count: count.Count})
+	}
+
+	return result, nil
+}
+
+func dbRunGroupToApiRunGroup(group runGroupWithStatus, counts []statusCount) RunGroup {
+	result := RunGroup{
+		Id:          group.ID,
+		OrgId:       group.OrgID,
+		Service:     group.Service,
+		Status:      RunGroupStatus(group.Status),
+		RunsSummary: RunGroupRunsSummary(countByStatus(counts)),
+		CreatedAt:   group.CreatedAt,
+	}
+
+	if group.RolloutStatus != nil {
+		// the runs of a pending rollout are being created
+		status := RunGroupRolloutStatus(*group.RolloutStatus)
+		if *group.RolloutStatus == dbModel.RolloutStatusPending {
+			status = RunGroupRolloutStatusInProgress
+		}
+
+		result.Rollout = &RunGroupRollout{
+			Status: status,
+			Wave:   *group.RolloutWave,
+			Waves:  *group.RolloutWaves,
+		}
+	}
+
+	return result
+}
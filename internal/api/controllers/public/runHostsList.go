@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"gorm.io/gorm"
 )
 
 func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsListParams) error {
@@ -24,6 +25,12 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	after, err := parseCursor(params.Cursor, params.Offset)
+	if err != nil {
+		instrumentation.PlaybookApiRequestError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	queryBuilder := this.database.
 		WithContext(ctx.Request().Context()).
 		Table("run_hosts").
@@ -42,10 +49,10 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 			status := *params.Filter.Status
 			switch status {
 			case dbModel.RunStatusTimeout:
-				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW()")
+				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW()")
 			case dbModel.RunStatusRunning:
 				queryBuilder.Where("run_hosts.status = ?", status)
-				queryBuilder.Where("runs.created_at + runs.timeout * interval '1 second' > NOW()")
+				queryBuilder.Where("COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' > NOW()")
 			default:
 				queryBuilder.Where("run_hosts.status = ?", status)
 			}
@@ -78,20 +85,40 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 
 			queryBuilder.Where("run_hosts.inventory_id = ?", inventoryId)
 		}
+
+		whereStats(queryBuilder, "changed", params.Filter.Changed)
+		whereStats(queryBuilder, "failures", params.Filter.Failed)
+		whereStats(queryBuilder, "unreachable", params.Filter.Unreachable)
 	}
 
-	var total int64
-	countResult := queryBuilder.Count(&total)
+	var total *int
+	if getIncludeTotal(params.IncludeTotal) {
+		var count int64
+		countResult := queryBuilder.Count(&count)
 
-	if countResult.Error != nil {
-		instrumentation.PlaybookRunReadError(ctx, countResult.Error)
-		return ctx.NoContent(http.StatusInternalServerError)
+		if countResult.Error != nil {
+			instrumentation.PlaybookRunReadError(ctx, countResult.Error)
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		total = utils.IntRef(int(count))
+	}
+
+	// newest first, id as secondary criteria to guarantee stable sorting
+	queryBuilder.Order("run_hosts.created_at desc")
+	queryBuilder.Order("run_hosts.id")
+
+	if params.Cursor != nil {
+		whereAfterCursor(queryBuilder, "run_hosts", true, after)
+	} else {
+		queryBuilder.Offset(offset)
 	}
 
-	queryBuilder.Limit(limit)
-	queryBuilder.Offset(offset)
+	// fetch one extra row to find out whether there is a next page
+	queryBuilder.Limit(limit + 1)
 
-	queryBuilder.Select(utils.MapStrings(fields, mapHostFieldsToSql))
+	// id and created_at are needed to build the cursor
+	queryBuilder.Select(append(utils.MapStrings(fields, mapHostFieldsToSql), "run_hosts.id", "run_hosts.created_at"))
 
 	var dbRunHosts []dbModel.RunHost
 	dbResult := queryBuilder.Find(&dbRunHosts)
@@ -101,6 +128,25 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	hasMore := len(dbRunHosts) > limit
+	if hasMore {
+		dbRunHosts = dbRunHosts[:limit]
+	}
+
+	const base = "/api/playbook-dispatcher/v1/run_hosts"
+	var links Links
+	if params.Cursor != nil {
+		var next *string
+		if hasMore {
+			last := dbRunHosts[len(dbRunHosts)-1]
+			next = utils.StringRef(encodeCursor(last.CreatedAt, last.ID))
+		}
+
+		links = createCursorLinks(base, middleware.GetQueryString(ctx), limit, next)
+	} else {
+		links = createLinks(base, middleware.GetQueryString(ctx), limit, offset, total, hasMore)
+	}
+
 	hosts := []RunHost{}
 
 	for _, host := range dbRunHosts {
@@ -128,6 +174,18 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 				if host.InventoryID != nil {
 					runHost.InventoryId = host.InventoryID
 				}
+			case fieldStats:
+				if host.Stats != nil {
+					runHost.Stats = &RunHostStats{
+						Ok:          host.Stats.Ok,
+						Changed:     host.Stats.Changed,
+						Failures:    host.Stats.Failures,
+						Unreachable: host.Stats.Unreachable,
+						Skipped:     host.Stats.Skipped,
+						Rescued:     host.Stats.Rescued,
+						Ignored:     host.Stats.Ignored,
+					}
+				}
 			}
 		}
 
@@ -138,9 +196,9 @@ func (this *controllers) ApiRunHostsList(ctx echo.Context, params ApiRunHostsLis
 		Data: hosts,
 		Meta: Meta{
 			Count: len(hosts),
-			Total: int(total),
+			Total: total,
 		},
-		Links: createLinks("/api/playbook-dispatcher/v1/run_hosts", middleware.GetQueryString(ctx), getLimit(params.Limit), getOffset(params.Offset), int(total)),
+		Links: links,
 	})
 }
 
@@ -158,11 +216,28 @@ func mapHostFieldsToSql(field string) string {
 		return "run_hosts.inventory_id"
 	case fieldInventoryId:
 		return "run_hosts.inventory_id"
+	case fieldStats:
+		return "run_hosts.stats"
 	default:
 		panic("unknown field " + field)
 	}
 }
 
+// filters hosts based on a counter of the play recap
+// hosts which have not reported the play recap yet never match
+func whereStats(queryBuilder *gorm.DB, counter string, value *bool) {
+	if value == nil {
+		return
+	}
+
+	operator := "="
+	if *value {
+		operator = ">"
+	}
+
+	queryBuilder.Where(fmt.Sprintf("(run_hosts.stats->>'%s')::int %s 0", counter, operator))
+}
+
 func inventoryLink(inventoryID *uuid.UUID) *string {
 	if inventoryID == nil {
 		return nil
@@ -0,0 +1,82 @@
+package public
+
+import (
+	"encoding/json"
+	"net/http"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/common/ansible"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	messageModel "playbook-dispatcher/internal/common/model/message"
+
+	"github.com/labstack/echo/v4"
+	identityMiddleware "github.com/redhatinsights/platform-go-middlewares/v2/identity"
+)
+
+func (this *controllers) ApiRunTaskResultsList(ctx echo.Context, id RunIdPath) error {
+	identity := identityMiddleware.GetIdentity(ctx.Request().Context())
+
+	// tenant isolation
+	queryBuilder := this.database.WithContext(ctx.Request().Context()).
+		Table("runs").
+		Select("id", "events", "sat_id", "extra_vars").
+		Where("runs.id = ?", id).
+		Where("org_id = ?", identity.Identity.OrgID)
+
+	// rbac + kessel
+	if allowedServices := middleware.GetAllowedServices(ctx); len(allowedServices) > 0 {
+		queryBuilder.Where("service IN ?", allowedServices)
+	}
+
+	var dbRuns []dbModel.Run
+	dbResult := queryBuilder.Limit(1).Find(&dbRuns)
+
+	if dbResult.Error != nil {
+		instrumentation.PlaybookRunReadError(ctx, dbResult.Error)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	if len(dbRuns) == 0 {
+		return ctx.JSON(http.StatusNotFound, &Error{Message: "Run not found"})
+	}
+
+	response := TaskResults{Data: []TaskResult{}}
+
+	// satellite runs report events in a different format which does not describe individual tasks
+	if dbRuns[0].SatId != nil || len(dbRuns[0].Events) == 0 {
+		return ctx.JSON(http.StatusOK, &response)
+	}
+
+	var events []messageModel.PlaybookRunResponseMessageYamlEventsElem
+	if err := json.Unmarshal(dbRuns[0].Events, &events); err != nil {
+		instrumentation.PlaybookRunReadError(ctx, err)
+		return ctx.NoContent(http.StatusInternalServerError)
+	}
+
+	results := ansible.GetTaskResults(events)
+
+	// task messages may include the values of secret extra vars
+	if dbRuns[0].ExtraVars != nil {
+		results = ansible.RedactTaskResults(results, dbRuns[0].ExtraVars.SecretValues())
+	}
+
+	for _, result := range results {
+		item := TaskResult{
+			Host:    result.Host,
+			Play:    result.Play,
+			Task:    result.Task,
+			Status:  TaskResultStatus(result.Status),
+			Ignored: result.Ignored,
+			Message: result.Message,
+		}
+
+		if result.Duration != nil {
+			duration := float32(*result.Duration)
+			item.Duration = &duration
+		}
+
+		response.Data = append(response.Data, item)
+	}
+
+	return ctx.JSON(http.StatusOK, &response)
+}
@@ -8,6 +8,7 @@ import (
 	"playbook-dispatcher/internal/api/middleware"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -31,7 +32,7 @@ func getOrderBy(params ApiRunsListParams) string {
 func mapFieldsToSql(field string) string {
 	// set status to "timeout" on read if the run has expired
 	if field == fieldStatus {
-		return `CASE WHEN runs.status='running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW() THEN 'timeout' ELSE runs.status END as status`
+		return `CASE WHEN runs.status='running' AND COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW() THEN 'timeout' ELSE runs.status END as status`
 	}
 
 	// column names for these fields are different in the db
@@ -46,6 +47,19 @@ func mapFieldsToSql(field string) string {
 	return field
 }
 
+// id and created_at are needed to build the cursor even if they are not requested
+func runSelectColumns(fields []string) []string {
+	columns := utils.MapStrings(fields, mapFieldsToSql)
+
+	for _, field := range []string{fieldId, fieldCreatedAt} {
+		if !slices.Contains(fields, field) {
+			columns = append(columns, field)
+		}
+	}
+
+	return columns
+}
+
 func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams) error {
 	var dbRuns []dbModel.Run
 
@@ -67,15 +81,21 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
+	after, err := parseCursor(params.Cursor, params.Offset)
+	if err != nil {
+		instrumentation.PlaybookApiRequestError(ctx, err)
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
 	if params.Filter != nil {
 		if params.Filter.Status != nil && *params.Filter.Status != "" {
 			status := *params.Filter.Status
 			switch status {
 			case dbModel.RunStatusTimeout:
-				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND runs.created_at + runs.timeout * interval '1 second' <= NOW()")
+				queryBuilder.Where("runs.status = 'timeout' OR runs.status = 'running' AND COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW()")
 			case dbModel.RunStatusRunning:
 				queryBuilder.Where("runs.status = ?", status)
-				queryBuilder.Where("runs.created_at + runs.timeout * interval '1 second' > NOW()")
+				queryBuilder.Where("COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' > NOW()")
 			default:
 				queryBuilder.Where("runs.status = ?", status)
 			}
@@ -104,21 +124,36 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		}
 	}
 
-	var total int64
-	countResult := queryBuilder.Count(&total)
+	var total *int
+	if getIncludeTotal(params.IncludeTotal) {
+		var count int64
+		countResult := queryBuilder.Count(&count)
 
-	if countResult.Error != nil {
-		instrumentation.PlaybookRunReadError(ctx, countResult.Error)
-		return ctx.NoContent(http.StatusInternalServerError)
+		if countResult.Error != nil {
+			instrumentation.PlaybookRunReadError(ctx, countResult.Error)
+			return ctx.NoContent(http.StatusInternalServerError)
+		}
+
+		total = utils.IntRef(int(count))
 	}
 
-	queryBuilder.Select(utils.MapStrings(fields, mapFieldsToSql))
+	queryBuilder.Select(runSelectColumns(fields))
 
-	queryBuilder.Order(getOrderBy(params))
+	orderBy := getOrderBy(params)
+	queryBuilder.Order(orderBy)
 	queryBuilder.Order("id") // secondary criteria to guarantee stable sorting
 
-	queryBuilder.Limit(getLimit(params.Limit))
-	queryBuilder.Offset(getOffset(params.Offset))
+	limit := getLimit(params.Limit)
+	offset := getOffset(params.Offset)
+
+	if params.Cursor != nil {
+		whereAfterCursor(queryBuilder, "runs", strings.HasSuffix(orderBy, "desc"), after)
+	} else {
+		queryBuilder.Offset(offset)
+	}
+
+	// fetch one extra row to find out whether there is a next page
+	queryBuilder.Limit(limit + 1)
 
 	dbResult := queryBuilder.Find(&dbRuns)
 
@@ -127,6 +162,25 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		return ctx.NoContent(http.StatusInternalServerError)
 	}
 
+	hasMore := len(dbRuns) > limit
+	if hasMore {
+		dbRuns = dbRuns[:limit]
+	}
+
+	const base = "/api/playbook-dispatcher/v1/runs"
+	var links Links
+	if params.Cursor != nil {
+		var next *string
+		if hasMore {
+			last := dbRuns[len(dbRuns)-1]
+			next = utils.StringRef(encodeCursor(last.CreatedAt, last.ID))
+		}
+
+		links = createCursorLinks(base, middleware.GetQueryString(ctx), limit, next)
+	} else {
+		links = createLinks(base, middleware.GetQueryString(ctx), limit, offset, total, hasMore)
+	}
+
 	response := make([]Run, len(dbRuns))
 
 	for i, v := range dbRuns {
@@ -137,9 +191,9 @@ func (this *controllers) ApiRunsList(ctx echo.Context, params ApiRunsListParams)
 		Data: response,
 		Meta: Meta{
 			Count: len(response),
-			Total: int(total),
+			Total: total,
 		},
-		Links: createLinks("/api/playbook-dispatcher/v1/runs", middleware.GetQueryString(ctx), getLimit(params.Limit), getOffset(params.Offset), int(total)),
+		Links: links,
 	})
 }
 
@@ -20,12 +20,30 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Stream status changes of Playbook runs
+	// (GET /api/playbook-dispatcher/v1/run_events)
+	ApiRunEventsStream(ctx echo.Context, params ApiRunEventsStreamParams) error
+	// List run groups
+	// (GET /api/playbook-dispatcher/v1/run_groups)
+	ApiRunGroupsList(ctx echo.Context, params ApiRunGroupsListParams) error
+	// Get a run group
+	// (GET /api/playbook-dispatcher/v1/run_groups/{id})
+	ApiRunGroupGet(ctx echo.Context, id RunGroupIdPath) error
 	// List hosts involved in Playbook runs
 	// (GET /api/playbook-dispatcher/v1/run_hosts)
 	ApiRunHostsList(ctx echo.Context, params ApiRunHostsListParams) error
 	// List Playbook runs
 	// (GET /api/playbook-dispatcher/v1/runs)
 	ApiRunsList(ctx echo.Context, params ApiRunsListParams) error
+	// Get a Playbook run
+	// (GET /api/playbook-dispatcher/v1/runs/{id})
+	ApiRunGet(ctx echo.Context, id RunIdPath, params ApiRunGetParams) error
+	// List changes predicted by a Playbook run in check mode
+	// (GET /api/playbook-dispatcher/v1/runs/{id}/diffs)
+	ApiRunDiffsList(ctx echo.Context, id RunIdPath) error
+	// List results of tasks of a Playbook run
+	// (GET /api/playbook-dispatcher/v1/runs/{id}/task_results)
+	ApiRunTaskResultsList(ctx echo.Context, id RunIdPath) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -33,6 +51,79 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// ApiRunEventsStream converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunEventsStream(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiRunEventsStreamParams
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("deepObject", true, false, "filter", ctx.QueryParams(), &params.Filter, runtime.BindQueryParameterOptions{Type: "object", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunEventsStream(ctx, params)
+	return err
+}
+
+// ApiRunGroupsList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunGroupsList(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiRunGroupsListParams
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameterWithOptions("deepObject", true, false, "filter", ctx.QueryParams(), &params.Filter, runtime.BindQueryParameterOptions{Type: "object", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter filter: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", ctx.QueryParams(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "offset", ctx.QueryParams(), &params.Offset, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunGroupsList(ctx, params)
+	return err
+}
+
+// ApiRunGroupGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunGroupGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id RunGroupIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunGroupGet(ctx, id)
+	return err
+}
+
 // ApiRunHostsList converts echo context to params.
 func (w *ServerInterfaceWrapper) ApiRunHostsList(ctx echo.Context) error {
 	var err error
@@ -67,6 +158,20 @@ func (w *ServerInterfaceWrapper) ApiRunHostsList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ApiRunHostsList(ctx, params)
 	return err
@@ -113,11 +218,82 @@ func (w *ServerInterfaceWrapper) ApiRunsList(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_total" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_total", ctx.QueryParams(), &params.IncludeTotal, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_total: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ApiRunsList(ctx, params)
 	return err
 }
 
+// ApiRunGet converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunGet(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id RunIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ApiRunGetParams
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameterWithOptions("deepObject", true, false, "fields", ctx.QueryParams(), &params.Fields, runtime.BindQueryParameterOptions{Type: "object", Format: ""})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fields: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunGet(ctx, id, params)
+	return err
+}
+
+// ApiRunDiffsList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunDiffsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id RunIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunDiffsList(ctx, id)
+	return err
+}
+
+// ApiRunTaskResultsList converts echo context to params.
+func (w *ServerInterfaceWrapper) ApiRunTaskResultsList(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id RunIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ApiRunTaskResultsList(ctx, id)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -146,54 +322,101 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/api/playbook-dispatcher/v1/run_events", wrapper.ApiRunEventsStream)
+	router.GET(baseURL+"/api/playbook-dispatcher/v1/run_groups", wrapper.ApiRunGroupsList)
+	router.GET(baseURL+"/api/playbook-dispatcher/v1/run_groups/:id", wrapper.ApiRunGroupGet)
 	router.GET(baseURL+"/api/playbook-dispatcher/v1/run_hosts", wrapper.ApiRunHostsList)
 	router.GET(baseURL+"/api/playbook-dispatcher/v1/runs", wrapper.ApiRunsList)
+	router.GET(baseURL+"/api/playbook-dispatcher/v1/runs/:id", wrapper.ApiRunGet)
+	router.GET(baseURL+"/api/playbook-dispatcher/v1/runs/:id/diffs", wrapper.ApiRunDiffsList)
+	router.GET(baseURL+"/api/playbook-dispatcher/v1/runs/:id/task_results", wrapper.ApiRunTaskResultsList)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9Ra3W8bxxH/VxbbPrQATVK2E6R8qqzEsFHFNuSoDWAL8vJ2SK59t3veD0qswf+9mNn7",
-	"viOPctLAebOOM7Oz8/mbWX/hiclyo0F7xxdfeC6syMCDpb8uVaY8/kOCS6zKvTKaL/jP4l5lIWM6ZEuw",
-	"zKyYBRdS75g3zIIPVvMJV0j6OYDd8QnXIgO+4CkJnHCXbCATUfJKhNTzxXfzCc+iYL54PMe/lI5/nU24",
-	"3+XIr7SHNVi+30/469XKwYB2L7VUifDgmN8Ac15Yr/Sa5cYppEB18QfSjFlIhVdbQM3xK1ojBQ/MgUdK",
-	"5SFDQcKzTPhkU7MeuKGJWg1esXmn+eCdroJ+YZx/riCVrn+1H2GlNDi2ot9R5yUUBgfJlCbtLLjcaAfT",
-	"9+gFuM9TI4EvvA0wrHKU1lI5tyYH6xVEJYRvX+Qd3xhHl/TCB2S1QfObCSdzISlovGRFhz83qJ2XJuD3",
-	"VOlPjiy5Be2N3d0qiXIK0zhvlV7zffVBWCt2ZKnig1l+hMQjhfO7FL9IgPx19bUyaOrB9g16nqbmzrGV",
-	"sWxFJBgpS+FAMqPZVlhlgmOJVfiTONWcdNZhc7Yuu/jC/2phxRf8L7M6FWeR181elrQv5auQpmKZAl4L",
-	"7bn4wnX5qVCncw5J75kyFUtI3djBV0FfEmHzWAd2qxIY430byWrOYX9RMIyJIqoxSQc87779PFKST7ix",
-	"61v6h4VE5Qo0pkawKa+cNeFeZRBzpjDcULYdlpYYG0ud0fHHMfG1s4v7TfgdLG8To51J4TayJxaEB3kr",
-	"SOFcln/8zhnsvqn0/Q35U3tkKDG/Nrv+mFx6a6x/tuv7AL8zYyXZbMigzlh/u9wNt8RGCC1QLvqoCOZW",
-	"cDXIBFF1+fohtyeDxxwm2zwT8go+B3Bk/sRoX3hC5HmKkEEZPfvoDJXWWtdjJv3JWmPjUW2rPBOSlYft",
-	"J/y5sUslJej//8nnSQLOlXhmrbagsZSZYBNgyjFtPBOYNyApBAqBeN55kpigC0iVW0AUJctc6YAsCdqr",
-	"lYrwD0/yoAWVmkzcX4Je+w1fnEXEU/05UBYuoh/PB4DcOcOq5LzIcna3gViVQXu7Y3cC05o4+YSvjM0E",
-	"BhMWoEfIxAdOihbrpXMGzok1DGQkxc/noCwa4V1FeDOQNkNteqA/93S6rGqJkJLgqUjftNTrsXRsVLGx",
-	"DLzADsPE0gRPxnqTit3SmE/MBj1lF0JjnwtYHtslMw82Nw7clA/c7ZIw2kEVVyJ1PeixUtYNeLRC24j7",
-	"yhAlWpaLNXShOc0UQ65MxcnSkfRhwjXcnyocSR8mPLewxaZ04gEl+UMO6YRtdEVhs6HY/RkiKjnq3u6I",
-	"FVMOx6kYbRV4wrJAnN2QaJSWpqj+DFmKonYtcByLw2F3XJpwb7xI+yLp88BwSgMchntzhquOODt7OjiS",
-	"NW0Z71AePGTM13b9Ug7MpIfLZaUA/+7J2Q+P/zF/cAkts/wV9dvu0S9CJrADCImViGFTLnXIW+XhGuuC",
-	"NxhzDrRvtI8mHaJjuPdgseS4naP5+G9vhYc0VR7+Pm1d6bm6ZxdWeZWIlF38+ycMi5HbXMXRph08ou5M",
-	"x5pi2cD2PcA7jtYuaoaXkiTU+GOEu+5h+wk/6bB4wmlIsugR+xJQHaduBcO+mgZGuGLYdhHqyCWuKtoH",
-	"g9fTQetV0BG3UsIXU8o4zy8F5b41l4zwXUfK6EeccMbobYqU3blohOs/sLyI1MQ/hMJ74djL6mutPgdg",
-	"qq4roUjfuKa6M/YTsxGAsjvlN6yGwsNJ98LEntpOvI0Z6rQYXNWBhQ47JhhS02lKs3PtFBacatsxdG53",
-	"FVIhuRBoSu13/hKMjPgfbxOBS70tGWH5ypgsdlk9G70OPg+e5dbIkIBkyx2WT439pzRNBc+MblTbYmXW",
-	"7+pDYVLf88iaqXTiCBw9coQ7vMyolhAneKS/BzjRo5UrswKsHCMmQNNt3aRuwV8eejN84dPyzayYaAHs",
-	"5iByKHz7W4GHQP8D/mspf9Us4GMghPLVG3a3UcmGiSIAq0spx4SUFpxrj1lHbve2SqD22RfBWgQVMcEG",
-	"jVfO/UWW8Al3gWZZPFqoNFhoLaoSoRNIYXhf3GgBzXXDk+/n894IlSFmQJUcJEZLx8TKgy2MQptAhD2O",
-	"YY1XEiyOT0KlIJkM8eGg0ql6wPh+/vSH+ci+P+5Vfntm/Qmy6m2NDjrbo/hDfGHxVq3XZN+6GnaCZAQ8",
-	"dndjON+3OEYn8s6SrLFc/Zq4HD2uhhwPXX/QYFtgm5N3INd2YF66vrqkSlCORqXJWylP+96evDaWGZRM",
-	"ns2N0r5aSjlImi9xd7BkBX7Ci1qgr8GBZSulJcuMRRjRHTn7E8wvtE2AVGK2mrzYiyyDZxu13qQ75sJ6",
-	"Dc6DnPbvdjSy9oRVVqZc34mEHAaZUClf8I/mv7D6pwW5EX6amKy/p6nC+EflckRoYKm8sgI300LmED5w",
-	"CBDQQ1SDNCQeJNsqwS5SEyS7iN+Mnb7X7/UbAhxkJ5QNdsE23uduMZslSD6t1ZyJXM1KEz6SlWaz7Rm9",
-	"eXjlaYobUJ5P+Basi5c7m86ncxo2ctAiV3zBn0zn0yd8wnPhN1SHjpw1s0ETRiHC9dCb7hXtBNBeqXJU",
-	"q2NtccxCMa+i4aKNlN6adBsfcprlw03ZtU7BIRM6lswYHDLGVZgr331oWeqYy3FsZiKxxjmWhdSrPIWu",
-	"zFeGZWDXKMZYJkGGaqeLLs7BYqRFBOg3ytXb2EdMTWHK1KqEzr8iQ1P9Znw7ds6EluwZaqmZvzPMhWWt",
-	"LaF8uFfOT5jR0LbMr3VwkRAkwJB7Fl+3sP1UswY/z1WJ+y4VgdHmfwt4N9wnapJZ+9F1PzmdgR7QTmCI",
-	"/zHhBMLiPwnsbzpvAY/n899tFV9h5IFt/Ot/YV48jacNCam0mjWeJ4jlyThL/axAm/yQZcLu+IKj18aS",
-	"gVhGsvIhCdkSTrFVN/FifRMrNDEkcRcd865KQ+T4EL99YJUXG4XdDbzSFlEeE66Qi361Jk1pLkbJHyJ7",
-	"U+rBwP/qoHcPinh3erg33t/+hMnxrSVGNw2K7VXp57aeebedFm/nC36oq5LlD+z2sR1WAmZ8f7P/XwAA",
-	"AP//EnhE/oElAAA=",
+	"H4sIAAAAAAAC/+x862/bOLb4v0Lo9/vQAo6TtDOLvfl007SdLW6nLZJ0d4FOEdPSsc2JTGpIKo9b+H+/",
+	"OIeUREm0JaftTArslyCW+Dg87xf1JUnVulASpDXJyZek4JqvwYKmX2elNkrjfxmYVIvCCiWTk+R9wf8o",
+	"gRXKCHzChGR2BUyDKXPLDFhWGsjYQml2Dff4u+BLITkOnrJTyWBd2Ht2w/MSmLFcW8O4pTUWQhsaDhP6",
+	"nRIItBS9VnmuboVc0hAmDNNgSy0hQyBmuZDXZirhzs6m7IxLqSybA0vVei5wzK2wKzZTi4UBO5v+JpNJ",
+	"IvBAf5Sg75NJIvkakpPEbZpMEpOuYM0RAfa+wDfGaiGXyWYzSd7INC8zuFSW530U/WsFdgWaWcVSVUob",
+	"IMiwNbfpCg+BD2nvKbsAi4MXPDeA/5hrUbipOFBJlnO9DHFsJnjk25VIVyzlBthsDZZPLcIzY1xmFTpy",
+	"buyMcQ1MrYW1kG0/uHBnuqJFWufPYMHL3CYnVpcwqdAxVyoHLgkfb8Va2D4ifuV3Yl2umSzXc9BMLWos",
+	"WOWJtwWYnBaMAvHz0SRZu4WTk2dH+EtI9+u4Bk5IC0vQBNx7InkfujcyEym3YIgUxIrEXBVnq0VDI6Yh",
+	"51bcEHnwKYpODhaI49WCCQtrXIhbR+Fm6pYTOkaMHzE801H0TOelfC0gz0z/WC9hISQYtqD3CO8cWpLi",
+	"ubFQ0oBjB7grcpVBReAYuG61FriFVgVoK8ABwW37EJ8SkSWTROnlFf2jIRWFAIlnLjVyWM7nkOOaVqxB",
+	"lfjCWG5Lk3yeJIRQXBBkud69Wqq0I4+S7uXQ8pPEgL4RKVTnmyS3ML9KlTQqhys3PdXALWRXnAAusubH",
+	"Shlrrky5XnNCUcE1SHulS789txaVXDJJllqVhXsId1bzqxuucfs1YvvzpKtZ6gdca36fbJoHav47pBZH",
+	"GHuf45MMoHhfPz0v5S+415vsA7erCK9nIK1YCCeFxAGlZARexZ8FTmy0gUPyH6XQkFWM0dD+/2tYJCfJ",
+	"/ztsTMihe2sOG1gqVqWf5rXILUQsyimqdUNqfkFDUArnHK2IkuyGa6FKw1It8BUfy66013Z2rRhg4CwX",
+	"bti7Ms/5PIdkU/PQSCRc0Ohm/l4k/Ycy9vGLOYpDKFu6lFEB9uPwdTDa2MzJJhksYsYbkFbpeyc3ONB8",
+	"a1lxiH1E7JiuuFxC1ofmvczvmTfNjBSPc2SeKE3/qNI+ZZaba298/EJEfRzOeJoqneEZvOUqco7mLOVF",
+	"UqOstuWTZMFF/mBA3GQPz747twg/IF5vqrFvslA2kbdOviSyeuRJ0sa1W73HTt5cDMv1WxoYbvtQZRLn",
+	"2THqpatWJkkpNfB05Y49gnjELbegwVEQ/5HKPmXBOntScC8JHG+oPuT8fq7UNXOK45vbqsZMmf+4VH+V",
+	"S/U4XKjH5aJ8hUJqSBnTdN/b9/kqn8dcKG1f3PdpgM+Z0hnhLIZQo7S9mt/HQ6qA905w3WRSS0GLK4Nh",
+	"3KTtBzSvz3IbQrgTfsLNC56dwx8lGEJ/qqT1lOBFkWPIKZQ8/N0oslXj9NQrrZV2W7Wx8oJnrNpsM0le",
+	"Kz0XWQby++98mqZgTGUZluIGJNNgVKlTSs5IZRlHuYEMIXun7GtVyuz7A3bZBydT4ACCO4GI2lQ8QvQ6",
+	"TSnX4vilQPNmGzsyZJ4sSE4qc83v3oJcolE7dgF8/TOipc4cW51G8hKnDLWrsXxdsNsVOOsC0up7dstR",
+	"y9DMZJIslF5zi0LELRzgpCSy00uxWJyRVxjbygi5zMG7jYwbpqFQ2kLG5vfsVBqBfsCTg4NMLBZPk64r",
+	"xRdRdYkKABi9JODd6ggMPbtaAc9i80iRVYg10UXYE5gup4yjHgaGfsDT2KHnsFAatkHm3nZAcw/3ga23",
+	"zEjgCg1o7yJOtqMTKzQcaJAZaEcH3GGtsjJvdiglcmHGKrr0c5Q9lesEpmdm1mAMd8zRX6Txqj7VAz9H",
+	"1o754xFHvIeKt7WN41lGaTeef2iB15vS4eB6GsMUKLpMjM9VaXu+IyWF0XGr89ONKS9KXSgDZppEzvaW",
+	"AtOtIFLWtisYlMru07fOImKwW6nOJu3dTTlSrjTGQTkfvXrOdy0+Ze9dXpgJ95ayv5GErVfo11LdymkM",
+	"JEy8jwQJh+533kLDDbpYIzeohu+zSYfZHQFjrP4rWD7IDd1Ms1PVQknPnHXwgOaEZnY5KDBJ4VJ9ylRL",
+	"kdfJMSvtcuTdrPEksfFyxTaSxyoVAbfMWgWDGXIIHX4aAnJ8/FM0fx2i2p00hur3evkmGxMj1ka43jj5",
+	"+fnx35/919HehvkDRSIuLtwzOnUxdeMNtd5psFqAmbJ3ypXJqrKWkMIKnjMf8kxDw16WFPgMqtFqp3fk",
+	"CXeh/ke55ggAz3ARhu5yBX7RUpAfUTNahfJjQIZHCccxIRncWdCodM29ocrHkwtuIc+FhactBkheizt2",
+	"poUVKc/Z2T9fmWSQBOcui9NxNRonbZdXWPlymyaGHA6gTv3ITS/wHZ571kx4Q45uEE4MzG58wE0ruB3e",
+	"9BUO/ieO3QQR8h55+W4RY3gqpkzNhR+O2bpsZG5lbGbNuwIbH9oPL/4rDttU4d/u4S0B2dRJj4FZTv9s",
+	"uumJob0CDdKNxQeOdF6P3TtM36s04SJ0nFIlcobnXPqRm1bqZmDexyJrWLzU+eB4nSebfupoYNa/YH7m",
+	"RtP8mPMbSHk/KMD4WaYQ2EDUfF5/TJrSMCW8j3uau5OhbIzf7tL0JOmpjx5oH6XAbgvR2J3S62hXZb5V",
+	"+pppF/878JpMRFyzYjhotuca6xzhLnxfcnON60TTbaFpp1U/x8nR6LCtzlQsAqdpmHJD7854ZISWeMow",
+	"AUD9JQZJaSDVYN0MroFpyHiKnRAhqb4kBU+v+RJ3/ZSoAqQxOUGurgG3/XT+6uXp2eWrl5+TLexFurWP",
+	"2Aeag33V+Z4KTWMvTWnH7nHuh7sSyz5mw03HtF5jOr6jYgtqrj1mbGe7m3R1WLgMy/oB5T7vIPk4sVUL",
+	"xls1966PF5PVFu57m3zQaqnBmLqi7wb23NJ6AG1NMpAJU6D+oOrFlFF5qHL6RDgYE06tweyW37g4uVNP",
+	"rynUBlLIq6KC88BNJgiMFXnO5oBqtdngN1n11WTsALOHfsaK32C6BWRr7IrnbmB4eoTYv+Ay244MDWsu",
+	"JGl12oIqYCmXKeS4dpAfDo5AlREPYDJJ3D7RKgQuGm05grsKAorMcVxwqK6xOaqNTcolpwzgDXRsTN+u",
+	"0In6u192Aj03LFhsOFarZcXD4ZbYJR6h7PcgelfD0qaRkAEP0t86ESY0q4HoaFpPPPy/PlI0EIa7Qugx",
+	"A7GeXWpoDXwWG6hLibw0vCLqKkzkjdjclJRhbw18fhQdGnhxu9ccmQLYTo2QXZ4f/RTbo1OJ3oW4DndV",
+	"rYcVNhscNKQIa481xUO8NuRtg7KLSS+2aK/T5VLDklvwPBeL+tv48crUybcFYys30lsAygJkrQWYMMzy",
+	"a5BMSKuYD3SdG+MkQBg28ziZsVJakZNupLZD0wFmIaQwqyk7XVjQt1xnhglLK3hczlDDb51OgyBDTTTz",
+	"KKcJUskqabBmmXDKdVagrsLkj7Ir0Leirn571RkjpJ8TkPTzDuvXqSWefNl/7cHsSbXX17rF1Tp9t7jq",
+	"bhoKgWkQRsA+x7hrMOUhow63n19tuoXxMaDvH3ilYpllDJvryMN7NfeMuw4jNFRC1sWiuo0n5tR0e3wG",
+	"vaBRePOnqdHn24AGplSO5djlL2jsw8Js3+XWb8wpbVFaVmiVlamzcZ6va3zW8qlkkJDzzXQjyj4t5PTo",
+	"3RCkovyAuOzY4qJCZsdPrZuGKvVJbOPctiDkpqax2sir0qZqDV6f8hsuCCqmZAr9zKvTemAqJOEOThVt",
+	"7bPbau1N/K1YSqW3TVXX8ecaTFpum4Rt/sW2lx0rOmA51XUyqQ8XnKS9TrNlA1lzsB164htoRlzmx1GM",
+	"Y1xW10cn5I3Kb5o2sBZbPjLX9Xis63r8HVzXZ3+G4zpMk4F4Z7v7evQo3dc9sg8dLIxJQHQ6y/Yp028x",
+	"Iy3gf/WJ/qZLy4HWuUWkMmiuO7XoKQyDO0hLTOexN9K3YkB6je0S0LgkhqSRIuqDAzfAtbRMmJjCNGjh",
+	"MO0NblWZZ2zNr8HnDqvmmNK6BidsX6puVQWeKWljSK+3+bbnYVFgqMZI5tIqjwDerS4Kw3iWaTAGspF0",
+	"3RbvnJVag7RBtNNjm13u9zdk8gi425qY3FtmNZfNza023Ex5GSBUhuqhd77H7Q7v59k+oBZEbWcje9I8",
+	"lwRux6iGNPdg+2282+r65GpX2M2ENZAvPGV9dCqs8SagAaotlVjCwxGxPs6ONse3vesqDY62KOTLxqLV",
+	"au353zBt08Homq6GugKFkpnxrW6NlvOpgVRJI1wrmL9ekZXuGmItY/V1yL8d/fT3o4HbgwTlN3DofgBn",
+	"7qIpNHQrfvTCN2xosVwSfuOtG8MNC91O6ZMvnRmDKYj90hzfQs8OglQX+bZ0KbZbRTnFb0yhRvU9pT5E",
+	"jQVgZjSjBd2rEX77zuoZu162LB/0z9A/dPo55EouDbMqthoO2b0ajRjSSxVaETa/6KRGa0wKkJDn1NMV",
+	"zT+kygEwloJZqbmb3asviDUwU4C03iurmseqhWtn5omQldp72qpmTJ/9XJ/AefV/ApmDyH77DXFum6N4",
+	"PTz3xTYvjlR68muxJ+6fK9BaafM0euEt6LzteLzuRUvA6s1dB7ClEhY3lbfjYXj6/dl4nGPRMF3gX3xH",
+	"AagN9K5sRg+oQM9GMiiQtTImQ45qs/q36K9wKz28w6LpvNn3ggHVJX2Lz2in7qOOROcfz9+SNFZ6oLKq",
+	"4aruZlhvvXZLT3RlkslCCWnrWygG0vDTDbcwZ76NCA/qe/VLAxoThhlbK42qoduc22+MvKQ2bcgzJgxT",
+	"hW84R/lfieUqv2emXC7B+K6Sztl2Og8bigIWqroWw1MiGBan8+Qk+V39Lyz+W0O24naaqnW/Ab72VF5W",
+	"RWRNESHzDQ5UQN6WU6Z8qXeYlZSQorK5EZyd5arM2Jl7pvT0N/mb/EBJasITrg36hK2sLczJ4WGKw6cN",
+	"mIe8EIcVCg/q8rY+vDmmSNkKm0Mc+GSS3IA27nDH06PpEZ5ZFSB5IZKT5Pn0aPqcSj12RYK0Y69D9Pbh",
+	"pvrAzTL2FZD3BUhCl9XA184fx8MdUDOEm8zclDmi0HSjTdMvmGJtLJqMag2bslc8XbktWMq1FmAa2zvr",
+	"xL0z5mSboZFBkOimg8yQnV3SYoasNat4v7nRg7/cJpLXCfVW7Obt24oXBcjgyo3HCfE8SMhc5wZUxUn/",
+	"OnXXKtxtCkCAKnmfuWczVn9ayCVKUDHWLW/JaSGwHYwwfUFLEnmbjxF9imvLZshhcHdz87lzG+/Z0VHn",
+	"zpmFO3tIGDlwRxh/6axDlNj1s/f/gwz709HRtrVq6A6Di4I05fnwlOaC34byrD5LnTjEdcna5UyaNCQw",
+	"y7oWGhWYc7pvgJyaC0MBbJ3pNnW3ioui6lL2aTOGoUVkVi2bIL8tPEG7EcUVXh6qx1W3o2NBAuHbMKAr",
+	"Ab8Vxj6E/VqfONlMBqe4LyaNGOi/XjRiZOujVCPE4OFXL+vzPh7+R7oFjLgHox9+EdlmBLd7PmwYuc6v",
+	"E6OiThbZAHv9Ag9nLv/FhD+FsH8pXXHGT8Mz6gvFbUb4BWyr03IMI6yqEudIhecsMSVAXNMk6ptBa/9R",
+	"5mBwkrFapLZWVE5HmeojEnRj2TBTaOAZ46lWxrB1mVtR5NBd851ia9BLXEZplkFW1qRHs12ARme0iiCF",
+	"qTdgB67yIeqs+L+ZaIMfusCGnZKz8QKhlMzeKmbKeQMtlVbocvWEcrEtzPy78T9pERyAhuLFdmmhGuxD",
+	"dXHwdZ/NZPwE+hrHX6O6/ccWH5uSJyo8Mh0/IGZj5H0fUe8018Xdm4d5Ik1UaSIfk/Hy40TZr4t0xT5n",
+	"0H7lmZs+yr95sDiZvWTJjBek4Gsf/xG7QOwem8jtL2B7+lTtul7MrfoKWcm2ywpeeHXS2boNOXM7UVrZ",
+	"0b3TqhbvMtnR8LPDLXyYR1g5g6OErJLI78y2P7zTGLLheDY/zKobdTuZvd1iElQTIm0WTeJfSYY557AT",
+	"JMp4U/bapfmahpjqbdAPY1dgXCPLmIYXsQiaay6DCbhABlogCAut1rRClVo8L6UEXSXOuhUMOsCUnQUr",
+	"URtN3d656B1jfs/qu+Xb5YiuNT7Uwv1JoRXB+ANLCRmDigkKDZmLYihL86HzgYCG6/aQJBSHK92UUAYF",
+	"SjXlSxITV2jsidN4KboMvtT9tWxOsQ7XzjVs9qFPmLX72Zwa8D3M2ZSdBxB8G/EIalOPWUgCMH90Oam4",
+	"qO5s77GlO6GrNjgitE9bdOst/jOMJ8m2sgv5A1u+j+M/mekWOEw2nzf/NwDAN1TBAGAAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,90 @@
+package dispatch
+
+import (
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/generic"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RecipientBusyCondition matches scheduled runs whose recipient (or any of whose hosts in case of a Satellite run) has another run running.
+// Can be used to find the queued runs that cannot be released yet.
+const RecipientBusyCondition = `(
+	(runs.sat_id IS NULL AND EXISTS (
+		SELECT 1 FROM runs AS running WHERE running.recipient = runs.recipient AND running.status = 'running'
+	))
+	OR (runs.sat_id IS NOT NULL AND EXISTS (
+		SELECT 1 FROM run_hosts AS host JOIN run_hosts AS running ON running.inventory_id = host.inventory_id
+		WHERE host.run_id = runs.id AND running.status = 'running'
+	))
+)`
+
+func hasConcurrencyGuard(run db.Run) bool {
+	return run.ConcurrencyPolicy != nil && *run.ConcurrencyPolicy != db.ConcurrencyPolicyAllow
+}
+
+// recipientBusy checks whether another run is running on the recipient of the given run.
+// The recipient of a Satellite run is the Satellite instance so the hosts of the run are checked instead.
+// The check takes a transaction-level advisory lock on the recipient so that concurrent dispatches to the same recipient cannot both pass it.
+func recipientBusy(tx *gorm.DB, run db.Run, inventoryIDs []uuid.UUID) (bool, error) {
+	if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", run.Recipient.String()).Error; err != nil {
+		return false, err
+	}
+
+	var busy bool
+	var result *gorm.DB
+
+	if run.SatId == nil {
+		result = tx.Raw(
+			"SELECT EXISTS (SELECT 1 FROM runs WHERE recipient = ? AND status = ? AND id <> ?)",
+			run.Recipient, db.RunStatusRunning, run.ID,
+		).Scan(&busy)
+	} else if len(inventoryIDs) > 0 {
+		result = tx.Raw(
+			"SELECT EXISTS (SELECT 1 FROM run_hosts WHERE inventory_id IN ? AND status = ? AND run_id <> ?)",
+			inventoryIDs, db.RunStatusRunning, run.ID,
+		).Scan(&busy)
+	} else {
+		return false, nil
+	}
+
+	return busy, result.Error
+}
+
+// queueRun keeps a run that cannot be dispatched yet in the scheduled status so that the scheduler releases it once the recipient is free
+func queueRun(run *db.Run) {
+	now := time.Now()
+
+	run.Status = db.RunStatusScheduled
+	run.DispatchedAt = nil
+
+	if run.NotBefore == nil {
+		run.NotBefore = &now
+	}
+}
+
+func inputInventoryIDs(hosts []generic.RunHostsInput) []uuid.UUID {
+	result := []uuid.UUID{}
+
+	for _, host := range hosts {
+		if host.InventoryId != nil {
+			result = append(result, *host.InventoryId)
+		}
+	}
+
+	return result
+}
+
+func runHostInventoryIDs(runHosts []db.RunHost) []uuid.UUID {
+	result := []uuid.UUID{}
+
+	for _, host := range runHosts {
+		if host.InventoryID != nil {
+			result = append(result, *host.InventoryID)
+		}
+	}
+
+	return result
+}
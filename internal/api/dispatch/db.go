@@ -1,8 +1,11 @@
 package dispatch
 
 import (
+	"playbook-dispatcher/internal/api/dispatch/protocols"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/spf13/viper"
@@ -10,27 +13,71 @@ import (
 
 func newRun(input *generic.RunInput, correlationId uuid.UUID, responseFull bool, service string, cfg *viper.Viper) dbModel.Run {
 	run := dbModel.Run{
-		ID:             uuid.New(),
-		OrgID:          input.OrgId,
-		CorrelationID:  correlationId,
-		URL:            input.Url,
-		Status:         dbModel.RunStatusRunning,
-		Recipient:      input.Recipient,
-		Labels:         input.Labels,
-		ResponseFull:   responseFull,
-		Service:        service,
-		Timeout:        *input.Timeout,       // defaulted
-		PlaybookRunUrl: *input.WebConsoleUrl, // defaulted
-		PlaybookName:   input.Name,
-		Principal:      input.Principal,
-		SatId:          input.SatId,
-		SatOrgId:       input.SatOrgId,
+		ID:                uuid.New(),
+		OrgID:             input.OrgId,
+		CorrelationID:     correlationId,
+		URL:               input.Url,
+		Recipient:         input.Recipient,
+		Labels:            input.Labels,
+		ResponseFull:      responseFull,
+		Service:           service,
+		Timeout:           *input.Timeout,       // defaulted
+		PlaybookRunUrl:    *input.WebConsoleUrl, // defaulted
+		PlaybookName:      input.Name,
+		Principal:         input.Principal,
+		SatId:             input.SatId,
+		SatOrgId:          input.SatOrgId,
+		NotBefore:         input.NotBefore,
+		NotAfter:          input.NotAfter,
+		IdempotencyKey:    input.IdempotencyKey,
+		ConcurrencyPolicy: input.ConcurrencyPolicy,
+		GroupID:           input.GroupId,
+		RolloutWave:       input.RolloutWave,
+		PlaybookDigest:    input.PlaybookDigest,
+		Mode:              dbModel.RunModeRun,
+	}
+
+	if input.Mode != nil {
+		run.Mode = *input.Mode
+	}
+
+	if isScheduled(*input) {
+		run.Status = dbModel.RunStatusScheduled
+	} else {
+		run.Status = dbModel.RunStatusRunning
+		run.DispatchedAt = utils.TimeRef(time.Now())
+	}
+
+	if input.Callback != nil {
+		run.CallbackUrl = &input.Callback.Url
+		run.CallbackSecretRef = &input.Callback.SecretRef
+	}
+
+	if input.ExtraVars != nil {
+		run.ExtraVars = &dbModel.ExtraVars{
+			Values:  input.ExtraVars.Values,
+			Secrets: input.ExtraVars.Secrets,
+		}
+	}
+
+	if input.RetryPolicy != nil {
+		run.RetryPolicy = &dbModel.RetryPolicy{
+			MaxAttempts: input.RetryPolicy.MaxAttempts,
+			Backoff:     *input.RetryPolicy.Backoff, // defaulted
+			Statuses:    input.RetryPolicy.Statuses,
+		}
+	}
+
+	run.ParentRunID = input.ParentRunId
+	run.Attempt = 1
+	if input.Attempt != nil {
+		run.Attempt = *input.Attempt
 	}
 
 	return run
 }
 
-func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID) []dbModel.RunHost {
+func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID, status string) []dbModel.RunHost {
 	newHosts := make([]dbModel.RunHost, len(runHosts))
 
 	for i, inputHost := range runHosts {
@@ -39,7 +86,7 @@ func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID) []dbModel.
 			RunID:                 entityId,
 			InventoryID:           inputHost.InventoryId,
 			SubscriptionManagerID: inputHost.SubscriptionManagerId,
-			Status:                dbModel.RunStatusRunning,
+			Status:                status,
 		}
 
 		if inputHost.AnsibleHost != nil {
@@ -51,3 +98,58 @@ func newHostRun(runHosts []generic.RunHostsInput, entityId uuid.UUID) []dbModel.
 
 	return newHosts
 }
+
+// reconstructs the input of a scheduled run so that the signal can be built once the run is due
+func newRunInput(run dbModel.Run, runHosts []dbModel.RunHost) generic.RunInput {
+	hosts := make([]generic.RunHostsInput, len(runHosts))
+
+	for i := range runHosts {
+		hosts[i] = generic.RunHostsInput{
+			AnsibleHost:           &runHosts[i].Host,
+			InventoryId:           runHosts[i].InventoryID,
+			SubscriptionManagerId: runHosts[i].SubscriptionManagerID,
+		}
+	}
+
+	input := generic.RunInput{
+		Recipient:      run.Recipient,
+		Url:            run.URL,
+		Hosts:          hosts,
+		Labels:         run.Labels,
+		Timeout:        &run.Timeout,
+		OrgId:          run.OrgID,
+		SatId:          run.SatId,
+		SatOrgId:       run.SatOrgId,
+		Name:           run.PlaybookName,
+		WebConsoleUrl:  &run.PlaybookRunUrl,
+		Principal:      run.Principal,
+		NotBefore:      run.NotBefore,
+		NotAfter:       run.NotAfter,
+		PlaybookDigest: run.PlaybookDigest,
+		Mode:           &run.Mode,
+	}
+
+	if run.ExtraVars != nil {
+		input.ExtraVars = &generic.RunExtraVarsInput{
+			Values:  run.ExtraVars.Values,
+			Secrets: run.ExtraVars.Secrets,
+		}
+	}
+
+	return input
+}
+
+func newOutboxSignal(run dbModel.Run, directive protocols.Directive, metadata map[string]string, lease time.Duration) dbModel.OutboxSignal {
+	return dbModel.OutboxSignal{
+		ID:        uuid.New(),
+		RunID:     run.ID,
+		OrgID:     run.OrgID,
+		Recipient: run.Recipient,
+		Directive: string(directive),
+		URL:       run.URL,
+		Metadata:  utils.MustMarshal(metadata),
+		Status:    dbModel.OutboxSignalStatusPending,
+		// the signal is sent right away by whoever created it; the relay only picks it up should that fail
+		NextAttemptAt: time.Now().Add(lease),
+	}
+}
@@ -2,6 +2,8 @@ package dispatch
 
 import (
 	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/dispatch/quota"
+	"playbook-dispatcher/internal/api/dispatch/registry"
 
 	"github.com/spf13/viper"
 	"golang.org/x/time/rate"
@@ -14,5 +16,7 @@ func NewDispatchManager(config *viper.Viper, cloudConnector connectors.CloudConn
 		cloudConnector: cloudConnector,
 		db:             db,
 		rateLimiter:    rateLimiter,
+		quota:          quota.New(config),
+		registry:       registry.New(config, db),
 	}
 }
@@ -0,0 +1,78 @@
+package dispatch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+)
+
+const uniqueViolation = "23505"
+
+func (dm *dispatchManager) idempotencyWindow() time.Duration {
+	return time.Duration(dm.config.GetInt("idempotency.window")) * time.Second
+}
+
+// idempotencyHash identifies the payload of a Playbook run so that a key reused for a different Playbook run can be told from a retry.
+// Fields assigned by the dispatcher rather than the caller (e.g. the run group) are left out.
+func idempotencyHash(run generic.RunInput) string {
+	run.IdempotencyKey = nil
+	run.GroupId = nil
+	run.ParentRunId = nil
+	run.Attempt = nil
+	run.RolloutWave = nil
+
+	hash := sha256.Sum256(utils.MustMarshal(run))
+	return hex.EncodeToString(hash[:])
+}
+
+// replayIdempotentRun returns the given run created earlier using the same idempotency key unless it was created from a different payload.
+// Runs created before payloads were hashed are always replayed.
+func replayIdempotentRun(existing *db.Run, key string, hash string) (*db.Run, error) {
+	if existing.IdempotencyHash != nil && *existing.IdempotencyHash != hash {
+		return nil, &IdempotencyKeyMismatchError{key: key}
+	}
+
+	return existing, nil
+}
+
+// findIdempotentRun looks up the run created by the given service using the same idempotency key within the idempotency window
+func (dm *dispatchManager) findIdempotentRun(ctx context.Context, orgID string, service string, key string) (*db.Run, error) {
+	var runs []db.Run
+
+	result := dm.db.WithContext(ctx).
+		Where("org_id = ?", orgID).
+		Where("service = ?", service).
+		Where("idempotency_key = ?", key).
+		Where("created_at > NOW() - ? * interval '1 second'", dm.idempotencyWindow().Seconds()).
+		Limit(1).
+		Find(&runs)
+
+	if result.Error != nil || len(runs) == 0 {
+		return nil, result.Error
+	}
+
+	return &runs[0], nil
+}
+
+// releaseIdempotencyKey frees the idempotency key of a run created outside of the idempotency window so that the key can be used again
+func releaseIdempotencyKey(tx *gorm.DB, run *db.Run, window time.Duration) error {
+	return tx.Model(&db.Run{}).
+		Where("org_id = ?", run.OrgID).
+		Where("service = ?", run.Service).
+		Where("idempotency_key = ?", *run.IdempotencyKey).
+		Where("created_at <= NOW() - ? * interval '1 second'", window.Seconds()).
+		Updates(map[string]interface{}{"idempotency_key": nil, "idempotency_hash": nil}).Error
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
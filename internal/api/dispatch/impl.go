@@ -4,6 +4,8 @@ import (
 	"context"
 	"playbook-dispatcher/internal/api/connectors"
 	"playbook-dispatcher/internal/api/dispatch/protocols"
+	"playbook-dispatcher/internal/api/dispatch/quota"
+	"playbook-dispatcher/internal/api/dispatch/registry"
 	"playbook-dispatcher/internal/api/instrumentation"
 	"playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/generic"
@@ -20,6 +22,8 @@ type dispatchManager struct {
 	cloudConnector connectors.CloudConnectorClient
 	db             *gorm.DB
 	rateLimiter    *rate.Limiter
+	quota          *quota.Quota
+	registry       *registry.Registry
 }
 
 func (dm *dispatchManager) newCorrelationId() uuid.UUID {
@@ -38,6 +42,10 @@ func (dm *dispatchManager) applyDefaults(run *generic.RunInput) {
 	if run.Timeout == nil {
 		run.Timeout = utils.IntRef(dm.config.GetInt("default.run.timeout"))
 	}
+
+	if run.RetryPolicy != nil && run.RetryPolicy.Backoff == nil {
+		run.RetryPolicy.Backoff = utils.IntRef(dm.config.GetInt("retry.backoff.default"))
+	}
 }
 
 func getProtocol(runInput generic.RunInput) protocols.Protocol {
@@ -48,52 +56,142 @@ func getProtocol(runInput generic.RunInput) protocols.Protocol {
 	}
 }
 
+func getRunProtocol(run db.Run) protocols.Protocol {
+	if run.SatId != nil {
+		return protocols.SatelliteProtocol
+	} else {
+		return protocols.RunnerProtocol
+	}
+}
+
 func (dm *dispatchManager) ProcessRun(ctx context.Context, orgID string, service string, run generic.RunInput) (runID, correlationID uuid.UUID, err error) {
 	correlationID = dm.newCorrelationId()
 	ctx = utils.WithCorrelationId(ctx, correlationID.String())
 
+	// a retried request gets the run created originally
+	var payloadHash string
+	if run.IdempotencyKey != nil {
+		payloadHash = idempotencyHash(run)
+
+		existing, err := dm.findIdempotentRun(ctx, run.OrgId, service, *run.IdempotencyKey)
+		if err == nil && existing != nil {
+			existing, err = replayIdempotentRun(existing, *run.IdempotencyKey, payloadHash)
+		}
+
+		if err != nil {
+			return uuid.UUID{}, correlationID, err
+		} else if existing != nil {
+			instrumentation.RunReplayed(ctx, existing.ID, service, *run.IdempotencyKey)
+			return existing.ID, existing.CorrelationID, nil
+		}
+	}
+
+	if run.Preflight || usesRegistry(run) {
+		if err = dm.checkPlaybook(ctx, &run); err != nil {
+			return uuid.UUID{}, correlationID, err
+		}
+	}
+
+	// only requests that would otherwise create a run take a token
+	refundQuota, err := dm.quota.Allow(ctx, run.OrgId, service)
+	if err != nil {
+		instrumentation.QuotaExceeded(ctx, err.(*quota.ExceededError).Scope, run.OrgId, service)
+		return uuid.UUID{}, correlationID, err
+	}
+
 	dm.applyDefaults(&run)
 
 	protocol := getProtocol(run)
 
+	entity := newRun(&run, correlationID, protocol.GetResponseFull(dm.config), service, dm.config)
+	if entity.IdempotencyKey != nil {
+		entity.IdempotencyHash = &payloadHash
+	}
+
+	// deferred runs are only stored now and dispatched by the scheduler once due
+	if isScheduled(run) {
+		if err = dm.createRun(ctx, &entity, run.Hosts, nil, protocol); err != nil {
+			// no run has been created so the request does not count against the quota
+			refundQuota()
+			return dm.replayOnConflict(ctx, entity, err)
+		}
+
+		if isHeld(run) {
+			instrumentation.RunHeld(ctx, run.Recipient, entity.ID, run.Url, service, protocol.GetLabel(), *run.RolloutWave)
+		} else {
+			instrumentation.RunScheduled(ctx, run.Recipient, entity.ID, run.Url, service, protocol.GetLabel(), *run.NotBefore)
+		}
+
+		return entity.ID, correlationID, nil
+	}
+
 	signalMetadata := protocol.BuildMetaData(run, correlationID, dm.config)
+	signal := newOutboxSignal(entity, protocol.GetDirective(), signalMetadata, dm.signalLease())
 
-	// take from the rate limit bucket
-	rateErr := dm.rateLimiter.Wait(ctx)
+	// the run is stored together with the signal so that neither can exist without the other
+	if err = dm.createRun(ctx, &entity, run.Hosts, &signal, protocol); err != nil {
+		// no run has been created so the request does not count against the quota
+		refundQuota()
+		return dm.replayOnConflict(ctx, entity, err)
+	}
 
-	if rateErr != nil {
-		return uuid.UUID{}, correlationID, rateErr
+	// the recipient is busy so the run is released by the scheduler later
+	if entity.Status == db.RunStatusScheduled {
+		instrumentation.RunQueued(ctx, run.Recipient, entity.ID, service)
+		return entity.ID, correlationID, nil
 	}
 
-	messageId, notFound, err := dm.cloudConnector.SendCloudConnectorRequest(
-		ctx,
-		orgID,
-		run.Recipient,
-		&run.Url,
-		string(protocol.GetDirective()),
-		signalMetadata,
-	)
+	err = dm.sendSignal(ctx, signal)
 
-	if err != nil {
-		instrumentation.CloudConnectorRequestError(ctx, err, run.Recipient, protocol.GetLabel())
+	if _, ok := err.(*RecipientNotFoundError); ok {
+		// nothing has been sent so the run is discarded and does not count against the quota
+		dm.discardRun(ctx, entity.ID)
+		refundQuota()
 		return uuid.UUID{}, correlationID, err
-	} else if notFound {
-		instrumentation.CloudConnectorNoConnection(ctx, run.Recipient, protocol.GetLabel())
-		return uuid.UUID{}, correlationID, &RecipientNotFoundError{recipient: run.Recipient, err: err}
+	} else if err != nil {
+		// the signal stays pending and is sent by the outbox relay once the lease expires
+		instrumentation.SignalDeferred(ctx, err, entity.ID, signal.ID)
 	}
 
-	instrumentation.CloudConnectorOK(ctx, run.Recipient, messageId)
+	instrumentation.RunCreated(ctx, run.Recipient, entity.ID, run.Url, service, protocol.GetLabel())
+	return entity.ID, correlationID, nil
+}
 
-	entity := newRun(&run, correlationID, protocol.GetResponseFull(dm.config), service, dm.config)
+func (dm *dispatchManager) createRun(ctx context.Context, entity *db.Run, hosts []generic.RunHostsInput, signal *db.OutboxSignal, protocol protocols.Protocol) error {
+	return dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if entity.IdempotencyKey != nil {
+			if err := releaseIdempotencyKey(tx, entity, dm.idempotencyWindow()); err != nil {
+				return err
+			}
+		}
+
+		if entity.Status == db.RunStatusRunning && hasConcurrencyGuard(*entity) {
+			busy, err := recipientBusy(tx, *entity, inputInventoryIDs(hosts))
+			if err != nil {
+				return err
+			}
+
+			if busy && *entity.ConcurrencyPolicy == db.ConcurrencyPolicyReject {
+				instrumentation.RunRejectedRecipientBusy(ctx, entity.Recipient, entity.Service)
+				return &RecipientBusyError{recipient: entity.Recipient}
+			} else if busy {
+				queueRun(entity)
+				signal = nil
+			}
+		}
+
+		if dbResult := tx.Create(entity); dbResult.Error != nil {
+			// a concurrent request with the same idempotency key (or a concurrent retry of the same run) created the run first
+			if (entity.IdempotencyKey != nil || entity.ParentRunID != nil) && isUniqueViolation(dbResult.Error) {
+				return dbResult.Error
+			}
 
-	err = dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		if dbResult := tx.Create(&entity); dbResult.Error != nil {
-			instrumentation.PlaybookRunCreateError(ctx, dbResult.Error, &entity, protocol.GetLabel())
+			instrumentation.PlaybookRunCreateError(ctx, dbResult.Error, entity, protocol.GetLabel())
 			return dbResult.Error
 		}
 
-		if len(run.Hosts) > 0 {
-			newHosts := newHostRun(run.Hosts, entity.ID)
+		if len(hosts) > 0 {
+			newHosts := newHostRun(hosts, entity.ID, entity.Status)
 
 			if dbResult := tx.Create(newHosts); dbResult.Error != nil {
 				instrumentation.PlaybookRunHostCreateError(ctx, dbResult.Error, newHosts, protocol.GetLabel())
@@ -101,15 +199,52 @@ func (dm *dispatchManager) ProcessRun(ctx context.Context, orgID string, service
 			}
 		}
 
+		if signal != nil {
+			if dbResult := tx.Create(signal); dbResult.Error != nil {
+				instrumentation.OutboxSignalCreateError(ctx, dbResult.Error, entity.ID, protocol.GetLabel())
+				return dbResult.Error
+			}
+		}
+
 		return nil
 	})
+}
 
-	if err != nil {
-		return entity.ID, correlationID, err
+// replayOnConflict returns the run created by a concurrent request with the same idempotency key.
+// Any other error is returned as is.
+func (dm *dispatchManager) replayOnConflict(ctx context.Context, entity db.Run, err error) (runID, correlationID uuid.UUID, _ error) {
+	if entity.IdempotencyKey == nil || !isUniqueViolation(err) {
+		return entity.ID, entity.CorrelationID, err
 	}
 
-	instrumentation.RunCreated(ctx, run.Recipient, entity.ID, run.Url, entity.Service, protocol.GetLabel())
-	return entity.ID, correlationID, nil
+	existing, findErr := dm.findIdempotentRun(ctx, entity.OrgID, entity.Service, *entity.IdempotencyKey)
+	if findErr != nil || existing == nil {
+		return entity.ID, entity.CorrelationID, err
+	}
+
+	if entity.IdempotencyHash != nil {
+		if existing, err = replayIdempotentRun(existing, *entity.IdempotencyKey, *entity.IdempotencyHash); err != nil {
+			return entity.ID, entity.CorrelationID, err
+		}
+	}
+
+	instrumentation.RunReplayed(ctx, existing.ID, entity.Service, *entity.IdempotencyKey)
+	return existing.ID, existing.CorrelationID, nil
+}
+
+// discardRun removes a run whose signal was rejected before anything was sent
+func (dm *dispatchManager) discardRun(ctx context.Context, runID uuid.UUID) {
+	err := dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if dbResult := tx.Where("run_id = ?", runID).Delete(&db.RunHost{}); dbResult.Error != nil {
+			return dbResult.Error
+		}
+
+		return tx.Delete(&db.Run{ID: runID}).Error
+	})
+
+	if err != nil {
+		instrumentation.PlaybookRunDiscardError(ctx, err, runID)
+	}
 }
 
 func (dm *dispatchManager) ProcessCancel(ctx context.Context, orgID string, cancel generic.CancelInput) (runID, correlationID uuid.UUID, err error) {
@@ -126,16 +261,36 @@ func (dm *dispatchManager) ProcessCancel(ctx context.Context, orgID string, canc
 		return uuid.UUID{}, run.CorrelationID, &RunOrgIdMismatchError{err: err, runID: cancel.RunId}
 	}
 
-	if run.SatId == nil || run.SatOrgId == nil {
+	// a Satellite run cannot be addressed without the Satellite organization
+	if run.SatId != nil && run.SatOrgId == nil {
 		instrumentation.PlaybookRunCancelRunTypeError(ctx, run.ID)
 		return uuid.UUID{}, run.CorrelationID, &RunCancelTypeError{err, run.ID}
 	}
 
+	// a scheduled run has not been sent to the recipient yet so there is nothing to signal
+	if run.Status == db.RunStatusScheduled {
+		if err := dm.cancelScheduledRun(ctx, run); err != nil {
+			return uuid.UUID{}, run.CorrelationID, err
+		}
+
+		instrumentation.RunCanceled(ctx, run.ID)
+		return cancel.RunId, run.CorrelationID, nil
+	}
+
 	if run.Status != db.RunStatusRunning {
 		return uuid.UUID{}, run.CorrelationID, &RunCancelNotCancelableError{run.ID}
 	}
 
-	protocol := *protocols.SatelliteProtocol
+	// a run whose signal is still waiting in the outbox has not reached the recipient either
+	if canceled, err := dm.cancelUnsentRun(ctx, run); err != nil {
+		instrumentation.PlaybookRunCancelError(ctx, err)
+		return uuid.UUID{}, run.CorrelationID, err
+	} else if canceled {
+		instrumentation.RunCanceled(ctx, run.ID)
+		return cancel.RunId, run.CorrelationID, nil
+	}
+
+	protocol := getRunProtocol(run)
 	signalMetadata := protocol.BuildCancelMetaData(cancel, run.CorrelationID, dm.config)
 
 	// take from the rate limit bucket
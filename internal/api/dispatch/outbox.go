@@ -0,0 +1,220 @@
+package dispatch
+
+import (
+	"context"
+	"encoding/json"
+	"playbook-dispatcher/internal/api/dispatch/protocols"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/outbox"
+	"playbook-dispatcher/internal/common/queue"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// signalLease is how long the sender of a signal is given before the outbox relay takes over.
+// It is never shorter than twice the cloud connector timeout so that a slow request does not outlive its lease.
+func (dm *dispatchManager) signalLease() time.Duration {
+	lease := time.Duration(dm.config.GetInt("outbox.lease")) * time.Second
+	minimum := 2 * time.Duration(dm.config.GetInt("cloud.connector.timeout")) * time.Second
+
+	if lease < minimum {
+		return minimum
+	}
+
+	return lease
+}
+
+func getSignalProtocol(signal db.OutboxSignal) protocols.Protocol {
+	if signal.Directive == string(protocols.SatelliteDirective) {
+		return protocols.SatelliteProtocol
+	} else {
+		return protocols.RunnerProtocol
+	}
+}
+
+// sendSignal sends a pending signal via cloud connector and marks it as sent.
+// The signal is marked as sending in its own transaction before the request is made so that it is never sent twice:
+// a signal whose sender dies before recording the result stays sending and is not sent again by the outbox relay.
+// Should the signal fail to be sent it is left pending.
+func (dm *dispatchManager) sendSignal(ctx context.Context, signal db.OutboxSignal) error {
+	protocol := getSignalProtocol(signal)
+
+	var metadata map[string]string
+	if err := json.Unmarshal(signal.Metadata, &metadata); err != nil {
+		return err
+	}
+
+	// take from the rate limit bucket
+	if rateErr := dm.rateLimiter.Wait(ctx); rateErr != nil {
+		return rateErr
+	}
+
+	claimed, err := dm.setSignalStatus(ctx, signal, db.OutboxSignalStatusPending, db.OutboxSignalStatusSending, map[string]interface{}{
+		"next_attempt_at": time.Now().Add(dm.signalLease()),
+	})
+
+	if err != nil {
+		return err
+	} else if !claimed {
+		// the signal has been taken over by another sender or abandoned in the meantime
+		return nil
+	}
+
+	messageId, notFound, err := dm.cloudConnector.SendCloudConnectorRequest(
+		ctx,
+		signal.OrgID,
+		signal.Recipient,
+		&signal.URL,
+		signal.Directive,
+		metadata,
+	)
+
+	if err != nil || notFound {
+		// nothing has been sent so the signal is handed back to the outbox relay
+		if _, releaseErr := dm.setSignalStatus(ctx, signal, db.OutboxSignalStatusSending, db.OutboxSignalStatusPending, nil); releaseErr != nil {
+			instrumentation.OutboxSignalUpdateError(ctx, releaseErr, signal.ID)
+		}
+	}
+
+	if err != nil {
+		instrumentation.CloudConnectorRequestError(ctx, err, signal.Recipient, protocol.GetLabel())
+		return err
+	} else if notFound {
+		instrumentation.CloudConnectorNoConnection(ctx, signal.Recipient, protocol.GetLabel())
+		return &RecipientNotFoundError{recipient: signal.Recipient, err: err}
+	}
+
+	instrumentation.CloudConnectorOK(ctx, signal.Recipient, messageId)
+
+	_, err = dm.setSignalStatus(ctx, signal, db.OutboxSignalStatusSending, db.OutboxSignalStatusSent, map[string]interface{}{
+		"message_id": messageId,
+		"attempts":   gorm.Expr("attempts + 1"),
+		"last_error": nil,
+	})
+
+	// the message has been sent already; the signal stays sending and is confirmed by the relay once the lease expires
+	if err != nil {
+		instrumentation.OutboxSignalUpdateError(ctx, err, signal.ID)
+	}
+
+	return nil
+}
+
+// setSignalStatus moves a signal from one status to another along with the given columns.
+// Returns false if the signal is not in the expected status.
+func (dm *dispatchManager) setSignalStatus(ctx context.Context, signal db.OutboxSignal, from, to string, columns map[string]interface{}) (bool, error) {
+	updates := map[string]interface{}{
+		"status":     to,
+		"updated_at": time.Now(),
+	}
+
+	for column, value := range columns {
+		updates[column] = value
+	}
+
+	result := dm.db.WithContext(ctx).Model(&db.OutboxSignal{}).
+		Where("id = ?", signal.ID).
+		Where("status = ?", from).
+		Updates(updates)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+// confirmSignal marks a signal whose sender stopped in the middle of sending it as sent without sending it again.
+// Whether the recipient got the signal is unknown; if it did not, the run times out eventually.
+func (dm *dispatchManager) confirmSignal(ctx context.Context, signal db.OutboxSignal) error {
+	err := &SignalNotConfirmedError{signalID: signal.ID}
+
+	if _, updateErr := dm.setSignalStatus(ctx, signal, db.OutboxSignalStatusSending, db.OutboxSignalStatusSent, map[string]interface{}{
+		"attempts":   gorm.Expr("attempts + 1"),
+		"last_error": queue.TruncateError(err),
+	}); updateErr != nil {
+		return updateErr
+	}
+
+	return err
+}
+
+// DeliverSignal sends a pending signal on behalf of the outbox relay.
+// A signal left in the sending status is not sent again; it is marked as sent and SignalNotConfirmedError is returned.
+// If the run has left the running status (e.g. it was canceled or timed out) in the meantime the signal is abandoned
+// and RunNotRunningError is returned. If the recipient is not connected the signal is given up on right away.
+// Any other error is returned so that the relay can retry later.
+func (dm *dispatchManager) DeliverSignal(ctx context.Context, signal db.OutboxSignal) error {
+	ctx = utils.WithOrgId(ctx, signal.OrgID)
+
+	if signal.Status == db.OutboxSignalStatusSending {
+		return dm.confirmSignal(ctx, signal)
+	}
+
+	var run db.Run
+	if dbResult := dm.db.WithContext(ctx).Select("id", "status").First(&run, signal.RunID); dbResult.Error != nil {
+		return dbResult.Error
+	}
+
+	if run.Status != db.RunStatusRunning {
+		if _, err := outbox.Abandon(dm.db.WithContext(ctx), run.Status, run.ID); err != nil {
+			return err
+		}
+
+		return &RunNotRunningError{runID: run.ID, status: run.Status}
+	}
+
+	err := dm.sendSignal(ctx, signal)
+
+	if _, ok := err.(*RecipientNotFoundError); ok {
+		if failErr := dm.FailSignal(ctx, signal, err); failErr != nil {
+			return failErr
+		}
+	}
+
+	return err
+}
+
+// cancelUnsentRun cancels a running run whose signal has not been sent yet, abandoning the signal.
+// Returns false if there is no such signal, i.e. the recipient has to be told to cancel the run.
+func (dm *dispatchManager) cancelUnsentRun(ctx context.Context, run db.Run) (bool, error) {
+	canceled := false
+
+	err := dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		abandoned, err := outbox.Abandon(tx, db.RunStatusCanceled, run.ID)
+		if err != nil || abandoned == 0 {
+			return err
+		}
+
+		canceled, err = updateRunStatus(ctx, tx, run, db.RunStatusRunning, db.RunStatusCanceled)
+		return err
+	})
+
+	return canceled, err
+}
+
+// FailSignal gives up on a pending signal and fails the run it belongs to
+func (dm *dispatchManager) FailSignal(ctx context.Context, signal db.OutboxSignal, reason error) error {
+	return dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&db.OutboxSignal{}).
+			Where("id = ?", signal.ID).
+			Where("status = ?", db.OutboxSignalStatusPending).
+			Updates(map[string]interface{}{
+				"status":     db.OutboxSignalStatusFailed,
+				"attempts":   gorm.Expr("attempts + 1"),
+				"last_error": queue.TruncateError(reason),
+				"updated_at": time.Now(),
+			})
+
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		var run db.Run
+		if dbResult := tx.First(&run, signal.RunID); dbResult.Error != nil {
+			return dbResult.Error
+		}
+
+		_, err := updateRunStatus(ctx, tx, run, db.RunStatusRunning, db.RunStatusFailure)
+		return err
+	})
+}
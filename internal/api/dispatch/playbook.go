@@ -0,0 +1,47 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/dispatch/preflight"
+	"playbook-dispatcher/internal/api/dispatch/registry"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/model/generic"
+)
+
+func usesRegistry(run generic.RunInput) bool {
+	return run.PlaybookDigest != nil || run.PlaybookSignature != nil
+}
+
+// checkPlaybook fetches the Playbook once for both the preflight and the playbook registry.
+// Malformed Playbooks are rejected here instead of surfacing as a failure reported by rhc-worker-playbook.
+func (dm *dispatchManager) checkPlaybook(ctx context.Context, run *generic.RunInput) error {
+	content, err := dm.registry.Fetch(ctx, run.Url)
+	if err != nil {
+		instrumentation.PlaybookRejected(ctx, err, run.OrgId, run.Url)
+		return err
+	}
+
+	if run.Preflight {
+		if err := preflight.Lint(content); err != nil {
+			instrumentation.PlaybookPreflightFailed(ctx, err, run.OrgId, run.Url)
+			return err
+		}
+	}
+
+	// the run records the digest of the Playbook so that it is known exactly what content ran
+	if usesRegistry(*run) {
+		digest, err := dm.registry.Resolve(ctx, run.OrgId, content, run.PlaybookDigest, run.PlaybookSignature)
+		if registry.IsRejected(err) {
+			instrumentation.PlaybookRejected(ctx, err, run.OrgId, run.Url)
+			return err
+		} else if err != nil {
+			instrumentation.PlaybookCreateError(ctx, err, run.OrgId)
+			return err
+		}
+
+		instrumentation.PlaybookVerified(ctx, run.OrgId, run.Url, digest)
+		run.PlaybookDigest = &digest
+	}
+
+	return nil
+}
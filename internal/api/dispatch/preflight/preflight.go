@@ -0,0 +1,92 @@
+package preflight
+
+import (
+	"fmt"
+
+	"github.com/ghodss/yaml"
+)
+
+// sections of a play that contain something to run
+var runnableSections = []string{"tasks", "roles", "pre_tasks", "post_tasks", "handlers"}
+
+// vars rhc-worker-playbook needs to verify the Playbook before running it
+var signatureVars = []string{"insights_signature", "insights_signature_exclude"}
+
+// Indicates that the Playbook cannot be run by rhc-worker-playbook
+type LintError struct {
+	Reason string
+}
+
+func (this *LintError) Error() string {
+	return fmt.Sprintf("Playbook preflight failed: %s", this.Reason)
+}
+
+// Lint checks that the Playbook is a list of plays rhc-worker-playbook is able to verify and run.
+// Every play needs to target hosts, define something to run and carry the insights signature vars.
+// The signature itself is only verified on the host.
+func Lint(content []byte) error {
+	var document interface{}
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return &LintError{Reason: fmt.Sprintf("not valid YAML: %s", err)}
+	}
+
+	plays, ok := document.([]interface{})
+	if !ok || len(plays) == 0 {
+		return &LintError{Reason: "expected a non-empty list of plays"}
+	}
+
+	for i, value := range plays {
+		if err := lintPlay(i, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func lintPlay(index int, value interface{}) error {
+	play, ok := value.(map[string]interface{})
+	if !ok {
+		return &LintError{Reason: fmt.Sprintf("play %d is not a mapping", index)}
+	}
+
+	if !isNonEmptyString(play["hosts"]) {
+		return &LintError{Reason: fmt.Sprintf("play %d does not define hosts", index)}
+	}
+
+	runnable := false
+	for _, section := range runnableSections {
+		content, present := play[section]
+		if !present || content == nil {
+			continue
+		}
+
+		if _, ok := content.([]interface{}); !ok {
+			return &LintError{Reason: fmt.Sprintf("%s of play %d is not a list", section, index)}
+		}
+
+		runnable = true
+	}
+
+	if !runnable {
+		return &LintError{Reason: fmt.Sprintf("play %d does not define any tasks or roles", index)}
+	}
+
+	vars, ok := play["vars"].(map[string]interface{})
+	if !ok {
+		return &LintError{Reason: fmt.Sprintf("play %d does not define vars", index)}
+	}
+
+	for _, name := range signatureVars {
+		if !isNonEmptyString(vars[name]) {
+			return &LintError{Reason: fmt.Sprintf("play %d is missing vars.%s", index, name)}
+		}
+	}
+
+	return nil
+}
+
+func isNonEmptyString(value interface{}) bool {
+	str, ok := value.(string)
+	return ok && str != ""
+}
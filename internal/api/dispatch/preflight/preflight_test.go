@@ -0,0 +1,101 @@
+package preflight
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+const validPlaybook = `
+- name: update packages
+  hosts: localhost
+  become: true
+  vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+    insights_signature: !!binary |
+      TFMwdExTMUNSVWRKVGlCUVIxQWdVMGxIVGtGVVZWSkZMUzB0TFMwS1ZtVnljMmx2YmpvZ1IyNTFV
+  tasks:
+  - name: update all packages
+    yum:
+      name: '*'
+      state: latest
+`
+
+var _ = Describe("Lint", func() {
+	It("accepts a valid Playbook", func() {
+		Expect(Lint([]byte(validPlaybook))).To(Succeed())
+	})
+
+	It("accepts a play that only defines roles", func() {
+		playbook := `
+- hosts: all
+  vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+    insights_signature: c2lnbmF0dXJl
+  roles:
+  - common
+`
+		Expect(Lint([]byte(playbook))).To(Succeed())
+	})
+
+	DescribeTable("rejects a malformed Playbook",
+		func(playbook string, reason string) {
+			Expect(Lint([]byte(playbook))).To(Equal(&LintError{Reason: reason}))
+		},
+
+		Entry("empty document", "", "expected a non-empty list of plays"),
+		Entry("empty list", "[]", "expected a non-empty list of plays"),
+		Entry("mapping", "hosts: all", "expected a non-empty list of plays"),
+		Entry("play not a mapping", "- hosts", "play 0 is not a mapping"),
+		Entry("missing hosts", `
+- vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+    insights_signature: c2lnbmF0dXJl
+  tasks:
+  - ping:
+`, "play 0 does not define hosts"),
+		Entry("nothing to run", `
+- hosts: all
+  vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+    insights_signature: c2lnbmF0dXJl
+`, "play 0 does not define any tasks or roles"),
+		Entry("tasks not a list", `
+- hosts: all
+  vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+    insights_signature: c2lnbmF0dXJl
+  tasks: ping
+`, "tasks of play 0 is not a list"),
+		Entry("missing vars", `
+- hosts: all
+  tasks:
+  - ping:
+`, "play 0 does not define vars"),
+		Entry("missing signature", `
+- hosts: all
+  vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+  tasks:
+  - ping:
+`, "play 0 is missing vars.insights_signature"),
+		Entry("missing signature exclude", `
+- hosts: all
+  vars:
+    insights_signature: c2lnbmF0dXJl
+  tasks:
+  - ping:
+`, "play 0 is missing vars.insights_signature_exclude"),
+	)
+
+	It("reports the play that fails", func() {
+		playbook := validPlaybook + "- hosts: all\n  tasks:\n  - ping:\n"
+		Expect(Lint([]byte(playbook))).To(Equal(&LintError{Reason: "play 1 does not define vars"}))
+	})
+
+	It("rejects content that is not YAML", func() {
+		err := Lint([]byte("- hosts: [all"))
+		Expect(err).To(BeAssignableToTypeOf(&LintError{}))
+		Expect(err.Error()).To(HavePrefix("Playbook preflight failed: not valid YAML"))
+	})
+})
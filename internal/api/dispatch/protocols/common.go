@@ -1,6 +1,26 @@
 package protocols
 
-import "github.com/spf13/viper"
+import (
+	"encoding/json"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// metadata keys used to deliver the extra vars of a Playbook run
+const (
+	runnerExtraVarsKey     = "crc_dispatcher_extra_vars"
+	runnerSecretVarsKey    = "crc_dispatcher_secret_vars"
+	satelliteExtraVarsKey  = "extra_vars"
+	satelliteSecretVarsKey = "secret_vars"
+)
+
+var extraVarsKeys = map[string]string{
+	runnerExtraVarsKey:    runnerSecretVarsKey,
+	satelliteExtraVarsKey: satelliteSecretVarsKey,
+}
 
 func buildCommonSignal(cfg *viper.Viper) map[string]string {
 	return map[string]string{
@@ -8,3 +28,41 @@ func buildCommonSignal(cfg *viper.Viper) map[string]string {
 		"response_interval": cfg.GetString("response.interval"),
 	}
 }
+
+// the extra vars are JSON-encoded and the names of the secret ones are sent along so that the worker can keep them out of its output
+func setExtraVars(metadata map[string]string, varsKey string, secretsKey string, extraVars *generic.RunExtraVarsInput) {
+	if extraVars == nil {
+		return
+	}
+
+	metadata[varsKey] = string(utils.MustMarshal(extraVars.Values))
+
+	if len(extraVars.Secrets) > 0 {
+		metadata[secretsKey] = strings.Join(extraVars.Secrets, ",")
+	}
+}
+
+// RedactMetaData returns a copy of the metadata in which the values of secret extra vars are redacted
+func RedactMetaData(metadata map[string]string) map[string]string {
+	result := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		result[key] = value
+	}
+
+	for varsKey, secretsKey := range extraVarsKeys {
+		secrets, ok := metadata[secretsKey]
+		if !ok {
+			continue
+		}
+
+		var values map[string]interface{}
+		if err := json.Unmarshal([]byte(metadata[varsKey]), &values); err != nil {
+			result[varsKey] = utils.RedactedValue
+			continue
+		}
+
+		result[varsKey] = string(utils.MustMarshal(utils.RedactValues(values, strings.Split(secrets, ","))))
+	}
+
+	return result
+}
@@ -0,0 +1,35 @@
+package protocols
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RedactMetaData", func() {
+	It("redacts the values of secret extra vars", func() {
+		metadata := map[string]string{
+			"crc_dispatcher_correlation_id": "c6b4c1bb-6f5a-4d3c-b5a1-2d4dc84a2d1e",
+			"crc_dispatcher_extra_vars":     `{"package": "openssl", "token": "s3cr3t"}`,
+			"crc_dispatcher_secret_vars":    "token",
+		}
+
+		result := RedactMetaData(metadata)
+		Expect(result["crc_dispatcher_correlation_id"]).To(Equal(metadata["crc_dispatcher_correlation_id"]))
+		Expect(result["crc_dispatcher_extra_vars"]).To(MatchJSON(`{"package": "openssl", "token": "[REDACTED]"}`))
+		Expect(metadata["crc_dispatcher_extra_vars"]).To(ContainSubstring("s3cr3t"))
+	})
+
+	It("redacts Satellite extra vars", func() {
+		result := RedactMetaData(map[string]string{
+			"extra_vars":  `{"password": "s3cr3t"}`,
+			"secret_vars": "password",
+		})
+
+		Expect(result["extra_vars"]).To(MatchJSON(`{"password": "[REDACTED]"}`))
+	})
+
+	It("leaves extra vars without secrets as they are", func() {
+		result := RedactMetaData(map[string]string{"extra_vars": `{"package": "openssl"}`})
+		Expect(result["extra_vars"]).To(Equal(`{"package": "openssl"}`))
+	})
+})
@@ -1,6 +1,7 @@
 package protocols
 
 import (
+	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/generic"
 
 	"github.com/google/uuid"
@@ -25,5 +26,25 @@ func (rp *runnerProtocol) BuildMetaData(runInput generic.RunInput, correlationID
 	metadata := buildCommonSignal(cfg)
 	metadata["crc_dispatcher_correlation_id"] = correlationID.String()
 
+	// the worker downloads the Playbook from the url itself and needs to verify it against the digest
+	if runInput.PlaybookDigest != nil {
+		metadata["crc_dispatcher_playbook_digest"] = *runInput.PlaybookDigest
+	}
+
+	// the worker runs ansible with --check --diff
+	if runInput.Mode != nil && *runInput.Mode == dbModel.RunModeCheck {
+		metadata["crc_dispatcher_mode"] = dbModel.RunModeCheck
+	}
+
+	setExtraVars(metadata, runnerExtraVarsKey, runnerSecretVarsKey, runInput.ExtraVars)
+
+	return metadata
+}
+
+func (rp *runnerProtocol) BuildCancelMetaData(cancelInput generic.CancelInput, correlationID uuid.UUID, cfg *viper.Viper) map[string]string {
+	metadata := buildCommonSignal(cfg)
+	metadata["operation"] = "cancel"
+	metadata["crc_dispatcher_correlation_id"] = correlationID.String()
+
 	return metadata
 }
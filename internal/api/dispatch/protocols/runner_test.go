@@ -29,5 +29,62 @@ var _ = Describe("Runner Protocol", func() {
 			Expect(metadata["response_interval"]).To(Equal("3"))
 			Expect(metadata["return_url"]).To(Equal("https://example.com"))
 		})
+
+		It("includes the digest of a Playbook from the registry", func() {
+			digest := "sha256:4f2b8c3dd1a3c0f5b4e1e3b9f4b0f0f2ad6c4de2e1f2a0cf8e1c5b7a9d3e2f10"
+			run := generic.RunInput{PlaybookDigest: &digest}
+
+			metadata := RunnerProtocol.BuildMetaData(run, uuid.New(), viper.New())
+			Expect(metadata["crc_dispatcher_playbook_digest"]).To(Equal(digest))
+		})
+
+		It("includes the extra vars", func() {
+			run := generic.RunInput{
+				ExtraVars: &generic.RunExtraVarsInput{
+					Values:  map[string]interface{}{"package": "openssl", "token": "s3cr3t"},
+					Secrets: []string{"token"},
+				},
+			}
+
+			metadata := RunnerProtocol.BuildMetaData(run, uuid.New(), viper.New())
+			Expect(metadata["crc_dispatcher_extra_vars"]).To(MatchJSON(`{"package": "openssl", "token": "s3cr3t"}`))
+			Expect(metadata["crc_dispatcher_secret_vars"]).To(Equal("token"))
+		})
+
+		It("requests the check mode", func() {
+			mode := "check"
+			run := generic.RunInput{Mode: &mode}
+
+			metadata := RunnerProtocol.BuildMetaData(run, uuid.New(), viper.New())
+			Expect(metadata["crc_dispatcher_mode"]).To(Equal("check"))
+		})
+
+		It("does not set the mode of a regular run", func() {
+			mode := "run"
+			run := generic.RunInput{Mode: &mode}
+
+			metadata := RunnerProtocol.BuildMetaData(run, uuid.New(), viper.New())
+			Expect(metadata).ToNot(HaveKey("crc_dispatcher_mode"))
+		})
+
+		It("produces correct cancel metadata", func() {
+			cancel := generic.CancelInput{
+				RunId:     uuid.New(),
+				OrgId:     "5318290",
+				Principal: "jharting",
+			}
+
+			correlationID := uuid.New()
+			cfg := viper.New()
+			cfg.Set("response.interval", "3")
+			cfg.Set("return.url", "https://example.com")
+
+			metadata := RunnerProtocol.BuildCancelMetaData(cancel, correlationID, cfg)
+			Expect(metadata).To(HaveLen(4))
+			Expect(metadata["operation"]).To(Equal("cancel"))
+			Expect(metadata["crc_dispatcher_correlation_id"]).To(Equal(correlationID.String()))
+			Expect(metadata["response_interval"]).To(Equal("3"))
+			Expect(metadata["return_url"]).To(Equal("https://example.com"))
+		})
 	})
 })
@@ -75,6 +75,7 @@ func (sp *satelliteProtocol) BuildMetaData(runInput generic.RunInput, correlatio
 		metadata["subscription_manager_ids"] = submanIDs
 	}
 	metadata["response_full"] = strconv.FormatBool(sp.GetResponseFull(cfg))
+	setExtraVars(metadata, satelliteExtraVarsKey, satelliteSecretVarsKey, runInput.ExtraVars)
 
 	return metadata
 }
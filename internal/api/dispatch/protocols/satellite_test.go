@@ -168,5 +168,24 @@ var _ = Describe("Satellite Protocol", func() {
 			Expect(metadata["response_interval"]).To(Equal("3"))
 			Expect(metadata["response_full"]).To(Equal("true"))
 		})
+
+		It("includes the extra vars", func() {
+			satID := uuid.New()
+
+			run := generic.RunInput{
+				Name:          utils.StringRef("Red Hat Playbook"),
+				WebConsoleUrl: utils.StringRef("https://console.redhat.com/insights/remediations"),
+				Principal:     utils.StringRef("jharting"),
+				SatId:         &satID,
+				SatOrgId:      utils.StringRef("1"),
+				ExtraVars: &generic.RunExtraVarsInput{
+					Values: map[string]interface{}{"packages": []interface{}{"openssl", "curl"}},
+				},
+			}
+
+			metadata := SatelliteProtocol.BuildMetaData(run, uuid.New(), viper.New())
+			Expect(metadata["extra_vars"]).To(MatchJSON(`{"packages": ["openssl", "curl"]}`))
+			Expect(metadata).ToNot(HaveKey("secret_vars"))
+		})
 	})
 })
@@ -22,4 +22,7 @@ type Protocol interface {
 
 	// build the metadata dictionary in a format that the given rhc worker understands
 	BuildMetaData(runInput generic.RunInput, correlationID uuid.UUID, cfg *viper.Viper) map[string]string
+
+	// build the metadata dictionary used to cancel a previously dispatched run
+	BuildCancelMetaData(cancelInput generic.CancelInput, correlationID uuid.UUID, cfg *viper.Viper) map[string]string
 }
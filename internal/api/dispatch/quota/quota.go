@@ -0,0 +1,228 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"playbook-dispatcher/internal/common/utils"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+const (
+	ScopeOrg     = "org_id"
+	ScopeService = "service"
+)
+
+// maximum number of buckets kept per scope before the buckets that are full again are dropped
+const maxBuckets = 10000
+
+// Limits defines the sustained rate (per second) and the burst of a token bucket
+type Limits struct {
+	Rate  rate.Limit
+	Burst int
+}
+
+type ExceededError struct {
+	Scope string
+	Key   string
+}
+
+func (this *ExceededError) Error() string {
+	return fmt.Sprintf("Quota exceeded for %s %s", this.Scope, this.Key)
+}
+
+// Quota admits dispatch requests based on a separate token bucket per organization and per dispatching service.
+// This way a single noisy tenant or service cannot use up the capacity shared by everyone else.
+type Quota struct {
+	orgs     buckets
+	services buckets
+}
+
+// buckets keep a token bucket for each key
+type buckets interface {
+	// take takes a token from the bucket of the given key.
+	// The returned function puts the token back. It is nil if the bucket is empty.
+	take(ctx context.Context, key string) (func(), error)
+}
+
+// New keeps the buckets selected by quota.impl.
+// By default the buckets are kept in the memory of the process, i.e. every replica admits the configured rate on its own.
+func New(cfg *viper.Viper) *Quota {
+	orgLimits, orgOverrides := readLimits(cfg, "quota.org"), ParseOverrides(cfg.GetString("quota.org.overrides"))
+	serviceLimits, serviceOverrides := readLimits(cfg, "quota.service"), ParseOverrides(cfg.GetString("quota.service.overrides"))
+
+	if cfg.GetString("quota.impl") == "redis" {
+		client := utils.NewRedisClient(cfg)
+
+		return &Quota{
+			orgs:     newRedisBuckets(client, quotaKeyPrefix+":"+ScopeOrg, orgLimits, orgOverrides),
+			services: newRedisBuckets(client, quotaKeyPrefix+":"+ScopeService, serviceLimits, serviceOverrides),
+		}
+	}
+
+	return &Quota{
+		orgs:     newMemoryBuckets(orgLimits, orgOverrides),
+		services: newMemoryBuckets(serviceLimits, serviceOverrides),
+	}
+}
+
+// Allow takes a token from the bucket of the organization as well as from the bucket of the service.
+// Nothing is taken if either of the buckets is empty.
+// Requests are admitted if the buckets cannot be read, i.e. the quota is not enforced while Redis is unavailable.
+// The returned function puts the tokens back; it is meant for requests that turn out not to create a run.
+func (this *Quota) Allow(ctx context.Context, orgID string, service string) (refund func(), err error) {
+	refundOrg, err := this.orgs.take(ctx, orgID)
+	if err != nil {
+		return noRefund, admit(ctx, err)
+	} else if refundOrg == nil {
+		return noRefund, &ExceededError{Scope: ScopeOrg, Key: orgID}
+	}
+
+	refundService, err := this.services.take(ctx, service)
+	if err != nil {
+		return refundOrg, admit(ctx, err)
+	} else if refundService == nil {
+		refundOrg()
+		return noRefund, &ExceededError{Scope: ScopeService, Key: service}
+	}
+
+	return func() {
+		refundOrg()
+		refundService()
+	}, nil
+}
+
+func noRefund() {}
+
+func admit(ctx context.Context, err error) error {
+	utils.GetLogFromContext(ctx).Warnw("Error reading dispatch quota, admitting request", "error", err)
+	return nil
+}
+
+// ParseOverrides parses overrides in the "<key>=<rate>:<burst>,<key>=<rate>:<burst>" format.
+// Malformed entries are ignored.
+func ParseOverrides(value string) map[string]Limits {
+	result := map[string]Limits{}
+
+	for _, entry := range strings.Split(value, ",") {
+		key, limits, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || key == "" {
+			continue
+		}
+
+		rateValue, burstValue, found := strings.Cut(limits, ":")
+		if !found {
+			continue
+		}
+
+		parsedRate, err := strconv.ParseFloat(rateValue, 64)
+		if err != nil {
+			continue
+		}
+
+		parsedBurst, err := strconv.Atoi(burstValue)
+		if err != nil {
+			continue
+		}
+
+		result[key] = newLimits(parsedRate, parsedBurst)
+	}
+
+	return result
+}
+
+func readLimits(cfg *viper.Viper, prefix string) Limits {
+	return newLimits(cfg.GetFloat64(prefix+".rps"), cfg.GetInt(prefix+".burst"))
+}
+
+// a non-positive rate turns the limit off
+func newLimits(rps float64, burst int) Limits {
+	if rps <= 0 {
+		return Limits{Rate: rate.Inf, Burst: burst}
+	}
+
+	return Limits{Rate: rate.Limit(rps), Burst: burst}
+}
+
+func limitsOf(key string, defaults Limits, overrides map[string]Limits) Limits {
+	if limits, ok := overrides[key]; ok {
+		return limits
+	}
+
+	return defaults
+}
+
+type memoryBuckets struct {
+	lock      sync.Mutex
+	defaults  Limits
+	overrides map[string]Limits
+	limiters  map[string]*rate.Limiter
+}
+
+func newMemoryBuckets(defaults Limits, overrides map[string]Limits) *memoryBuckets {
+	return &memoryBuckets{
+		defaults:  defaults,
+		overrides: overrides,
+		limiters:  map[string]*rate.Limiter{},
+	}
+}
+
+func (this *memoryBuckets) take(ctx context.Context, key string) (func(), error) {
+	now := time.Now()
+
+	reservation := this.reserve(key, now)
+	if reservation == nil {
+		return nil, nil
+	}
+
+	return func() { reservation.CancelAt(now) }, nil
+}
+
+// reserve takes a token from the bucket of the given key.
+// Returns nil if the bucket is empty.
+func (this *memoryBuckets) reserve(key string, now time.Time) *rate.Reservation {
+	reservation := this.get(key, now).ReserveN(now, 1)
+
+	if !reservation.OK() {
+		return nil
+	}
+
+	if reservation.DelayFrom(now) > 0 {
+		reservation.CancelAt(now)
+		return nil
+	}
+
+	return reservation
+}
+
+func (this *memoryBuckets) get(key string, now time.Time) *rate.Limiter {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	if limiter, ok := this.limiters[key]; ok {
+		return limiter
+	}
+
+	if len(this.limiters) >= maxBuckets {
+		this.prune(now)
+	}
+
+	limits := limitsOf(key, this.defaults, this.overrides)
+	limiter := rate.NewLimiter(limits.Rate, limits.Burst)
+	this.limiters[key] = limiter
+	return limiter
+}
+
+// prune drops the buckets that are full again as they are no different from new ones
+func (this *memoryBuckets) prune(now time.Time) {
+	for key, limiter := range this.limiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(this.limiters, key)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package quota
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+var _ = Describe("Quota", func() {
+	ctx := context.Background()
+
+	newQuota := func(orgOverrides string, serviceOverrides string) *Quota {
+		cfg := viper.New()
+		cfg.Set("quota.org.rps", 0.001)
+		cfg.Set("quota.org.burst", 2)
+		cfg.Set("quota.org.overrides", orgOverrides)
+		cfg.Set("quota.service.rps", 0.001)
+		cfg.Set("quota.service.burst", 3)
+		cfg.Set("quota.service.overrides", serviceOverrides)
+		return New(cfg)
+	}
+
+	allow := func(quota *Quota, orgID string, service string) error {
+		_, err := quota.Allow(ctx, orgID, service)
+		return err
+	}
+
+	It("admits requests up to the burst of the organization", func() {
+		quota := newQuota("", "")
+
+		Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+		Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+		Expect(allow(quota, "5318290", "remediations")).To(Equal(&ExceededError{Scope: ScopeOrg, Key: "5318290"}))
+	})
+
+	It("keeps separate buckets for each organization", func() {
+		quota := newQuota("", "")
+
+		Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+		Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+		Expect(allow(quota, "12900172", "config_manager")).To(Succeed())
+	})
+
+	It("admits requests up to the burst of the service", func() {
+		quota := newQuota("", "")
+
+		Expect(allow(quota, "1", "remediations")).To(Succeed())
+		Expect(allow(quota, "2", "remediations")).To(Succeed())
+		Expect(allow(quota, "3", "remediations")).To(Succeed())
+		Expect(allow(quota, "4", "remediations")).To(Equal(&ExceededError{Scope: ScopeService, Key: "remediations"}))
+	})
+
+	It("does not charge the organization if the service is over quota", func() {
+		quota := newQuota("", "remediations=0.001:1")
+
+		Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+		Expect(allow(quota, "5318290", "remediations")).To(HaveOccurred())
+		Expect(allow(quota, "5318290", "config_manager")).To(Succeed())
+	})
+
+	It("puts the tokens back on refund", func() {
+		quota := newQuota("", "")
+		Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+
+		refund, err := quota.Allow(ctx, "5318290", "remediations")
+		Expect(err).ToNot(HaveOccurred())
+		refund()
+
+		Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+		Expect(allow(quota, "5318290", "remediations")).To(Equal(&ExceededError{Scope: ScopeOrg, Key: "5318290"}))
+	})
+
+	It("applies overrides", func() {
+		quota := newQuota("5318290=0.001:3", "")
+
+		Expect(allow(quota, "5318290", "a")).To(Succeed())
+		Expect(allow(quota, "5318290", "b")).To(Succeed())
+		Expect(allow(quota, "5318290", "c")).To(Succeed())
+		Expect(allow(quota, "5318290", "d")).To(HaveOccurred())
+	})
+
+	It("does not limit if the rate is not positive", func() {
+		quota := newQuota("5318290=0:1", "remediations=0:1")
+
+		for i := 0; i < 10; i++ {
+			Expect(allow(quota, "5318290", "remediations")).To(Succeed())
+		}
+	})
+})
+
+var _ = DescribeTable("ParseOverrides",
+	func(value string, expected map[string]Limits) {
+		Expect(ParseOverrides(value)).To(Equal(expected))
+	},
+
+	Entry("empty", "", map[string]Limits{}),
+	Entry("single", "5318290=10:100", map[string]Limits{"5318290": {Rate: 10, Burst: 100}}),
+	Entry("multiple", "5318290=10:100, remediations=0.5:5", map[string]Limits{
+		"5318290":      {Rate: 10, Burst: 100},
+		"remediations": {Rate: 0.5, Burst: 5},
+	}),
+	Entry("unlimited", "5318290=0:100", map[string]Limits{"5318290": {Rate: rate.Inf, Burst: 100}}),
+	Entry("malformed entries are ignored", "5318290=10,=1:1,a=b:1,c=1:d,d=1:2", map[string]Limits{"d": {Rate: 1, Burst: 2}}),
+)
@@ -0,0 +1,88 @@
+package quota
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/time/rate"
+)
+
+const quotaKeyPrefix = "playbook-dispatcher:quota"
+
+// takeScript refills the bucket (a hash holding the tokens and the time of the last update) at ARGV[1] tokens per second up to ARGV[2] tokens
+// and takes ARGV[3] tokens from it. A negative number of tokens puts the tokens back.
+// The clock of Redis is used so that the replicas need not agree on the time.
+var takeScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local requested = tonumber(ARGV[3])
+
+local time = redis.call('TIME')
+local now = tonumber(time[1]) + tonumber(time[2]) / 1000000
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'timestamp')
+local tokens = tonumber(state[1])
+local timestamp = tonumber(state[2])
+
+if tokens == nil or timestamp == nil then
+	tokens = burst
+	timestamp = now
+end
+
+tokens = math.min(burst, tokens + math.max(0, now - timestamp) * rate)
+
+local taken = 0
+if tokens >= requested then
+	tokens = math.min(burst, tokens - requested)
+	taken = 1
+end
+
+redis.call('HSET', KEYS[1], 'tokens', string.format('%.6f', tokens), 'timestamp', string.format('%.6f', now))
+-- a bucket that is full again is no different from a new one
+redis.call('EXPIRE', KEYS[1], math.ceil(burst / rate) + 1)
+
+return taken
+`)
+
+// redisBuckets keep the token buckets in Redis so that the quota is shared by all replicas
+type redisBuckets struct {
+	client    *redis.Client
+	prefix    string
+	defaults  Limits
+	overrides map[string]Limits
+}
+
+func newRedisBuckets(client *redis.Client, prefix string, defaults Limits, overrides map[string]Limits) *redisBuckets {
+	return &redisBuckets{
+		client:    client,
+		prefix:    prefix,
+		defaults:  defaults,
+		overrides: overrides,
+	}
+}
+
+func (this *redisBuckets) take(ctx context.Context, key string) (func(), error) {
+	limits := limitsOf(key, this.defaults, this.overrides)
+	if limits.Rate == rate.Inf {
+		return func() {}, nil
+	}
+
+	redisKey := this.prefix + ":" + key
+
+	taken, err := this.run(ctx, redisKey, limits, 1)
+	if err != nil || !taken {
+		return nil, err
+	}
+
+	return func() {
+		if _, err := this.run(ctx, redisKey, limits, -1); err != nil {
+			utils.GetLogFromContext(ctx).Warnw("Error returning dispatch quota token", "error", err, "key", redisKey)
+		}
+	}, nil
+}
+
+func (this *redisBuckets) run(ctx context.Context, key string, limits Limits, tokens int) (bool, error) {
+	taken, err := takeScript.Run(ctx, this.client, []string{key}, float64(limits.Rate), limits.Burst, tokens).Int()
+	return taken == 1, err
+}
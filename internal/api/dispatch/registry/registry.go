@@ -0,0 +1,262 @@
+package registry
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const digestPrefix = "sha256:"
+
+// Indicates that the Playbook is not signed by any of the trusted keys
+type InvalidSignatureError struct {
+	Digest string
+}
+
+// Indicates that the Playbook fetched from the URL is not the one the caller referenced
+type DigestMismatchError struct {
+	Expected string
+	Actual   string
+}
+
+// Indicates that the referenced Playbook has not been uploaded to the registry
+type PlaybookNotFoundError struct {
+	Digest string
+}
+
+// Indicates that the Playbook is larger than playbook.max.size bytes
+type PlaybookTooLargeError struct {
+	MaxSize int64
+}
+
+// Indicates that the host of the Playbook URL is not on the outbound.allowed.hosts allowlist
+type URLNotAllowedError struct {
+	URL    string
+	Reason string
+}
+
+// Indicates that the Playbook cannot be fetched from its URL
+type FetchError struct {
+	URL    string
+	Reason string
+}
+
+func (this *InvalidSignatureError) Error() string {
+	return fmt.Sprintf("Playbook signature not valid: %s", this.Digest)
+}
+
+func (this *DigestMismatchError) Error() string {
+	return fmt.Sprintf("Playbook digest mismatch: expected %s, got %s", this.Expected, this.Actual)
+}
+
+func (this *PlaybookNotFoundError) Error() string {
+	return fmt.Sprintf("Playbook not found in registry: %s", this.Digest)
+}
+
+func (this *PlaybookTooLargeError) Error() string {
+	return fmt.Sprintf("Playbook larger than %d bytes", this.MaxSize)
+}
+
+func (this *URLNotAllowedError) Error() string {
+	return fmt.Sprintf("Playbook cannot be fetched from %s: %s", this.URL, this.Reason)
+}
+
+func (this *FetchError) Error() string {
+	return fmt.Sprintf("Playbook cannot be fetched from %s: %s", this.URL, this.Reason)
+}
+
+// IsRejected indicates whether the error means that the Playbook did not pass verification
+func IsRejected(err error) bool {
+	switch err.(type) {
+	case *InvalidSignatureError, *DigestMismatchError, *PlaybookNotFoundError, *PlaybookTooLargeError, *URLNotAllowedError, *FetchError:
+		return true
+	default:
+		return false
+	}
+}
+
+// Registry stores verified Playbooks addressed by the SHA-256 digest of their content.
+// A Playbook is only accepted if it carries a detached Ed25519 signature made by one of the keys listed in playbook.registry.keys.
+// The registry also fetches the Playbooks that Playbook runs point to, as long as their host is on the outbound.allowed.hosts allowlist.
+type Registry struct {
+	db           *gorm.DB
+	client       utils.HttpRequestDoer
+	keys         []ed25519.PublicKey
+	maxSize      int64
+	allowedHosts utils.HostAllowlist
+}
+
+func New(cfg *viper.Viper, db *gorm.DB) *Registry {
+	checkRedirect := utils.ParseHostAllowlist(cfg.GetString("outbound.allowed.hosts")).CheckRedirect(false)
+
+	client := &http.Client{
+		Timeout: time.Duration(cfg.GetInt64("playbook.fetch.timeout")) * time.Second,
+		// an allowed host must not be able to redirect the fetch to a host that is not allowed
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if err := checkRedirect(req, via); err != nil {
+				return &URLNotAllowedError{URL: req.URL.String(), Reason: err.Error()}
+			}
+
+			return nil
+		},
+	}
+
+	return NewWithHttpRequestDoer(cfg, db, utils.NewMeasuredHttpRequestDoer(client, "playbook-registry", "FetchPlaybook"))
+}
+
+func NewWithHttpRequestDoer(cfg *viper.Viper, db *gorm.DB, client utils.HttpRequestDoer) *Registry {
+	return &Registry{
+		db:           db,
+		client:       client,
+		keys:         ParseKeys(cfg.GetString("playbook.registry.keys")),
+		maxSize:      cfg.GetInt64("playbook.max.size"),
+		allowedHosts: utils.ParseHostAllowlist(cfg.GetString("outbound.allowed.hosts")),
+	}
+}
+
+// ParseKeys parses a comma-separated list of base64-encoded Ed25519 public keys.
+// Malformed entries are ignored.
+func ParseKeys(value string) []ed25519.PublicKey {
+	result := []ed25519.PublicKey{}
+
+	for _, entry := range strings.Split(value, ",") {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(entry))
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			continue
+		}
+
+		result = append(result, ed25519.PublicKey(key))
+	}
+
+	return result
+}
+
+// Digest returns the SHA-256 digest of the given content in the "sha256:<hex>" format
+func Digest(content []byte) string {
+	sum := sha256.Sum256(content)
+	return digestPrefix + hex.EncodeToString(sum[:])
+}
+
+// Verify checks that the base64-encoded signature of the content was made by one of the trusted keys
+func (this *Registry) Verify(content []byte, signature string) error {
+	decoded, err := base64.StdEncoding.DecodeString(signature)
+	if err == nil {
+		for _, key := range this.keys {
+			if ed25519.Verify(key, content, decoded) {
+				return nil
+			}
+		}
+	}
+
+	return &InvalidSignatureError{Digest: Digest(content)}
+}
+
+// Upload verifies the signature of the Playbook and stores it in the registry of the organization.
+// Uploading the same Playbook again keeps the original record.
+func (this *Registry) Upload(ctx context.Context, orgID string, content []byte, signature string) (db.Playbook, error) {
+	if int64(len(content)) > this.maxSize {
+		return db.Playbook{}, &PlaybookTooLargeError{MaxSize: this.maxSize}
+	}
+
+	if err := this.Verify(content, signature); err != nil {
+		return db.Playbook{}, err
+	}
+
+	playbook := db.Playbook{
+		OrgID:     orgID,
+		Digest:    Digest(content),
+		Content:   content,
+		Signature: signature,
+	}
+
+	if dbResult := this.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&playbook); dbResult.Error != nil {
+		return db.Playbook{}, dbResult.Error
+	}
+
+	return playbook, nil
+}
+
+// Resolve returns the digest of the Playbook fetched for a run.
+// If the caller references a digest, the Playbook needs to match it and needs to have been uploaded to the registry before.
+// Otherwise, the Playbook needs to carry a valid signature and is added to the registry.
+func (this *Registry) Resolve(ctx context.Context, orgID string, content []byte, digest *string, signature *string) (string, error) {
+	actual := Digest(content)
+
+	if digest != nil {
+		if *digest != actual {
+			return "", &DigestMismatchError{Expected: *digest, Actual: actual}
+		}
+
+		var playbooks []db.Playbook
+		dbResult := this.db.WithContext(ctx).Select("org_id", "digest").Where("org_id = ? AND digest = ?", orgID, actual).Limit(1).Find(&playbooks)
+		if dbResult.Error != nil {
+			return "", dbResult.Error
+		}
+
+		if len(playbooks) > 0 {
+			return actual, nil
+		} else if signature == nil {
+			return "", &PlaybookNotFoundError{Digest: actual}
+		}
+	}
+
+	if signature == nil {
+		return "", &InvalidSignatureError{Digest: actual}
+	}
+
+	if _, err := this.Upload(ctx, orgID, content, *signature); err != nil {
+		return "", err
+	}
+
+	return actual, nil
+}
+
+// Fetch downloads the Playbook, failing if it is larger than playbook.max.size bytes
+func (this *Registry) Fetch(ctx context.Context, url string) ([]byte, error) {
+	if err := this.allowedHosts.CheckURL(url, false); err != nil {
+		return nil, &URLNotAllowedError{URL: url, Reason: err.Error()}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, &FetchError{URL: url, Reason: err.Error()}
+	}
+
+	resp, err := this.client.Do(req)
+	if notAllowed := (*URLNotAllowedError)(nil); errors.As(err, &notAllowed) {
+		return nil, notAllowed
+	} else if err != nil {
+		return nil, &FetchError{URL: url, Reason: err.Error()}
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &FetchError{URL: url, Reason: fmt.Sprintf("unexpected status code %d", resp.StatusCode)}
+	}
+
+	content, err := io.ReadAll(io.LimitReader(resp.Body, this.maxSize+1))
+	if err != nil {
+		return nil, &FetchError{URL: url, Reason: err.Error()}
+	}
+
+	if int64(len(content)) > this.maxSize {
+		return nil, &PlaybookTooLargeError{MaxSize: this.maxSize}
+	}
+
+	return content, nil
+}
@@ -0,0 +1,155 @@
+package registry
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/common/utils"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/viper"
+)
+
+var _ = Describe("Registry", func() {
+	const content = "- name: ping\n  hosts: all\n  tasks:\n  - ping:\n"
+
+	var (
+		key      = ed25519.NewKeyFromSeed([]byte("playbook-dispatcher-test-signing"))
+		otherKey = ed25519.NewKeyFromSeed([]byte("playbook-dispatcher-other-signer"))
+	)
+
+	sign := func(key ed25519.PrivateKey, content string) string {
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(key, []byte(content)))
+	}
+
+	newRegistry := func(status int, body string) *Registry {
+		cfg := viper.New()
+		cfg.Set("playbook.registry.keys", "malformed, "+base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey)))
+		cfg.Set("playbook.max.size", 1024)
+		cfg.Set("outbound.allowed.hosts", "example.com")
+		return NewWithHttpRequestDoer(cfg, nil, utils.NewMockHttpRequestDoer(status, body, nil))
+	}
+
+	It("computes the SHA-256 digest of the content", func() {
+		Expect(Digest([]byte("hello"))).To(Equal("sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"))
+	})
+
+	It("ignores malformed keys", func() {
+		Expect(ParseKeys("malformed, " + base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey)))).To(HaveLen(1))
+		Expect(ParseKeys("")).To(BeEmpty())
+	})
+
+	Describe("Verify", func() {
+		It("accepts a Playbook signed by a trusted key", func() {
+			Expect(newRegistry(200, "").Verify([]byte(content), sign(key, content))).To(Succeed())
+		})
+
+		It("rejects a Playbook signed by an unknown key", func() {
+			err := newRegistry(200, "").Verify([]byte(content), sign(otherKey, content))
+			Expect(err).To(Equal(&InvalidSignatureError{Digest: Digest([]byte(content))}))
+		})
+
+		It("rejects a signature of different content", func() {
+			err := newRegistry(200, "").Verify([]byte(content), sign(key, content+"\n"))
+			Expect(err).To(BeAssignableToTypeOf(&InvalidSignatureError{}))
+		})
+
+		It("rejects a signature that is not base64-encoded", func() {
+			err := newRegistry(200, "").Verify([]byte(content), "not base64!")
+			Expect(err).To(BeAssignableToTypeOf(&InvalidSignatureError{}))
+		})
+	})
+
+	Describe("Resolve", func() {
+		It("rejects a Playbook that does not match the referenced digest", func() {
+			digest := Digest([]byte("something else"))
+
+			_, err := newRegistry(200, "").Resolve(context.Background(), "5318290", []byte(content), &digest, nil)
+			Expect(err).To(Equal(&DigestMismatchError{Expected: digest, Actual: Digest([]byte(content))}))
+		})
+
+		It("rejects an unsigned Playbook", func() {
+			_, err := newRegistry(200, "").Resolve(context.Background(), "5318290", []byte(content), nil, nil)
+			Expect(err).To(BeAssignableToTypeOf(&InvalidSignatureError{}))
+		})
+
+		It("rejects a Playbook signed by an unknown key", func() {
+			_, err := newRegistry(200, "").Resolve(context.Background(), "5318290", []byte(content), nil, utils.StringRef(sign(otherKey, content)))
+			Expect(err).To(BeAssignableToTypeOf(&InvalidSignatureError{}))
+		})
+
+	})
+
+	Describe("Fetch", func() {
+		It("returns the content of the Playbook", func() {
+			result, err := newRegistry(200, content).Fetch(context.Background(), "http://example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(result)).To(Equal(content))
+		})
+
+		It("fails if the Playbook cannot be fetched", func() {
+			_, err := newRegistry(404, "").Fetch(context.Background(), "http://example.com")
+			Expect(err).To(Equal(&FetchError{URL: "http://example.com", Reason: "unexpected status code 404"}))
+		})
+
+		It("does not fetch a Playbook from a host that is not allowed", func() {
+			_, err := newRegistry(200, content).Fetch(context.Background(), "http://169.254.169.254/latest/meta-data")
+			Expect(err).To(Equal(&URLNotAllowedError{URL: "http://169.254.169.254/latest/meta-data", Reason: "Host not allowed: 169.254.169.254"}))
+		})
+
+		It("fails if the Playbook is too large", func() {
+			_, err := newRegistry(200, strings.Repeat("#", 1025)).Fetch(context.Background(), "http://example.com")
+			Expect(err).To(Equal(&PlaybookTooLargeError{MaxSize: 1024}))
+		})
+	})
+
+	It("rejects uploads larger than the maximum size", func() {
+		large := strings.Repeat("#", 1025)
+
+		_, err := newRegistry(200, "").Upload(context.Background(), "5318290", []byte(large), sign(key, large))
+		Expect(err).To(Equal(&PlaybookTooLargeError{MaxSize: 1024}))
+	})
+
+	It("tells verification failures from other errors", func() {
+		Expect(IsRejected(&InvalidSignatureError{})).To(BeTrue())
+		Expect(IsRejected(&DigestMismatchError{})).To(BeTrue())
+		Expect(IsRejected(&PlaybookNotFoundError{})).To(BeTrue())
+		Expect(IsRejected(&PlaybookTooLargeError{})).To(BeTrue())
+		Expect(IsRejected(&URLNotAllowedError{})).To(BeTrue())
+		Expect(IsRejected(&FetchError{})).To(BeTrue())
+		Expect(IsRejected(fmt.Errorf("connection refused"))).To(BeFalse())
+		Expect(IsRejected(nil)).To(BeFalse())
+	})
+
+	It("does not follow a redirect to a host that is not allowed", func() {
+		server := httptest.NewServer(http.RedirectHandler("http://169.254.169.254/latest/meta-data", http.StatusFound))
+		defer server.Close()
+
+		cfg := viper.New()
+		cfg.Set("outbound.allowed.hosts", "127.0.0.1")
+		cfg.Set("playbook.fetch.timeout", 5)
+		cfg.Set("playbook.max.size", 1024)
+
+		_, err := New(cfg, nil).Fetch(context.Background(), server.URL+"/playbook.yml")
+		Expect(err).To(Equal(&URLNotAllowedError{URL: "http://169.254.169.254/latest/meta-data", Reason: "Host not allowed: 169.254.169.254"}))
+	})
+
+	It("sends the request to the URL of the Playbook", func() {
+		var requested string
+		cfg := viper.New()
+		cfg.Set("outbound.allowed.hosts", "example.com")
+		registry := NewWithHttpRequestDoer(cfg, nil, utils.NewMockHttpRequestDoerWithCallback(func(req *http.Request) (int, string, error) {
+			requested = req.URL.String()
+			return 500, "", nil
+		}))
+
+		_, err := registry.Fetch(context.Background(), "http://example.com/playbook.yml")
+		Expect(err).To(BeAssignableToTypeOf(&FetchError{}))
+		Expect(requested).To(Equal("http://example.com/playbook.yml"))
+	})
+})
@@ -0,0 +1,79 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RetryCondition matches finished runs that qualify for another attempt according to their retry policy and have not been retried yet.
+// A Satellite run only qualifies if any of its hosts finished with one of the statuses of the retry policy.
+const RetryCondition = `(
+	runs.retry_policy IS NOT NULL
+	AND runs.attempt < (runs.retry_policy->>'max_attempts')::int
+	AND runs.retry_policy->'statuses' @> to_jsonb(runs.status::text)
+	AND NOT EXISTS (SELECT 1 FROM runs AS retry WHERE retry.parent_run_id = runs.id)
+	AND (runs.sat_id IS NULL OR EXISTS (
+		SELECT 1 FROM run_hosts AS host
+		WHERE host.run_id = runs.id AND runs.retry_policy->'statuses' @> to_jsonb(host.status::text)
+	))
+)`
+
+// ProcessRetry creates the next attempt of a finished run using the same recipient and Playbook.
+// The attempt is linked to the run using parent_run_id and is dispatched by the scheduler once the backoff elapses.
+// A retry of a Satellite run only targets the hosts that finished with one of the statuses of the retry policy.
+// Returns RunAlreadyRetriedError if the next attempt has been created in the meantime.
+func (dm *dispatchManager) ProcessRetry(ctx context.Context, run db.Run) (runID uuid.UUID, err error) {
+	query := dm.db.WithContext(ctx).Where("run_id = ?", run.ID)
+	if run.SatId != nil {
+		query = query.Where("status IN ?", run.RetryPolicy.Statuses)
+	}
+
+	var runHosts []db.RunHost
+	if dbResult := query.Find(&runHosts); dbResult.Error != nil {
+		return uuid.UUID{}, dbResult.Error
+	}
+
+	runID, _, err = dm.ProcessRun(ctx, run.OrgID, run.Service, newRetryRunInput(run, runHosts))
+	if err != nil && isUniqueViolation(err) {
+		return uuid.UUID{}, &RunAlreadyRetriedError{runID: run.ID}
+	}
+
+	return runID, err
+}
+
+// the backoff doubles with each attempt
+func retryDueAt(run db.Run) time.Time {
+	backoff := time.Duration(run.RetryPolicy.Backoff) * time.Second << (run.Attempt - 1)
+	return run.UpdatedAt.Add(backoff)
+}
+
+func newRetryRunInput(run db.Run, runHosts []db.RunHost) generic.RunInput {
+	input := newRunInput(run, runHosts)
+
+	input.NotBefore = utils.TimeRef(retryDueAt(run))
+	input.NotAfter = nil
+	input.ConcurrencyPolicy = run.ConcurrencyPolicy
+	input.ParentRunId = &run.ID
+	input.GroupId = run.GroupID
+	input.RolloutWave = run.RolloutWave
+	input.Attempt = utils.IntRef(run.Attempt + 1)
+	input.RetryPolicy = &generic.RunRetryPolicyInput{
+		MaxAttempts: run.RetryPolicy.MaxAttempts,
+		Backoff:     &run.RetryPolicy.Backoff,
+		Statuses:    run.RetryPolicy.Statuses,
+	}
+
+	if run.CallbackUrl != nil && run.CallbackSecretRef != nil {
+		input.Callback = &generic.RunCallbackInput{
+			Url:       *run.CallbackUrl,
+			SecretRef: *run.CallbackSecretRef,
+		}
+	}
+
+	return input
+}
@@ -0,0 +1,168 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RolloutCondition matches rollouts that are in progress and not pausing between waves
+const RolloutCondition = `rollouts.status = 'in_progress' AND (rollouts.next_wave_at IS NULL OR rollouts.next_wave_at <= NOW())`
+
+// a run that exceeded its timeout counts as failed even though its status has not been updated yet
+const rolloutRunStatusSql = `CASE WHEN runs.status='running' AND COALESCE(runs.dispatched_at, runs.created_at) + runs.timeout * interval '1 second' <= NOW() THEN 'timeout' ELSE runs.status::text END`
+
+// only the latest attempt of a retried run counts and a run that is about to be retried does not count as failed yet
+const rolloutProgressSql = `
+	COUNT(*) AS total,
+	COUNT(*) FILTER (WHERE ` + rolloutRunStatusSql + ` = 'success') AS success,
+	COUNT(*) FILTER (WHERE ` + rolloutRunStatusSql + ` NOT IN ('success', 'running', 'scheduled') AND NOT ` + RetryCondition + `) AS failure`
+
+type rolloutProgress struct {
+	Total   int
+	Success int
+	Failure int
+}
+
+// ProcessRollout moves a rollout forward based on the outcome of the runs of the waves released so far.
+// Runs of the canary wave that were rejected when dispatched count as failed.
+// The rollout halts, canceling the runs of the remaining waves, once more than failure_threshold percent of these runs failed
+// or success_threshold can no longer be reached. Once success_threshold percent of these runs succeeded the pause starts,
+// after which the next wave is released. The rollout completes once the last wave is released.
+// Returns the rollout as updated, which is unchanged if the rollout is waiting for runs to finish or another replica moved it in the meantime.
+func (dm *dispatchManager) ProcessRollout(ctx context.Context, rollout db.Rollout) (db.Rollout, error) {
+	var progress rolloutProgress
+
+	dbResult := dm.db.WithContext(ctx).
+		Table("runs").
+		Select(rolloutProgressSql).
+		Where("runs.group_id = ?", rollout.GroupID).
+		Where("runs.rollout_wave <= ?", rollout.Wave).
+		Where("NOT EXISTS (SELECT 1 FROM runs AS retry WHERE retry.parent_run_id = runs.id)").
+		Scan(&progress)
+
+	if dbResult.Error != nil {
+		return rollout, dbResult.Error
+	}
+
+	progress.Total += rollout.Rejected
+	progress.Failure += rollout.Rejected
+
+	// there is nothing to judge the wave by
+	if progress.Total == 0 {
+		return rollout, nil
+	}
+
+	pending := progress.Total - progress.Success - progress.Failure
+
+	switch {
+	case progress.Failure*100 > rollout.FailureThreshold*progress.Total,
+		(progress.Success+pending)*100 < rollout.SuccessThreshold*progress.Total:
+		return dm.haltRollout(ctx, rollout)
+	case progress.Success*100 < rollout.SuccessThreshold*progress.Total:
+		return rollout, nil
+	case rollout.Pause > 0 && rollout.NextWaveAt == nil:
+		return dm.pauseRollout(ctx, rollout)
+	default:
+		return dm.releaseWave(ctx, rollout)
+	}
+}
+
+func (dm *dispatchManager) pauseRollout(ctx context.Context, rollout db.Rollout) (db.Rollout, error) {
+	nextWaveAt := time.Now().Add(time.Duration(rollout.Pause) * time.Second)
+
+	updated, err := updateRollout(dm.db.WithContext(ctx), rollout, map[string]interface{}{"next_wave_at": nextWaveAt})
+	if err != nil || !updated {
+		return rollout, err
+	}
+
+	rollout.NextWaveAt = &nextWaveAt
+	return rollout, nil
+}
+
+// releaseWave makes the held runs of the next wave due so that the scheduler dispatches them
+func (dm *dispatchManager) releaseWave(ctx context.Context, rollout db.Rollout) (db.Rollout, error) {
+	wave := rollout.Wave + 1
+	status := db.RolloutStatusInProgress
+	if wave == rollout.Waves-1 {
+		status = db.RolloutStatusCompleted
+	}
+
+	updated := false
+
+	err := dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		updated, err = updateRollout(tx, rollout, map[string]interface{}{"wave": wave, "status": status, "next_wave_at": nil})
+		if err != nil || !updated {
+			return err
+		}
+
+		return tx.Model(&db.Run{}).
+			Where("group_id = ?", rollout.GroupID).
+			Where("rollout_wave = ?", wave).
+			Where("status = ?", db.RunStatusScheduled).
+			Where("not_before IS NULL").
+			Update("not_before", gorm.Expr("NOW()")).Error
+	})
+
+	if err != nil || !updated {
+		return rollout, err
+	}
+
+	rollout.Wave = wave
+	rollout.Status = status
+	rollout.NextWaveAt = nil
+	return rollout, nil
+}
+
+// haltRollout cancels the held runs of the remaining waves
+func (dm *dispatchManager) haltRollout(ctx context.Context, rollout db.Rollout) (db.Rollout, error) {
+	updated := false
+
+	err := dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		updated, err = updateRollout(tx, rollout, map[string]interface{}{"status": db.RolloutStatusHalted})
+		if err != nil || !updated {
+			return err
+		}
+
+		var runs []db.Run
+		dbResult := tx.
+			Where("group_id = ?", rollout.GroupID).
+			Where("rollout_wave > ?", rollout.Wave).
+			Where("status = ?", db.RunStatusScheduled).
+			Find(&runs)
+
+		if dbResult.Error != nil {
+			return dbResult.Error
+		}
+
+		for _, run := range runs {
+			runCtx := utils.WithCorrelationId(ctx, run.CorrelationID.String())
+			if _, err := updateRunStatus(runCtx, tx, run, db.RunStatusScheduled, db.RunStatusCanceled); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil || !updated {
+		return rollout, err
+	}
+
+	rollout.Status = db.RolloutStatusHalted
+	return rollout, nil
+}
+
+// updateRollout applies the given changes unless the rollout has moved on in the meantime
+func updateRollout(tx *gorm.DB, rollout db.Rollout, updates map[string]interface{}) (bool, error) {
+	result := tx.Model(&db.Rollout{}).
+		Where("group_id = ?", rollout.GroupID).
+		Where("status = ?", db.RolloutStatusInProgress).
+		Where("wave = ?", rollout.Wave).
+		Updates(updates)
+
+	return result.RowsAffected > 0, result.Error
+}
@@ -0,0 +1,194 @@
+package dispatch
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/instrumentation"
+	"playbook-dispatcher/internal/common/callback"
+	"playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/notify"
+	"playbook-dispatcher/internal/common/outbox"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// retries are always stored first and dispatched by the scheduler once due
+func isScheduled(run generic.RunInput) bool {
+	return run.ParentRunId != nil || isHeld(run) || run.NotBefore != nil && run.NotBefore.After(time.Now())
+}
+
+// runs of a later rollout wave are held in the scheduled status without not_before until the wave is released
+func isHeld(run generic.RunInput) bool {
+	return run.RolloutWave != nil && *run.RolloutWave > 0 && run.NotBefore == nil
+}
+
+// ProcessScheduledRun moves a scheduled run that is due to the running status and sends its signal.
+// The signal is stored in the same transaction so that it is sent by the outbox relay should sending it right away fail.
+// A run whose recipient is not connected fails, same as a run that is not scheduled.
+// If another run is running on the recipient, a run using the queue concurrency policy stays scheduled
+// while a run using the reject concurrency policy fails. RecipientBusyError is returned in both cases.
+func (dm *dispatchManager) ProcessScheduledRun(ctx context.Context, run db.Run) error {
+	ctx = utils.WithCorrelationId(ctx, run.CorrelationID.String())
+
+	var runHosts []db.RunHost
+	if dbResult := dm.db.WithContext(ctx).Where("run_id = ?", run.ID).Find(&runHosts); dbResult.Error != nil {
+		return dbResult.Error
+	}
+
+	protocol := getRunProtocol(run)
+	runInput := newRunInput(run, runHosts)
+	signalMetadata := protocol.BuildMetaData(runInput, run.CorrelationID, dm.config)
+	signal := newOutboxSignal(run, protocol.GetDirective(), signalMetadata, dm.signalLease())
+
+	rejected := false
+
+	err := dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if hasConcurrencyGuard(run) {
+			busy, err := recipientBusy(tx, run, runHostInventoryIDs(runHosts))
+			if err != nil {
+				return err
+			}
+
+			if busy && *run.ConcurrencyPolicy == db.ConcurrencyPolicyReject {
+				rejected = true
+				return updateScheduledRun(ctx, tx, run, db.RunStatusFailure)
+			} else if busy {
+				return &RecipientBusyError{recipient: run.Recipient}
+			}
+		}
+
+		if err := updateScheduledRun(ctx, tx, run, db.RunStatusRunning); err != nil {
+			return err
+		}
+
+		if dbResult := tx.Create(&signal); dbResult.Error != nil {
+			instrumentation.OutboxSignalCreateError(ctx, dbResult.Error, run.ID, protocol.GetLabel())
+			return dbResult.Error
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	} else if rejected {
+		return &RecipientBusyError{recipient: run.Recipient}
+	}
+
+	err = dm.sendSignal(ctx, signal)
+
+	if _, ok := err.(*RecipientNotFoundError); ok {
+		if failErr := dm.FailSignal(ctx, signal, err); failErr != nil {
+			return failErr
+		}
+
+		return err
+	} else if err != nil {
+		// the signal stays pending and is sent by the outbox relay once the lease expires
+		instrumentation.SignalDeferred(ctx, err, run.ID, signal.ID)
+	}
+
+	return nil
+}
+
+// ExpireScheduledRun moves a scheduled run that was not dispatched in time to the expired status
+func (dm *dispatchManager) ExpireScheduledRun(ctx context.Context, run db.Run) error {
+	return dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateScheduledRun(ctx, tx, run, db.RunStatusExpired)
+	})
+}
+
+func (dm *dispatchManager) cancelScheduledRun(ctx context.Context, run db.Run) error {
+	err := dm.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateScheduledRun(ctx, tx, run, db.RunStatusCanceled)
+	})
+
+	// the run was dispatched or expired in the meantime
+	if _, ok := err.(*RunNotScheduledError); ok {
+		return &RunCancelNotCancelableError{run.ID}
+	}
+
+	return err
+}
+
+// updateScheduledRun moves a scheduled run and its hosts to the given status.
+// Returns RunNotScheduledError if the run has left the scheduled status in the meantime.
+func updateScheduledRun(ctx context.Context, tx *gorm.DB, run db.Run, status string) error {
+	updated, err := updateRunStatus(ctx, tx, run, db.RunStatusScheduled, status)
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return &RunNotScheduledError{runID: run.ID}
+	}
+
+	return nil
+}
+
+// updateRunStatus moves a run and its hosts from one status to another and reports the change.
+// Signals of the run that have not been sent yet are abandoned once the run is no longer running.
+// Returns false if the run was not in the expected status.
+func updateRunStatus(ctx context.Context, tx *gorm.DB, run db.Run, from string, status string) (bool, error) {
+	updates := map[string]interface{}{"status": status}
+	if status == db.RunStatusRunning {
+		updates["dispatched_at"] = time.Now()
+	}
+
+	result := tx.Model(&db.Run{}).
+		Where("id = ?", run.ID).
+		Where("status = ?", from).
+		Updates(updates)
+
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+
+	var runHosts []db.RunHost
+
+	result = tx.Model(&runHosts).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "host"}, {Name: "inventory_id"}}}).
+		Where("run_id = ?", run.ID).
+		Where("status = ?", from).
+		Update("status", status)
+
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	changes := []notify.StatusChange{newStatusChange(run, notify.TypeRun, status)}
+	for _, runHost := range runHosts {
+		change := newStatusChange(run, notify.TypeRunHost, status)
+		change.Host = &runHost.Host
+		change.InventoryID = runHost.InventoryID
+		changes = append(changes, change)
+	}
+
+	if status != db.RunStatusRunning {
+		if _, err := outbox.Abandon(tx, status, run.ID); err != nil {
+			return false, err
+		}
+
+		if err := callback.Enqueue(ctx, tx, run.ID); err != nil {
+			return false, err
+		}
+	}
+
+	return true, notify.Publish(ctx, tx, changes...)
+}
+
+func newStatusChange(run db.Run, changeType string, status string) notify.StatusChange {
+	return notify.StatusChange{
+		Type:      changeType,
+		RunID:     run.ID,
+		OrgID:     run.OrgID,
+		Service:   run.Service,
+		Recipient: run.Recipient,
+		Labels:    run.Labels,
+		Status:    status,
+		Timestamp: time.Now(),
+	}
+}
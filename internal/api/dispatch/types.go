@@ -3,6 +3,7 @@ package dispatch
 import (
 	"context"
 	"fmt"
+	"playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/generic"
 
 	"github.com/google/uuid"
@@ -12,6 +13,12 @@ import (
 type DispatchManager interface {
 	ProcessRun(ctx context.Context, orgID string, service string, run generic.RunInput) (runID, correlationID uuid.UUID, err error)
 	ProcessCancel(ctx context.Context, orgID string, cancel generic.CancelInput) (runID, correlationID uuid.UUID, err error)
+	ProcessScheduledRun(ctx context.Context, run db.Run) error
+	ExpireScheduledRun(ctx context.Context, run db.Run) error
+	ProcessRetry(ctx context.Context, run db.Run) (runID uuid.UUID, err error)
+	ProcessRollout(ctx context.Context, rollout db.Rollout) (db.Rollout, error)
+	DeliverSignal(ctx context.Context, signal db.OutboxSignal) error
+	FailSignal(ctx context.Context, signal db.OutboxSignal, reason error) error
 }
 
 // Indicates that the recipient is not connected
@@ -38,6 +45,37 @@ type RunCancelNotCancelableError struct {
 	runID uuid.UUID
 }
 
+// Indicates that the run is no longer in the scheduled status
+type RunNotScheduledError struct {
+	runID uuid.UUID
+}
+
+// Indicates that the run is no longer in the running status
+type RunNotRunningError struct {
+	runID  uuid.UUID
+	status string
+}
+
+// Indicates that the sender of the signal stopped before recording whether it was sent
+type SignalNotConfirmedError struct {
+	signalID uuid.UUID
+}
+
+// Indicates that another run is running on the recipient
+type RecipientBusyError struct {
+	recipient uuid.UUID
+}
+
+// Indicates that the next attempt of the run exists already
+type RunAlreadyRetriedError struct {
+	runID uuid.UUID
+}
+
+// Indicates that the idempotency key has been used for a different Playbook run
+type IdempotencyKeyMismatchError struct {
+	key string
+}
+
 func (this *RecipientNotFoundError) Error() string {
 	return fmt.Sprintf("Recipient not found: %s", this.recipient)
 }
@@ -51,9 +89,33 @@ func (this *RunOrgIdMismatchError) Error() string {
 }
 
 func (this *RunCancelTypeError) Error() string {
-	return fmt.Sprintf("Run not of type RHC Satellite and cannot be canceled: %s", this.runID)
+	return fmt.Sprintf("Run is missing Satellite details and cannot be canceled: %s", this.runID)
 }
 
 func (this *RunCancelNotCancelableError) Error() string {
 	return fmt.Sprintf("Run has finished running and cannot be canceled: %s", this.runID)
 }
+
+func (this *RunNotScheduledError) Error() string {
+	return fmt.Sprintf("Run is no longer scheduled: %s", this.runID)
+}
+
+func (this *RunNotRunningError) Error() string {
+	return fmt.Sprintf("Run is no longer running (%s): %s", this.status, this.runID)
+}
+
+func (this *SignalNotConfirmedError) Error() string {
+	return fmt.Sprintf("Signal may not have been sent: %s", this.signalID)
+}
+
+func (this *RecipientBusyError) Error() string {
+	return fmt.Sprintf("Another run is running on the recipient: %s", this.recipient)
+}
+
+func (this *RunAlreadyRetriedError) Error() string {
+	return fmt.Sprintf("Run has been retried already: %s", this.runID)
+}
+
+func (this *IdempotencyKeyMismatchError) Error() string {
+	return fmt.Sprintf("Idempotency key %s has been used for a different Playbook run", this.key)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	api "playbook-dispatcher/internal/api/utils"
 	"playbook-dispatcher/internal/common/utils"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -19,10 +20,23 @@ const (
 	labelPlaybookRunCreate     = "playbook_run_create"
 	labelPlaybookRunHostCreate = "playbook_run_host_create"
 	labelPlaybookRunRead       = "playbook_run_read"
+	labelPlaybookRunDiscard    = "playbook_run_discard"
+	labelRunGroupCreate        = "run_group_create"
+	labelRolloutCreate         = "rollout_create"
+	labelOutboxSignalCreate    = "outbox_signal_create"
+	labelOutboxSignalUpdate    = "outbox_signal_update"
 	labelNoConnection          = "no_connection"
 	labelErrorGeneric          = "error"
 	labelTenantAnemic          = "anemic-tenant"
 	labelSatellite             = "satellite"
+	labelCallback              = "callback"
+	labelSchedule              = "schedule"
+	labelRollout               = "rollout"
+	labelPlaybook              = "playbook"
+	labelPlaybookCreate        = "playbook_create"
+	labelPreflight             = "preflight"
+	labelExtraVars             = "extra_vars"
+	labelConnectionStatus      = "connection_status"
 	LabelAnsibleRequest        = "ansible"
 	LabelSatRequest            = "satellite"
 	labelKesselPassed          = "ok"
@@ -78,6 +92,26 @@ var (
 		Help: "The total number of created playbook runs",
 	}, []string{"dispatching_service", "request", "api_version"})
 
+	runScheduledTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_run_scheduled_total",
+		Help: "The total number of created playbook runs whose dispatch was deferred",
+	}, []string{"dispatching_service", "request", "api_version"})
+
+	quotaExceededTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_quota_exceeded_total",
+		Help: "The total number of playbook runs rejected because the quota of the organization or the dispatching service was exceeded",
+	}, []string{"scope", "dispatching_service", "api_version"})
+
+	recipientBusyTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_recipient_busy_total",
+		Help: "The total number of playbook runs rejected or queued because another playbook run was running on the recipient",
+	}, []string{"policy", "dispatching_service"})
+
+	runReplayedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "api_run_replayed_total",
+		Help: "The total number of dispatch requests answered with a previously created playbook run based on the idempotency key",
+	}, []string{"dispatching_service", "api_version"})
+
 	runCanceledTotal = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "api_run_canceled_total",
 		Help: "The total number of canceled playbook runs",
@@ -99,6 +133,54 @@ func InvalidSatelliteRequest(ctx echo.Context, err error) {
 	validationFailureTotal.WithLabelValues(labelSatellite).Inc()
 }
 
+func InvalidCallbackRequest(ctx echo.Context, err error) {
+	utils.GetLogFromEcho(ctx).Errorw("Invalid callback request", "error", err)
+	validationFailureTotal.WithLabelValues(labelCallback).Inc()
+}
+
+func InvalidScheduleRequest(ctx echo.Context, err error) {
+	utils.GetLogFromEcho(ctx).Errorw("Invalid schedule request", "error", err)
+	validationFailureTotal.WithLabelValues(labelSchedule).Inc()
+}
+
+func InvalidRolloutRequest(ctx echo.Context, err error) {
+	utils.GetLogFromEcho(ctx).Errorw("Invalid rollout request", "error", err)
+	validationFailureTotal.WithLabelValues(labelRollout).Inc()
+}
+
+func InvalidPlaybookRequest(ctx echo.Context, err error) {
+	utils.GetLogFromEcho(ctx).Errorw("Invalid playbook request", "error", err)
+	validationFailureTotal.WithLabelValues(labelPlaybook).Inc()
+}
+
+func InvalidExtraVarsRequest(ctx echo.Context, err error) {
+	utils.GetLogFromEcho(ctx).Errorw("Invalid extra vars request", "error", err)
+	validationFailureTotal.WithLabelValues(labelExtraVars).Inc()
+}
+
+func PlaybookRejected(ctx context.Context, err error, orgId string, url string) {
+	utils.GetLogFromContext(ctx).Warnw("Rejecting playbook that cannot be verified", "error", err, "org_id", orgId, "url", url)
+	validationFailureTotal.WithLabelValues(labelPlaybook).Inc()
+}
+
+func PlaybookPreflightFailed(ctx context.Context, err error, orgId string, url string) {
+	utils.GetLogFromContext(ctx).Warnw("Rejecting playbook that failed the preflight", "error", err, "org_id", orgId, "url", url)
+	validationFailureTotal.WithLabelValues(labelPreflight).Inc()
+}
+
+func PlaybookVerified(ctx context.Context, orgId string, url string, digest string) {
+	utils.GetLogFromContext(ctx).Infow("Verified playbook", "org_id", orgId, "url", url, "digest", digest)
+}
+
+func PlaybookUploaded(ctx echo.Context, orgId string, digest string) {
+	utils.GetLogFromEcho(ctx).Infow("Uploaded playbook to the registry", "org_id", orgId, "digest", digest)
+}
+
+func PlaybookCreateError(ctx context.Context, err error, orgId string) {
+	utils.GetLogFromContext(ctx).Errorw("Error storing playbook in the registry", "error", err, "org_id", orgId)
+	errorTotal.WithLabelValues(labelDb, labelPlaybookCreate, labelErrorGeneric, api.GetApiVersion(ctx)).Inc()
+}
+
 func CloudConnectorRequestError(ctx context.Context, err error, recipient uuid.UUID, requestType string) {
 	utils.GetLogFromContext(ctx).Errorw("Error sending message to cloud connector", "error", err, "recipient", recipient)
 	connectorErrorTotal.WithLabelValues(labelErrorGeneric, requestType).Inc()
@@ -109,6 +191,11 @@ func CloudConnectorNoConnection(ctx context.Context, recipient uuid.UUID, reques
 	connectorErrorTotal.WithLabelValues(labelNoConnection, requestType).Inc()
 }
 
+func CloudConnectorStatusError(ctx context.Context, err error, orgId string, recipient string) {
+	utils.GetLogFromContext(ctx).Errorw("Error reading connection status from cloud connector", "error", err, "org_id", orgId, "recipient", recipient)
+	connectorErrorTotal.WithLabelValues(labelErrorGeneric, labelConnectionStatus).Inc()
+}
+
 func CloudConnectorOK(ctx context.Context, recipient uuid.UUID, messageId *string) {
 	utils.GetLogFromContext(ctx).Debugw("Received response from cloud connector", "recipient", recipient, "message_id", *messageId)
 	connectorSentTotal.Inc()
@@ -124,6 +211,35 @@ func PlaybookRunHostCreateError(ctx context.Context, err error, data []dbModel.R
 	errorTotal.WithLabelValues(labelDb, labelPlaybookRunHostCreate, requestType, api.GetApiVersion(ctx)).Inc()
 }
 
+func OutboxSignalCreateError(ctx context.Context, err error, runId uuid.UUID, requestType string) {
+	utils.GetLogFromContext(ctx).Errorw("Error creating outbox signal", "error", err, "run_id", runId.String())
+	errorTotal.WithLabelValues(labelDb, labelOutboxSignalCreate, requestType, api.GetApiVersion(ctx)).Inc()
+}
+
+func OutboxSignalUpdateError(ctx context.Context, err error, signalId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error updating the status of outbox signal", "error", err, "signal_id", signalId.String())
+	errorTotal.WithLabelValues(labelDb, labelOutboxSignalUpdate, labelErrorGeneric, api.GetApiVersion(ctx)).Inc()
+}
+
+func PlaybookRunDiscardError(ctx context.Context, err error, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error discarding run of a disconnected recipient", "error", err, "run_id", runId.String())
+	errorTotal.WithLabelValues(labelDb, labelPlaybookRunDiscard, labelErrorGeneric, api.GetApiVersion(ctx)).Inc()
+}
+
+func RunGroupCreateError(ctx context.Context, err error, orgId string) {
+	utils.GetLogFromContext(ctx).Errorw("Error creating run group", "error", err, "org_id", orgId)
+	errorTotal.WithLabelValues(labelDb, labelRunGroupCreate, labelErrorGeneric, api.GetApiVersion(ctx)).Inc()
+}
+
+func RolloutCreateError(ctx context.Context, err error, groupId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error creating rollout", "error", err, "group_id", groupId.String())
+	errorTotal.WithLabelValues(labelDb, labelRolloutCreate, labelErrorGeneric, api.GetApiVersion(ctx)).Inc()
+}
+
+func SignalDeferred(ctx context.Context, err error, runId uuid.UUID, signalId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Warnw("Signal could not be sent, deferring to the outbox relay", "error", err, "run_id", runId.String(), "signal_id", signalId.String())
+}
+
 func PlaybookApiRequestError(ctx echo.Context, err error) {
 	utils.GetLogFromEcho(ctx).Errorw("Unable to process api request", "error", err)
 }
@@ -134,7 +250,7 @@ func PlaybookRunCancelError(ctx context.Context, err error) {
 }
 
 func PlaybookRunCancelRunTypeError(ctx context.Context, runId uuid.UUID) {
-	utils.GetLogFromContext(ctx).Errorw("Attempting to cancel run not of type Satellite RHC")
+	utils.GetLogFromContext(ctx).Errorw("Attempting to cancel Satellite run with missing Satellite details", "run_id", runId.String())
 	runCanceledErrorTotal.Inc()
 }
 
@@ -180,6 +296,36 @@ func RunCreated(ctx context.Context, recipient uuid.UUID, runId uuid.UUID, paylo
 	runCreatedTotal.WithLabelValues(service, requestType, api.GetApiVersion(ctx)).Inc()
 }
 
+func RunScheduled(ctx context.Context, recipient uuid.UUID, runId uuid.UUID, payload string, service string, requestType string, notBefore time.Time) {
+	utils.GetLogFromContext(ctx).Infow("Scheduled new playbook run", "recipient", recipient.String(), "run_id", runId.String(), "payload", string(payload), "service", service, "not_before", notBefore)
+	runScheduledTotal.WithLabelValues(service, requestType, api.GetApiVersion(ctx)).Inc()
+}
+
+func RunHeld(ctx context.Context, recipient uuid.UUID, runId uuid.UUID, payload string, service string, requestType string, wave int) {
+	utils.GetLogFromContext(ctx).Infow("Holding new playbook run until its rollout wave is released", "recipient", recipient.String(), "run_id", runId.String(), "payload", string(payload), "service", service, "wave", wave)
+	runScheduledTotal.WithLabelValues(service, requestType, api.GetApiVersion(ctx)).Inc()
+}
+
+func QuotaExceeded(ctx context.Context, scope string, orgId string, service string) {
+	utils.GetLogFromContext(ctx).Warnw("Rejecting playbook run over quota", "scope", scope, "org_id", orgId, "service", service)
+	quotaExceededTotal.WithLabelValues(scope, service, api.GetApiVersion(ctx)).Inc()
+}
+
+func RunQueued(ctx context.Context, recipient uuid.UUID, runId uuid.UUID, service string) {
+	utils.GetLogFromContext(ctx).Infow("Queued new playbook run as the recipient is busy", "recipient", recipient.String(), "run_id", runId.String(), "service", service)
+	recipientBusyTotal.WithLabelValues(dbModel.ConcurrencyPolicyQueue, service).Inc()
+}
+
+func RunRejectedRecipientBusy(ctx context.Context, recipient uuid.UUID, service string) {
+	utils.GetLogFromContext(ctx).Infow("Rejecting playbook run as the recipient is busy", "recipient", recipient.String(), "service", service)
+	recipientBusyTotal.WithLabelValues(dbModel.ConcurrencyPolicyReject, service).Inc()
+}
+
+func RunReplayed(ctx context.Context, runId uuid.UUID, service string, idempotencyKey string) {
+	utils.GetLogFromContext(ctx).Infow("Returning existing playbook run for repeated dispatch request", "run_id", runId.String(), "service", service, "idempotency_key", idempotencyKey)
+	runReplayedTotal.WithLabelValues(service, api.GetApiVersion(ctx)).Inc()
+}
+
 func RunCanceled(ctx context.Context, runId uuid.UUID) {
 	utils.GetLogFromContext(ctx).Infow("Successfully initiated playbook run cancelation", "run_id", runId.String())
 	runCanceledTotal.Inc()
@@ -190,6 +336,12 @@ func Start() {
 	// https://www.robustperception.io/existential-issues-with-metrics
 	validationFailureTotal.WithLabelValues(labelTenantAnemic)
 	validationFailureTotal.WithLabelValues(labelSatellite)
+	validationFailureTotal.WithLabelValues(labelCallback)
+	validationFailureTotal.WithLabelValues(labelSchedule)
+	validationFailureTotal.WithLabelValues(labelRollout)
+	validationFailureTotal.WithLabelValues(labelPlaybook)
+	validationFailureTotal.WithLabelValues(labelPreflight)
+	validationFailureTotal.WithLabelValues(labelExtraVars)
 
 	errorTotal.WithLabelValues(labelDb, labelPlaybookRunCreate, LabelAnsibleRequest, api.V1.String())
 	errorTotal.WithLabelValues(labelDb, labelPlaybookRunHostCreate, LabelAnsibleRequest, api.V1.String())
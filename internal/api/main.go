@@ -14,6 +14,7 @@ import (
 	"playbook-dispatcher/internal/api/rbac"
 	"playbook-dispatcher/internal/common/constants"
 	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/notify"
 	"playbook-dispatcher/internal/common/utils"
 	"sync"
 	"time"
@@ -51,6 +52,8 @@ func Start(
 	db, sql := db.Connect(ctx, cfg)
 
 	ready.Register(sql.Ping)
+	// stop taking traffic while a service the API depends on is failing
+	ready.Register(utils.CheckCircuitBreakers)
 	live.Register(sql.Ping)
 
 	publicSpec, err := public.GetSwagger()
@@ -86,6 +89,8 @@ func Start(
 		log.Warn("Using mock CloudConnectorClient")
 	}
 
+	cloudConnectorClient = connectors.NewConnectorClientWithCache(cfg, cloudConnectorClient)
+
 	var inventoryConnectorClient inventory.InventoryConnector
 
 	if cfg.GetString("inventory.connector.impl") == "impl" {
@@ -135,9 +140,16 @@ func Start(
 	internal.POST("/dispatch", privateController.ApiInternalRunsCreate)
 	internal.POST("/v2/recipients/status", privateController.ApiInternalV2RecipientsStatus)
 	internal.POST("/v2/dispatch", privateController.ApiInternalV2RunsCreate)
+	internal.POST("/v2/dispatch/rollout", privateController.ApiInternalV2RunsCreateRollout)
 	internal.POST("/v2/cancel", privateController.ApiInternalV2RunsCancel)
+	internal.POST("/v2/cancel/filter", privateController.ApiInternalV2RunsCancelFilter)
+	internal.POST("/v2/run_groups/cancel", privateController.ApiInternalV2RunGroupsCancel)
+	internal.POST("/v2/playbooks", privateController.ApiInternalV2PlaybooksUpload)
+
+	statusChanges := notify.NewListener(sql, time.Duration(cfg.GetInt("run.events.listener.retry.interval"))*time.Second)
+	go statusChanges.Start(ctx)
 
-	publicController := public.CreateController(db, cloudConnectorClient)
+	publicController := public.CreateController(db, cloudConnectorClient, statusChanges, cfg)
 	public := server.Group("/api/playbook-dispatcher")
 	public.Use(echo.WrapMiddleware(identity.EnforceIdentity))
 	public.Use(echo.WrapMiddleware(middleware.EnforceIdentityType))
@@ -150,8 +162,14 @@ func Start(
 	public.Use(middleware.ExtractHeaders(constants.HeaderIdentity))
 	public.Use(middleware.EnforcePermissions(cfg, rbac.DispatcherPermission("run", "read")))
 
+	public.GET("/v1/run_events", publicController.ApiRunEventsStream)
+	public.GET("/v1/run_groups", publicController.ApiRunGroupsList)
+	public.GET("/v1/run_groups/:id", publicController.ApiRunGroupGet)
 	public.GET("/v1/run_hosts", publicController.ApiRunHostsList)
 	public.GET("/v1/runs", publicController.ApiRunsList)
+	public.GET("/v1/runs/:id", publicController.ApiRunGet)
+	public.GET("/v1/runs/:id/diffs", publicController.ApiRunDiffsList)
+	public.GET("/v1/runs/:id/task_results", publicController.ApiRunTaskResultsList)
 
 	wg.Add(1)
 	go func() {
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	externalRef0 "playbook-dispatcher/internal/api/controllers/public"
 
@@ -19,6 +20,51 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ConcurrencyPolicy.
+const (
+	Allow  ConcurrencyPolicy = "allow"
+	Queue  ConcurrencyPolicy = "queue"
+	Reject ConcurrencyPolicy = "reject"
+)
+
+// Valid indicates whether the value is a known member of the ConcurrencyPolicy enum.
+func (e ConcurrencyPolicy) Valid() bool {
+	switch e {
+	case Allow:
+		return true
+	case Queue:
+		return true
+	case Reject:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ConnectionStatus.
+const (
+	Connected        ConnectionStatus = "connected"
+	Disconnected     ConnectionStatus = "disconnected"
+	RhcNotConfigured ConnectionStatus = "rhc_not_configured"
+	Unknown          ConnectionStatus = "unknown"
+)
+
+// Valid indicates whether the value is a known member of the ConnectionStatus enum.
+func (e ConnectionStatus) Valid() bool {
+	switch e {
+	case Connected:
+		return true
+	case Disconnected:
+		return true
+	case RhcNotConfigured:
+		return true
+	case Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for RecipientType.
 const (
 	DirectConnect RecipientType = "directConnect"
@@ -40,21 +86,21 @@ func (e RecipientType) Valid() bool {
 	}
 }
 
-// Defines values for RecipientWithConnectionInfoStatus.
+// Defines values for RetryPolicyStatuses.
 const (
-	Connected        RecipientWithConnectionInfoStatus = "connected"
-	Disconnected     RecipientWithConnectionInfoStatus = "disconnected"
-	RhcNotConfigured RecipientWithConnectionInfoStatus = "rhc_not_configured"
+	Failure     RetryPolicyStatuses = "failure"
+	Timeout     RetryPolicyStatuses = "timeout"
+	Unreachable RetryPolicyStatuses = "unreachable"
 )
 
-// Valid indicates whether the value is a known member of the RecipientWithConnectionInfoStatus enum.
-func (e RecipientWithConnectionInfoStatus) Valid() bool {
+// Valid indicates whether the value is a known member of the RetryPolicyStatuses enum.
+func (e RetryPolicyStatuses) Valid() bool {
 	switch e {
-	case Connected:
+	case Failure:
 		return true
-	case Disconnected:
+	case Timeout:
 		return true
-	case RhcNotConfigured:
+	case Unreachable:
 		return true
 	default:
 		return false
@@ -67,6 +113,7 @@ const (
 	InventoryId ApiInternalV2RunHostsListParamsFieldsData = "inventory_id"
 	Links       ApiInternalV2RunHostsListParamsFieldsData = "links"
 	Run         ApiInternalV2RunHostsListParamsFieldsData = "run"
+	Stats       ApiInternalV2RunHostsListParamsFieldsData = "stats"
 	Status      ApiInternalV2RunHostsListParamsFieldsData = "status"
 	Stdout      ApiInternalV2RunHostsListParamsFieldsData = "stdout"
 )
@@ -82,6 +129,8 @@ func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 		return true
 	case Run:
 		return true
+	case Stats:
+		return true
 	case Status:
 		return true
 	case Stdout:
@@ -91,6 +140,27 @@ func (e ApiInternalV2RunHostsListParamsFieldsData) Valid() bool {
 	}
 }
 
+// CancelFilter Selects the running and scheduled Playbook runs to be canceled
+type CancelFilter struct {
+	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
+	Labels *externalRef0.Labels `json:"labels,omitempty"`
+
+	// Service Service that triggered the given Playbook run
+	Service externalRef0.Service `json:"service"`
+}
+
+// CancelFilterInputV2 defines model for CancelFilterInputV2.
+type CancelFilterInputV2 struct {
+	// Filter Selects the running and scheduled Playbook runs to be canceled
+	Filter CancelFilter `json:"filter"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
+
 // CancelInputV2 defines model for CancelInputV2.
 type CancelInputV2 struct {
 	// OrgId Identifies the organization that the given resource belongs to
@@ -103,12 +173,29 @@ type CancelInputV2 struct {
 	RunId externalRef0.RunId `json:"run_id"`
 }
 
+// ConcurrencyPolicy Defines what happens if another Playbook run is running on the recipient (or on any of the hosts of a Satellite Playbook run) at the time of dispatch.
+// allow - the Playbook run is dispatched regardless (default)
+// reject - the Playbook run is not created
+// queue - the Playbook run is kept in the scheduled status until the running Playbook runs finish
+type ConcurrencyPolicy string
+
+// ConnectionStatus Indicates the current connection status of the recipient.
+// unknown - the connection status could not be determined
+type ConnectionStatus string
+
 // Error defines model for Error.
 type Error struct {
 	// Message Human readable error message
 	Message string `json:"message"`
 }
 
+// ExtraVars Optional variables passed to the Playbook as extra vars.
+// Allows for a single Playbook to be parameterized instead of rendering a Playbook for every variation.
+type ExtraVars map[string]interface{}
+
+// ExtraVarsSchema Optional JSON schema that extra_vars are validated against
+type ExtraVarsSchema map[string]interface{}
+
 // HighLevelRecipientStatus defines model for HighLevelRecipientStatus.
 type HighLevelRecipientStatus = []RecipientWithConnectionInfo
 
@@ -123,9 +210,49 @@ type HostsWithOrgId struct {
 	OrgId OrgId `json:"org_id"`
 }
 
+// IdempotencyKey Optional key that makes the dispatch of a Playbook run safe to retry.
+// A repeated dispatch with the same key by the same service within the idempotency window returns the original Playbook run instead of dispatching it again.
+type IdempotencyKey = string
+
+// NotAfter Optional timestamp after which a scheduled Playbook run that has not been dispatched yet expires.
+// Can only be used together with not_before.
+type NotAfter = time.Time
+
+// NotBefore Optional timestamp before which the Playbook run is not dispatched.
+// Until then the Playbook run is kept in the scheduled status.
+type NotBefore = time.Time
+
 // OrgId Identifies the organization that the given resource belongs to
 type OrgId = string
 
+// PlaybookDigest SHA-256 digest of the content of a Playbook stored in the playbook registry
+type PlaybookDigest = string
+
+// PlaybookSignature Base64-encoded detached Ed25519 signature of the content of a Playbook
+type PlaybookSignature = string
+
+// PlaybookUploadInputV2 defines model for PlaybookUploadInputV2.
+type PlaybookUploadInputV2 struct {
+	// Content Content of the Playbook
+	Content string `json:"content"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Signature Base64-encoded detached Ed25519 signature of the content of a Playbook
+	Signature PlaybookSignature `json:"signature"`
+}
+
+// PlaybookUploaded defines model for PlaybookUploaded.
+type PlaybookUploaded struct {
+	// Digest SHA-256 digest of the content of a Playbook stored in the playbook registry
+	Digest PlaybookDigest `json:"digest"`
+}
+
+// Preflight If set, the Playbook is fetched from url and checked before the Playbook run is dispatched.
+// A Playbook that is not a list of plays defining hosts, tasks or roles and the insights_signature vars is rejected.
+type Preflight = bool
+
 // Principal Username of the user interacting with the service
 type Principal = string
 
@@ -148,6 +275,10 @@ type RecipientStatus struct {
 
 	// Recipient Identifier of the host to which a given Playbook is addressed
 	Recipient externalRef0.RunRecipient `json:"recipient"`
+
+	// Status Indicates the current connection status of the recipient.
+	// unknown - the connection status could not be determined
+	Status ConnectionStatus `json:"status"`
 }
 
 // RecipientType Identifies the type of recipient [Satellite, Direct Connected, None]
@@ -170,14 +301,12 @@ type RecipientWithConnectionInfo struct {
 	// SatOrgId Identifier of the organization within Satellite
 	SatOrgId SatelliteOrgId `json:"sat_org_id"`
 
-	// Status Indicates the current run status of the recipient
-	Status  RecipientWithConnectionInfoStatus `json:"status"`
-	Systems []HostId                          `json:"systems"`
+	// Status Indicates the current connection status of the recipient.
+	// unknown - the connection status could not be determined
+	Status  ConnectionStatus `json:"status"`
+	Systems []HostId         `json:"systems"`
 }
 
-// RecipientWithConnectionInfoStatus Indicates the current run status of the recipient
-type RecipientWithConnectionInfoStatus string
-
 // RecipientWithOrg defines model for RecipientWithOrg.
 type RecipientWithOrg struct {
 	// OrgId Identifies the organization that the given resource belongs to
@@ -187,6 +316,71 @@ type RecipientWithOrg struct {
 	Recipient externalRef0.RunRecipient `json:"recipient"`
 }
 
+// RetryPolicy Optional policy for retrying the Playbook run automatically once it finishes with one of the given statuses.
+// Each retry is a new Playbook run dispatched to the same recipient and linked to the previous attempt using parent_run_id.
+// A retry of a Satellite Playbook run only targets the hosts that finished with one of the given statuses.
+type RetryPolicy struct {
+	// Backoff Number of seconds to wait before the first retry. The delay doubles with each subsequent retry.
+	Backoff *int `json:"backoff,omitempty"`
+
+	// MaxAttempts Maximum number of attempts, including the initial Playbook run
+	MaxAttempts int `json:"max_attempts"`
+
+	// Statuses Statuses of a finished Playbook run that qualify for a retry
+	Statuses []RetryPolicyStatuses `json:"statuses"`
+}
+
+// RetryPolicyStatuses defines model for RetryPolicy.Statuses.
+type RetryPolicyStatuses string
+
+// RolloutInputV2 defines model for RolloutInputV2.
+type RolloutInputV2 struct {
+	Runs []RunInputV2 `json:"runs"`
+
+	// Strategy Defines the waves the Playbook runs of a rollout are dispatched in.
+	// The first wave (canary) consists of the first canary (or canary_percentage percent) Playbook runs of the request, each following wave of the next wave_size Playbook runs.
+	// The next wave is dispatched once success_threshold percent of the Playbook runs dispatched so far succeeded and the pause elapsed.
+	// The rollout halts, canceling the Playbook runs that have not been dispatched yet, once more than failure_threshold percent of the Playbook runs dispatched so far failed.
+	// A Playbook run that is going to be retried according to its retry policy does not count as failed.
+	Strategy RolloutStrategy `json:"strategy"`
+}
+
+// RolloutStrategy Defines the waves the Playbook runs of a rollout are dispatched in.
+// The first wave (canary) consists of the first canary (or canary_percentage percent) Playbook runs of the request, each following wave of the next wave_size Playbook runs.
+// The next wave is dispatched once success_threshold percent of the Playbook runs dispatched so far succeeded and the pause elapsed.
+// The rollout halts, canceling the Playbook runs that have not been dispatched yet, once more than failure_threshold percent of the Playbook runs dispatched so far failed.
+// A Playbook run that is going to be retried according to its retry policy does not count as failed.
+type RolloutStrategy struct {
+	// Canary Number of Playbook runs in the canary wave. Cannot be used together with canary_percentage.
+	Canary *int `json:"canary,omitempty"`
+
+	// CanaryPercentage Percentage of Playbook runs in the canary wave, rounded up. Cannot be used together with canary.
+	CanaryPercentage *int `json:"canary_percentage,omitempty"`
+
+	// FailureThreshold Percentage of the Playbook runs dispatched so far that may fail before the rollout halts
+	FailureThreshold *int `json:"failure_threshold,omitempty"`
+
+	// Pause Number of seconds to wait between waves
+	Pause *int `json:"pause,omitempty"`
+
+	// SuccessThreshold Percentage of the Playbook runs dispatched so far that need to succeed before the next wave is dispatched
+	SuccessThreshold *int `json:"success_threshold,omitempty"`
+
+	// WaveSize Number of Playbook runs in each wave following the canary wave
+	WaveSize int `json:"wave_size"`
+}
+
+// RunCallback Optional callback invoked once the Playbook run reaches a final status.
+// The payload is signed using HMAC-SHA256 with the pre-shared key identified by secret_ref.
+// The url needs to use https and its host needs to be on the allowlist of the dispatcher.
+type RunCallback struct {
+	// SecretRef Name of the pre-shared key used to sign the callback payload. Needs to match the PSK principal of the caller.
+	SecretRef string `json:"secret_ref"`
+
+	// Url URL the callback is sent to
+	Url string `json:"url"`
+}
+
 // RunCanceled defines model for RunCanceled.
 type RunCanceled struct {
 	// Code status code of the request
@@ -201,6 +395,9 @@ type RunCreated struct {
 	// Code status code of the request
 	Code int `json:"code"`
 
+	// GroupId Unique identifier of a run group
+	GroupId *externalRef0.RunGroupId `json:"group_id,omitempty"`
+
 	// Id Unique identifier of a Playbook run
 	Id *externalRef0.RunId `json:"id,omitempty"`
 
@@ -208,6 +405,18 @@ type RunCreated struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// RunGroupCancelInputV2 defines model for RunGroupCancelInputV2.
+type RunGroupCancelInputV2 struct {
+	// GroupId Unique identifier of a run group
+	GroupId externalRef0.RunGroupId `json:"group_id"`
+
+	// OrgId Identifies the organization that the given resource belongs to
+	OrgId OrgId `json:"org_id"`
+
+	// Principal Username of the user interacting with the service
+	Principal Principal `json:"principal"`
+}
+
 // RunInput defines model for RunInput.
 type RunInput struct {
 	// Account Identifier of the tenant
@@ -249,20 +458,62 @@ type RunInputHosts = []struct {
 
 // RunInputV2 defines model for RunInputV2.
 type RunInputV2 struct {
+	// Callback Optional callback invoked once the Playbook run reaches a final status.
+	// The payload is signed using HMAC-SHA256 with the pre-shared key identified by secret_ref.
+	Callback *RunCallback `json:"callback,omitempty"`
+
+	// ConcurrencyPolicy Defines what happens if another Playbook run is running on the recipient (or on any of the hosts of a Satellite Playbook run) at the time of dispatch.
+	// allow - the Playbook run is dispatched regardless (default)
+	// reject - the Playbook run is not created
+	// queue - the Playbook run is kept in the scheduled status until the running Playbook runs finish
+	ConcurrencyPolicy *ConcurrencyPolicy `json:"concurrency_policy,omitempty"`
+
+	// ExtraVars Optional variables passed to the Playbook as extra vars.
+	// Allows for a single Playbook to be parameterized instead of rendering a Playbook for every variation.
+	ExtraVars *ExtraVars `json:"extra_vars,omitempty"`
+
+	// ExtraVarsSchema Optional JSON schema that extra_vars are validated against
+	ExtraVarsSchema *ExtraVarsSchema `json:"extra_vars_schema,omitempty"`
+
 	// Hosts Optionally, information about hosts involved in the Playbook run can be provided.
 	// This information is used to pre-allocate run_host resources.
 	// Moreover, it can be used to create a connection between a run_host resource and host inventory.
 	Hosts *RunInputHosts `json:"hosts,omitempty"`
 
+	// IdempotencyKey Optional key that makes the dispatch of a Playbook run safe to retry.
+	// A repeated dispatch with the same key by the same service within the idempotency window returns the original Playbook run instead of dispatching it again.
+	IdempotencyKey *IdempotencyKey `json:"idempotency_key,omitempty"`
+
 	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
 	Labels *externalRef0.Labels `json:"labels,omitempty"`
 
+	// Mode Mode in which the Playbook is executed. In the check mode Ansible is run with --check --diff, i.e. the changes the Playbook would make are reported but not applied.
+	Mode *externalRef0.RunMode `json:"mode,omitempty"`
+
 	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
 	Name externalRef0.PlaybookName `json:"name"`
 
+	// NotAfter Optional timestamp after which a scheduled Playbook run that has not been dispatched yet expires.
+	// Can only be used together with not_before.
+	NotAfter *NotAfter `json:"not_after,omitempty"`
+
+	// NotBefore Optional timestamp before which the Playbook run is not dispatched.
+	// Until then the Playbook run is kept in the scheduled status.
+	NotBefore *NotBefore `json:"not_before,omitempty"`
+
 	// OrgId Identifier of the tenant
 	OrgId externalRef0.OrgId `json:"org_id"`
 
+	// PlaybookDigest SHA-256 digest of the content of a Playbook stored in the playbook registry
+	PlaybookDigest *PlaybookDigest `json:"playbook_digest,omitempty"`
+
+	// PlaybookSignature Base64-encoded detached Ed25519 signature of the content of a Playbook
+	PlaybookSignature *PlaybookSignature `json:"playbook_signature,omitempty"`
+
+	// Preflight If set, the Playbook is fetched from url and checked before the Playbook run is dispatched.
+	// A Playbook that is not a list of plays defining hosts, tasks or roles and the insights_signature vars is rejected.
+	Preflight *Preflight `json:"preflight,omitempty"`
+
 	// Principal Username of the user interacting with the service
 	Principal Principal `json:"principal"`
 
@@ -272,6 +523,15 @@ type RunInputV2 struct {
 	// RecipientConfig recipient-specific configuration options
 	RecipientConfig *RecipientConfig `json:"recipient_config,omitempty"`
 
+	// RetryPolicy Optional policy for retrying the Playbook run automatically once it finishes with one of the given statuses.
+	// Each retry is a new Playbook run dispatched to the same recipient and linked to the previous attempt using parent_run_id.
+	// A retry of a Satellite Playbook run only targets the hosts that finished with one of the given statuses.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
+
+	// SecretVars Names of extra_vars whose values are secret.
+	// Secret values are redacted when the Playbook run is read and in logs.
+	SecretVars *SecretVars `json:"secret_vars,omitempty"`
+
 	// Timeout Amount of seconds after which the run is considered failed due to timeout
 	Timeout *externalRef0.RunTimeout `json:"timeout,omitempty"`
 
@@ -294,6 +554,10 @@ type SatelliteId = string
 // SatelliteOrgId Identifier of the organization within Satellite
 type SatelliteOrgId = string
 
+// SecretVars Names of extra_vars whose values are secret.
+// Secret values are redacted when the Playbook run is read and in logs.
+type SecretVars = []string
+
 // Version Version of the API
 type Version = string
 
@@ -303,6 +567,9 @@ type BadRequest = Error
 // Forbidden defines model for Forbidden.
 type Forbidden = Error
 
+// ServiceUnavailable defines model for ServiceUnavailable.
+type ServiceUnavailable = Error
+
 // ApiInternalRunsCreateJSONBody defines parameters for ApiInternalRunsCreate.
 type ApiInternalRunsCreateJSONBody = []RunInput
 
@@ -312,6 +579,20 @@ type ApiInternalV2RunsCancelJSONBody = []CancelInputV2
 // ApiInternalV2RunsCreateJSONBody defines parameters for ApiInternalV2RunsCreate.
 type ApiInternalV2RunsCreateJSONBody = []RunInputV2
 
+// ApiInternalV2RunsCreateParams defines parameters for ApiInternalV2RunsCreate.
+type ApiInternalV2RunsCreateParams struct {
+	// IdempotencyKey Key identifying the dispatch request.
+	// Applies to every Playbook run in the request that does not define its own idempotency_key.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ApiInternalV2RunsCreateRolloutParams defines parameters for ApiInternalV2RunsCreateRollout.
+type ApiInternalV2RunsCreateRolloutParams struct {
+	// IdempotencyKey Key identifying the dispatch request.
+	// Applies to every Playbook run in the request that does not define its own idempotency_key.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
 // ApiInternalV2RecipientsStatusJSONBody defines parameters for ApiInternalV2RecipientsStatus.
 type ApiInternalV2RecipientsStatusJSONBody = []RecipientWithOrg
 
@@ -339,15 +620,27 @@ type ApiInternalRunsCreateJSONRequestBody = ApiInternalRunsCreateJSONBody
 // ApiInternalV2RunsCancelJSONRequestBody defines body for ApiInternalV2RunsCancel for application/json ContentType.
 type ApiInternalV2RunsCancelJSONRequestBody = ApiInternalV2RunsCancelJSONBody
 
+// ApiInternalV2RunsCancelFilterJSONRequestBody defines body for ApiInternalV2RunsCancelFilter for application/json ContentType.
+type ApiInternalV2RunsCancelFilterJSONRequestBody = CancelFilterInputV2
+
 // ApiInternalHighlevelConnectionStatusJSONRequestBody defines body for ApiInternalHighlevelConnectionStatus for application/json ContentType.
 type ApiInternalHighlevelConnectionStatusJSONRequestBody = HostsWithOrgId
 
 // ApiInternalV2RunsCreateJSONRequestBody defines body for ApiInternalV2RunsCreate for application/json ContentType.
 type ApiInternalV2RunsCreateJSONRequestBody = ApiInternalV2RunsCreateJSONBody
 
+// ApiInternalV2RunsCreateRolloutJSONRequestBody defines body for ApiInternalV2RunsCreateRollout for application/json ContentType.
+type ApiInternalV2RunsCreateRolloutJSONRequestBody = RolloutInputV2
+
+// ApiInternalV2PlaybooksUploadJSONRequestBody defines body for ApiInternalV2PlaybooksUpload for application/json ContentType.
+type ApiInternalV2PlaybooksUploadJSONRequestBody = PlaybookUploadInputV2
+
 // ApiInternalV2RecipientsStatusJSONRequestBody defines body for ApiInternalV2RecipientsStatus for application/json ContentType.
 type ApiInternalV2RecipientsStatusJSONRequestBody = ApiInternalV2RecipientsStatusJSONBody
 
+// ApiInternalV2RunGroupsCancelJSONRequestBody defines body for ApiInternalV2RunGroupsCancel for application/json ContentType.
+type ApiInternalV2RunGroupsCancelJSONRequestBody = RunGroupCancelInputV2
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -431,21 +724,41 @@ type ClientInterface interface {
 
 	ApiInternalV2RunsCancel(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiInternalV2RunsCancelFilterWithBody request with any body
+	ApiInternalV2RunsCancelFilterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunsCancelFilter(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiInternalHighlevelConnectionStatusWithBody request with any body
 	ApiInternalHighlevelConnectionStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ApiInternalHighlevelConnectionStatus(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ApiInternalV2RunsCreateWithBody request with any body
-	ApiInternalV2RunsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+	ApiInternalV2RunsCreateWithBody(ctx context.Context, params *ApiInternalV2RunsCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunsCreate(ctx context.Context, params *ApiInternalV2RunsCreateParams, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiInternalV2RunsCreateRolloutWithBody request with any body
+	ApiInternalV2RunsCreateRolloutWithBody(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunsCreateRollout(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, body ApiInternalV2RunsCreateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
-	ApiInternalV2RunsCreate(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+	// ApiInternalV2PlaybooksUploadWithBody request with any body
+	ApiInternalV2PlaybooksUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2PlaybooksUpload(ctx context.Context, body ApiInternalV2PlaybooksUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ApiInternalV2RecipientsStatusWithBody request with any body
 	ApiInternalV2RecipientsStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	ApiInternalV2RecipientsStatus(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ApiInternalV2RunGroupsCancelWithBody request with any body
+	ApiInternalV2RunGroupsCancelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	ApiInternalV2RunGroupsCancel(ctx context.Context, body ApiInternalV2RunGroupsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiInternalV2RunHostsList request
 	ApiInternalV2RunHostsList(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -501,6 +814,30 @@ func (c *Client) ApiInternalV2RunsCancel(ctx context.Context, body ApiInternalV2
 	return c.Client.Do(req)
 }
 
+func (c *Client) ApiInternalV2RunsCancelFilterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCancelFilterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV2RunsCancelFilter(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCancelFilterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ApiInternalHighlevelConnectionStatusWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewApiInternalHighlevelConnectionStatusRequestWithBody(c.Server, contentType, body)
 	if err != nil {
@@ -525,8 +862,56 @@ func (c *Client) ApiInternalHighlevelConnectionStatus(ctx context.Context, body
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RunsCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RunsCreateRequestWithBody(c.Server, contentType, body)
+func (c *Client) ApiInternalV2RunsCreateWithBody(ctx context.Context, params *ApiInternalV2RunsCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCreateRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV2RunsCreate(ctx context.Context, params *ApiInternalV2RunsCreateParams, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCreateRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV2RunsCreateRolloutWithBody(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCreateRolloutRequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV2RunsCreateRollout(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, body ApiInternalV2RunsCreateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunsCreateRolloutRequest(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV2PlaybooksUploadWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2PlaybooksUploadRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -537,8 +922,8 @@ func (c *Client) ApiInternalV2RunsCreateWithBody(ctx context.Context, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiInternalV2RunsCreate(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiInternalV2RunsCreateRequest(c.Server, body)
+func (c *Client) ApiInternalV2PlaybooksUpload(ctx context.Context, body ApiInternalV2PlaybooksUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2PlaybooksUploadRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -573,6 +958,30 @@ func (c *Client) ApiInternalV2RecipientsStatus(ctx context.Context, body ApiInte
 	return c.Client.Do(req)
 }
 
+func (c *Client) ApiInternalV2RunGroupsCancelWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunGroupsCancelRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiInternalV2RunGroupsCancel(ctx context.Context, body ApiInternalV2RunGroupsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiInternalV2RunGroupsCancelRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) ApiInternalV2RunHostsList(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewApiInternalV2RunHostsListRequest(c.Server, params)
 	if err != nil {
@@ -677,19 +1086,19 @@ func NewApiInternalV2RunsCancelRequestWithBody(server string, contentType string
 	return req, nil
 }
 
-// NewApiInternalHighlevelConnectionStatusRequest calls the generic ApiInternalHighlevelConnectionStatus builder with application/json body
-func NewApiInternalHighlevelConnectionStatusRequest(server string, body ApiInternalHighlevelConnectionStatusJSONRequestBody) (*http.Request, error) {
+// NewApiInternalV2RunsCancelFilterRequest calls the generic ApiInternalV2RunsCancelFilter builder with application/json body
+func NewApiInternalV2RunsCancelFilterRequest(server string, body ApiInternalV2RunsCancelFilterJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalHighlevelConnectionStatusRequestWithBody(server, "application/json", bodyReader)
+	return NewApiInternalV2RunsCancelFilterRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewApiInternalHighlevelConnectionStatusRequestWithBody generates requests for ApiInternalHighlevelConnectionStatus with any type of body
-func NewApiInternalHighlevelConnectionStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiInternalV2RunsCancelFilterRequestWithBody generates requests for ApiInternalV2RunsCancelFilter with any type of body
+func NewApiInternalV2RunsCancelFilterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -697,7 +1106,7 @@ func NewApiInternalHighlevelConnectionStatusRequestWithBody(server string, conte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/internal/v2/connection_status")
+	operationPath := fmt.Sprintf("/internal/v2/cancel/filter")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -717,19 +1126,19 @@ func NewApiInternalHighlevelConnectionStatusRequestWithBody(server string, conte
 	return req, nil
 }
 
-// NewApiInternalV2RunsCreateRequest calls the generic ApiInternalV2RunsCreate builder with application/json body
-func NewApiInternalV2RunsCreateRequest(server string, body ApiInternalV2RunsCreateJSONRequestBody) (*http.Request, error) {
+// NewApiInternalHighlevelConnectionStatusRequest calls the generic ApiInternalHighlevelConnectionStatus builder with application/json body
+func NewApiInternalHighlevelConnectionStatusRequest(server string, body ApiInternalHighlevelConnectionStatusJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalV2RunsCreateRequestWithBody(server, "application/json", bodyReader)
+	return NewApiInternalHighlevelConnectionStatusRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewApiInternalV2RunsCreateRequestWithBody generates requests for ApiInternalV2RunsCreate with any type of body
-func NewApiInternalV2RunsCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiInternalHighlevelConnectionStatusRequestWithBody generates requests for ApiInternalHighlevelConnectionStatus with any type of body
+func NewApiInternalHighlevelConnectionStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -737,7 +1146,7 @@ func NewApiInternalV2RunsCreateRequestWithBody(server string, contentType string
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/internal/v2/dispatch")
+	operationPath := fmt.Sprintf("/internal/v2/connection_status")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -757,19 +1166,19 @@ func NewApiInternalV2RunsCreateRequestWithBody(server string, contentType string
 	return req, nil
 }
 
-// NewApiInternalV2RecipientsStatusRequest calls the generic ApiInternalV2RecipientsStatus builder with application/json body
-func NewApiInternalV2RecipientsStatusRequest(server string, body ApiInternalV2RecipientsStatusJSONRequestBody) (*http.Request, error) {
+// NewApiInternalV2RunsCreateRequest calls the generic ApiInternalV2RunsCreate builder with application/json body
+func NewApiInternalV2RunsCreateRequest(server string, params *ApiInternalV2RunsCreateParams, body ApiInternalV2RunsCreateJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewApiInternalV2RecipientsStatusRequestWithBody(server, "application/json", bodyReader)
+	return NewApiInternalV2RunsCreateRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewApiInternalV2RecipientsStatusRequestWithBody generates requests for ApiInternalV2RecipientsStatus with any type of body
-func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewApiInternalV2RunsCreateRequestWithBody generates requests for ApiInternalV2RunsCreate with any type of body
+func NewApiInternalV2RunsCreateRequestWithBody(server string, params *ApiInternalV2RunsCreateParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -777,7 +1186,7 @@ func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/internal/v2/recipients/status")
+	operationPath := fmt.Sprintf("/internal/v2/dispatch")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -794,11 +1203,37 @@ func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType
 
 	req.Header.Add("Content-Type", contentType)
 
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
 	return req, nil
 }
 
-// NewApiInternalV2RunHostsListRequest generates requests for ApiInternalV2RunHostsList
-func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2RunHostsListParams) (*http.Request, error) {
+// NewApiInternalV2RunsCreateRolloutRequest calls the generic ApiInternalV2RunsCreateRollout builder with application/json body
+func NewApiInternalV2RunsCreateRolloutRequest(server string, params *ApiInternalV2RunsCreateRolloutParams, body ApiInternalV2RunsCreateRolloutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunsCreateRolloutRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunsCreateRolloutRequestWithBody generates requests for ApiInternalV2RunsCreateRollout with any type of body
+func NewApiInternalV2RunsCreateRolloutRequestWithBody(server string, params *ApiInternalV2RunsCreateRolloutParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -806,7 +1241,7 @@ func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2Run
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/internal/v2/run_hosts")
+	operationPath := fmt.Sprintf("/internal/v2/dispatch/rollout")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -816,10 +1251,174 @@ func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2Run
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.Filter != nil {
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IdempotencyKey != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Idempotency-Key", runtime.ParamLocationHeader, *params.IdempotencyKey)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Idempotency-Key", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewApiInternalV2PlaybooksUploadRequest calls the generic ApiInternalV2PlaybooksUpload builder with application/json body
+func NewApiInternalV2PlaybooksUploadRequest(server string, body ApiInternalV2PlaybooksUploadJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2PlaybooksUploadRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV2PlaybooksUploadRequestWithBody generates requests for ApiInternalV2PlaybooksUpload with any type of body
+func NewApiInternalV2PlaybooksUploadRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/playbooks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RecipientsStatusRequest calls the generic ApiInternalV2RecipientsStatus builder with application/json body
+func NewApiInternalV2RecipientsStatusRequest(server string, body ApiInternalV2RecipientsStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RecipientsStatusRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RecipientsStatusRequestWithBody generates requests for ApiInternalV2RecipientsStatus with any type of body
+func NewApiInternalV2RecipientsStatusRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/recipients/status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RunGroupsCancelRequest calls the generic ApiInternalV2RunGroupsCancel builder with application/json body
+func NewApiInternalV2RunGroupsCancelRequest(server string, body ApiInternalV2RunGroupsCancelJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewApiInternalV2RunGroupsCancelRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewApiInternalV2RunGroupsCancelRequestWithBody generates requests for ApiInternalV2RunGroupsCancel with any type of body
+func NewApiInternalV2RunGroupsCancelRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_groups/cancel")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewApiInternalV2RunHostsListRequest generates requests for ApiInternalV2RunHostsList
+func NewApiInternalV2RunHostsListRequest(server string, params *ApiInternalV2RunHostsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/internal/v2/run_hosts")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
 
 			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
 				return nil, err
@@ -974,21 +1573,41 @@ type ClientWithResponsesInterface interface {
 
 	ApiInternalV2RunsCancelWithResponse(ctx context.Context, body ApiInternalV2RunsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelResponse, error)
 
+	// ApiInternalV2RunsCancelFilterWithBodyWithResponse request with any body
+	ApiInternalV2RunsCancelFilterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error)
+
+	ApiInternalV2RunsCancelFilterWithResponse(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error)
+
 	// ApiInternalHighlevelConnectionStatusWithBodyWithResponse request with any body
 	ApiInternalHighlevelConnectionStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error)
 
 	ApiInternalHighlevelConnectionStatusWithResponse(ctx context.Context, body ApiInternalHighlevelConnectionStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error)
 
 	// ApiInternalV2RunsCreateWithBodyWithResponse request with any body
-	ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
+	ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
+
+	ApiInternalV2RunsCreateWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateParams, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
 
-	ApiInternalV2RunsCreateWithResponse(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error)
+	// ApiInternalV2RunsCreateRolloutWithBodyWithResponse request with any body
+	ApiInternalV2RunsCreateRolloutWithBodyWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateRolloutResponse, error)
+
+	ApiInternalV2RunsCreateRolloutWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, body ApiInternalV2RunsCreateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateRolloutResponse, error)
+
+	// ApiInternalV2PlaybooksUploadWithBodyWithResponse request with any body
+	ApiInternalV2PlaybooksUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2PlaybooksUploadResponse, error)
+
+	ApiInternalV2PlaybooksUploadWithResponse(ctx context.Context, body ApiInternalV2PlaybooksUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2PlaybooksUploadResponse, error)
 
 	// ApiInternalV2RecipientsStatusWithBodyWithResponse request with any body
 	ApiInternalV2RecipientsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error)
 
 	ApiInternalV2RecipientsStatusWithResponse(ctx context.Context, body ApiInternalV2RecipientsStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error)
 
+	// ApiInternalV2RunGroupsCancelWithBodyWithResponse request with any body
+	ApiInternalV2RunGroupsCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunGroupsCancelResponse, error)
+
+	ApiInternalV2RunGroupsCancelWithResponse(ctx context.Context, body ApiInternalV2RunGroupsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunGroupsCancelResponse, error)
+
 	// ApiInternalV2RunHostsListWithResponse request
 	ApiInternalV2RunHostsListWithResponse(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunHostsListResponse, error)
 
@@ -1042,11 +1661,35 @@ func (r ApiInternalV2RunsCancelResponse) StatusCode() int {
 	return 0
 }
 
+type ApiInternalV2RunsCancelFilterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunsCanceled
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunsCancelFilterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunsCancelFilterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ApiInternalHighlevelConnectionStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
 	JSON200      *HighLevelRecipientStatus
 	JSON400      *BadRequest
+	JSON503      *ServiceUnavailable
 }
 
 // Status returns HTTPResponse.Status
@@ -1087,6 +1730,52 @@ func (r ApiInternalV2RunsCreateResponse) StatusCode() int {
 	return 0
 }
 
+type ApiInternalV2RunsCreateRolloutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunsCreated
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunsCreateRolloutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunsCreateRolloutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiInternalV2PlaybooksUploadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *PlaybookUploaded
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2PlaybooksUploadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2PlaybooksUploadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ApiInternalV2RecipientsStatusResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1110,6 +1799,29 @@ func (r ApiInternalV2RecipientsStatusResponse) StatusCode() int {
 	return 0
 }
 
+type ApiInternalV2RunGroupsCancelResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON207      *RunsCanceled
+	JSON400      *BadRequest
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiInternalV2RunGroupsCancelResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiInternalV2RunGroupsCancelResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type ApiInternalV2RunHostsListResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -1190,6 +1902,23 @@ func (c *ClientWithResponses) ApiInternalV2RunsCancelWithResponse(ctx context.Co
 	return ParseApiInternalV2RunsCancelResponse(rsp)
 }
 
+// ApiInternalV2RunsCancelFilterWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCancelFilterResponse
+func (c *ClientWithResponses) ApiInternalV2RunsCancelFilterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCancelFilterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCancelFilterResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunsCancelFilterWithResponse(ctx context.Context, body ApiInternalV2RunsCancelFilterJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCancelFilterResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCancelFilter(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCancelFilterResponse(rsp)
+}
+
 // ApiInternalHighlevelConnectionStatusWithBodyWithResponse request with arbitrary body returning *ApiInternalHighlevelConnectionStatusResponse
 func (c *ClientWithResponses) ApiInternalHighlevelConnectionStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalHighlevelConnectionStatusResponse, error) {
 	rsp, err := c.ApiInternalHighlevelConnectionStatusWithBody(ctx, contentType, body, reqEditors...)
@@ -1208,22 +1937,56 @@ func (c *ClientWithResponses) ApiInternalHighlevelConnectionStatusWithResponse(c
 }
 
 // ApiInternalV2RunsCreateWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCreateResponse
-func (c *ClientWithResponses) ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
-	rsp, err := c.ApiInternalV2RunsCreateWithBody(ctx, contentType, body, reqEditors...)
+func (c *ClientWithResponses) ApiInternalV2RunsCreateWithBodyWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCreateWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseApiInternalV2RunsCreateResponse(rsp)
 }
 
-func (c *ClientWithResponses) ApiInternalV2RunsCreateWithResponse(ctx context.Context, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
-	rsp, err := c.ApiInternalV2RunsCreate(ctx, body, reqEditors...)
+func (c *ClientWithResponses) ApiInternalV2RunsCreateWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateParams, body ApiInternalV2RunsCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCreate(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
 	return ParseApiInternalV2RunsCreateResponse(rsp)
 }
 
+// ApiInternalV2RunsCreateRolloutWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunsCreateRolloutResponse
+func (c *ClientWithResponses) ApiInternalV2RunsCreateRolloutWithBodyWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateRolloutResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCreateRolloutWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCreateRolloutResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunsCreateRolloutWithResponse(ctx context.Context, params *ApiInternalV2RunsCreateRolloutParams, body ApiInternalV2RunsCreateRolloutJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunsCreateRolloutResponse, error) {
+	rsp, err := c.ApiInternalV2RunsCreateRollout(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunsCreateRolloutResponse(rsp)
+}
+
+// ApiInternalV2PlaybooksUploadWithBodyWithResponse request with arbitrary body returning *ApiInternalV2PlaybooksUploadResponse
+func (c *ClientWithResponses) ApiInternalV2PlaybooksUploadWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2PlaybooksUploadResponse, error) {
+	rsp, err := c.ApiInternalV2PlaybooksUploadWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2PlaybooksUploadResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2PlaybooksUploadWithResponse(ctx context.Context, body ApiInternalV2PlaybooksUploadJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2PlaybooksUploadResponse, error) {
+	rsp, err := c.ApiInternalV2PlaybooksUpload(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2PlaybooksUploadResponse(rsp)
+}
+
 // ApiInternalV2RecipientsStatusWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RecipientsStatusResponse
 func (c *ClientWithResponses) ApiInternalV2RecipientsStatusWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RecipientsStatusResponse, error) {
 	rsp, err := c.ApiInternalV2RecipientsStatusWithBody(ctx, contentType, body, reqEditors...)
@@ -1241,6 +2004,23 @@ func (c *ClientWithResponses) ApiInternalV2RecipientsStatusWithResponse(ctx cont
 	return ParseApiInternalV2RecipientsStatusResponse(rsp)
 }
 
+// ApiInternalV2RunGroupsCancelWithBodyWithResponse request with arbitrary body returning *ApiInternalV2RunGroupsCancelResponse
+func (c *ClientWithResponses) ApiInternalV2RunGroupsCancelWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ApiInternalV2RunGroupsCancelResponse, error) {
+	rsp, err := c.ApiInternalV2RunGroupsCancelWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunGroupsCancelResponse(rsp)
+}
+
+func (c *ClientWithResponses) ApiInternalV2RunGroupsCancelWithResponse(ctx context.Context, body ApiInternalV2RunGroupsCancelJSONRequestBody, reqEditors ...RequestEditorFn) (*ApiInternalV2RunGroupsCancelResponse, error) {
+	rsp, err := c.ApiInternalV2RunGroupsCancel(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiInternalV2RunGroupsCancelResponse(rsp)
+}
+
 // ApiInternalV2RunHostsListWithResponse request returning *ApiInternalV2RunHostsListResponse
 func (c *ClientWithResponses) ApiInternalV2RunHostsListWithResponse(ctx context.Context, params *ApiInternalV2RunHostsListParams, reqEditors ...RequestEditorFn) (*ApiInternalV2RunHostsListResponse, error) {
 	rsp, err := c.ApiInternalV2RunHostsList(ctx, params, reqEditors...)
@@ -1325,6 +2105,39 @@ func ParseApiInternalV2RunsCancelResponse(rsp *http.Response) (*ApiInternalV2Run
 	return response, nil
 }
 
+// ParseApiInternalV2RunsCancelFilterResponse parses an HTTP response from a ApiInternalV2RunsCancelFilterWithResponse call
+func ParseApiInternalV2RunsCancelFilterResponse(rsp *http.Response) (*ApiInternalV2RunsCancelFilterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunsCancelFilterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunsCanceled
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseApiInternalHighlevelConnectionStatusResponse parses an HTTP response from a ApiInternalHighlevelConnectionStatusWithResponse call
 func ParseApiInternalHighlevelConnectionStatusResponse(rsp *http.Response) (*ApiInternalHighlevelConnectionStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1353,6 +2166,13 @@ func ParseApiInternalHighlevelConnectionStatusResponse(rsp *http.Response) (*Api
 		}
 		response.JSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
 	}
 
 	return response, nil
@@ -1384,6 +2204,72 @@ func ParseApiInternalV2RunsCreateResponse(rsp *http.Response) (*ApiInternalV2Run
 	return response, nil
 }
 
+// ParseApiInternalV2RunsCreateRolloutResponse parses an HTTP response from a ApiInternalV2RunsCreateRolloutWithResponse call
+func ParseApiInternalV2RunsCreateRolloutResponse(rsp *http.Response) (*ApiInternalV2RunsCreateRolloutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunsCreateRolloutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunsCreated
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiInternalV2PlaybooksUploadResponse parses an HTTP response from a ApiInternalV2PlaybooksUploadWithResponse call
+func ParseApiInternalV2PlaybooksUploadResponse(rsp *http.Response) (*ApiInternalV2PlaybooksUploadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2PlaybooksUploadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest PlaybookUploaded
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseApiInternalV2RecipientsStatusResponse parses an HTTP response from a ApiInternalV2RecipientsStatusWithResponse call
 func ParseApiInternalV2RecipientsStatusResponse(rsp *http.Response) (*ApiInternalV2RecipientsStatusResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -1417,6 +2303,39 @@ func ParseApiInternalV2RecipientsStatusResponse(rsp *http.Response) (*ApiInterna
 	return response, nil
 }
 
+// ParseApiInternalV2RunGroupsCancelResponse parses an HTTP response from a ApiInternalV2RunGroupsCancelWithResponse call
+func ParseApiInternalV2RunGroupsCancelResponse(rsp *http.Response) (*ApiInternalV2RunGroupsCancelResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiInternalV2RunGroupsCancelResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 207:
+		var dest RunsCanceled
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON207 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseApiInternalV2RunHostsListResponse parses an HTTP response from a ApiInternalV2RunHostsListWithResponse call
 func ParseApiInternalV2RunHostsListResponse(rsp *http.Response) (*ApiInternalV2RunHostsListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
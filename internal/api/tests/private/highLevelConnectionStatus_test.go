@@ -64,10 +64,34 @@ var _ = Describe("high level connection status", func() {
 		Expect((*result)[1].Status).To(Equal(Connected))
 		Expect((*result)[1].Systems).To(Equal(directConnectHost))
 	})
-	It("disallow more than 50 hosts", func() {
+	It("accepts thousands of hosts", func() {
 
-		hosts := make([]string, 51)
-		for i := 0; i < 51; i++ {
+		hosts := make([]string, 5000)
+		for i := range hosts {
+			hosts[i] = "host" + strconv.Itoa(i+1)
+		}
+		hosts[0] = "c484f980-ab8d-401b-90e7-aa1d4ccf8c0e"
+
+		payload := ApiInternalHighlevelConnectionStatusJSONRequestBody{
+			Hosts: hosts,
+			OrgId: "12345",
+		}
+
+		response, err := getConnectionStatus(payload)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(200))
+
+		result := response.JSON200
+		Expect(*result).To(HaveLen(2))
+		Expect((*result)[0].RecipientType).To(Equal(Satellite))
+		Expect((*result)[0].Systems).To(Equal([]HostId{"c484f980-ab8d-401b-90e7-aa1d4ccf8c0e"}))
+		Expect((*result)[1].RecipientType).To(Equal(DirectConnect))
+		Expect((*result)[1].Systems).To(Equal([]HostId{"fe30b997-c15a-44a9-89df-c236c3b5c540"}))
+	})
+
+	It("rejects more than 5000 hosts", func() {
+		hosts := make([]string, 5001)
+		for i := range hosts {
 			hosts[i] = "host" + strconv.Itoa(i+1)
 		}
 
@@ -81,6 +105,38 @@ var _ = Describe("high level connection status", func() {
 		Expect(response.StatusCode()).To(Equal(400))
 	})
 
+	It("reports unknown status if the connection status cannot be read", func() {
+		payload := ApiInternalHighlevelConnectionStatusJSONRequestBody{
+			Hosts: []string{"unknown-status-host"},
+			OrgId: "12345",
+		}
+
+		response, err := getConnectionStatus(payload)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(200))
+
+		result := response.JSON200
+		Expect(*result).To(HaveLen(1))
+		Expect((*result)[0].RecipientType).To(Equal(DirectConnect))
+		Expect((*result)[0].Recipient).To(Equal(public.RunRecipient(uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587"))))
+		Expect((*result)[0].Status).To(Equal(Unknown))
+		Expect((*result)[0].Systems).To(Equal([]HostId{"unknown-status-host"}))
+	})
+
+	It("responds with 503 if inventory is unavailable", func() {
+		payload := ApiInternalHighlevelConnectionStatusJSONRequestBody{
+			Hosts: []string{"unavailable-host"},
+			OrgId: "12345",
+		}
+
+		response, err := getConnectionStatus(payload)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode()).To(Equal(503))
+		Expect(response.JSON503.Message).To(Equal("inventory unavailable: circuit_open"))
+	})
+
 	It("handles satellite hosts with nil SatelliteVersion", func() {
 		// Test with a host that has nil SatelliteVersion
 		payload := ApiInternalHighlevelConnectionStatusJSONRequestBody{
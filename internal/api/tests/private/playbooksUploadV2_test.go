@@ -0,0 +1,75 @@
+package private
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"net/http"
+	"playbook-dispatcher/internal/api/dispatch/registry"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func uploadPlaybookV2(payload ApiInternalV2PlaybooksUploadJSONRequestBody) *ApiInternalV2PlaybooksUploadResponse {
+	resp, err := client.ApiInternalV2PlaybooksUpload(test.TestContext(), payload)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2PlaybooksUploadResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res
+}
+
+func signPlaybook(content string) PlaybookSignature {
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(playbookSigningKey, []byte(content)))
+}
+
+var _ = Describe("playbooksUpload V2", func() {
+	db := test.WithDatabase()
+
+	It("stores a signed Playbook under its digest", func() {
+		content := "- name: ping\n  hosts: all\n  tasks:\n  - ping:\n"
+
+		res := uploadPlaybookV2(ApiInternalV2PlaybooksUploadJSONRequestBody{
+			OrgId:     OrgId(orgId()),
+			Content:   content,
+			Signature: signPlaybook(content),
+		})
+
+		Expect(res.StatusCode()).To(Equal(http.StatusCreated))
+		Expect(res.JSON201.Digest).To(Equal(registry.Digest([]byte(content))))
+
+		var playbook dbModel.Playbook
+		Expect(db().Where("org_id = ? AND digest = ?", orgId(), res.JSON201.Digest).First(&playbook).Error).ToNot(HaveOccurred())
+		Expect(string(playbook.Content)).To(Equal(content))
+	})
+
+	It("accepts the same Playbook more than once", func() {
+		content := "- name: uptime\n  hosts: all\n  tasks:\n  - command: uptime\n"
+		payload := ApiInternalV2PlaybooksUploadJSONRequestBody{
+			OrgId:     OrgId(orgId()),
+			Content:   content,
+			Signature: signPlaybook(content),
+		}
+
+		first := uploadPlaybookV2(payload)
+		second := uploadPlaybookV2(payload)
+
+		Expect(second.StatusCode()).To(Equal(http.StatusCreated))
+		Expect(second.JSON201.Digest).To(Equal(first.JSON201.Digest))
+	})
+
+	It("rejects a Playbook with an invalid signature", func() {
+		content := "- name: ping\n  hosts: all\n  tasks:\n  - ping:\n"
+
+		res := uploadPlaybookV2(ApiInternalV2PlaybooksUploadJSONRequestBody{
+			OrgId:     OrgId(orgId()),
+			Content:   content,
+			Signature: signPlaybook("- name: something else\n"),
+		})
+
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(res.JSON400.Message).To(ContainSubstring("Playbook signature not valid"))
+	})
+})
@@ -2,6 +2,8 @@ package private
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/base64"
 	"fmt"
 	"net/http"
 	"playbook-dispatcher/internal/api"
@@ -19,8 +21,16 @@ const (
 
 	webConsoleUrlDefault = "https://example.com"
 	testBuildCommit      = "testV1"
+
+	// organizations with a small dispatch quota
+	quotaOrgId          = "5318299"
+	preflightQuotaOrgId = "5318298"
+	refundQuotaOrgId    = "5318297"
 )
 
+// key trusted to sign Playbooks uploaded to the playbook registry
+var playbookSigningKey = ed25519.NewKeyFromSeed([]byte("playbook-dispatcher-test-signing"))
+
 var (
 	orgId         = test.WithOrgId()
 	accountNumber = test.WithAccountNumber()
@@ -46,6 +56,10 @@ func TestConfig(t *testing.T) {
 	cfg.Set("web.port", 9002)
 	cfg.Set("cloud.connector.rps", 5)
 	cfg.Set("cloud.connector.req.bucket", 5)
+	cfg.Set("quota.org.overrides", fmt.Sprintf("%s=0.001:2,%s=0.001:1,%s=0.001:1", quotaOrgId, preflightQuotaOrgId, refundQuotaOrgId))
+	cfg.Set("outbound.allowed.hosts", "example.com,127.0.0.1")
+	cfg.Set("playbook.registry.enabled", true)
+	cfg.Set("playbook.registry.keys", base64.StdEncoding.EncodeToString(playbookSigningKey.Public().(ed25519.PublicKey)))
 
 	cfg.Set("build.commit", testBuildCommit)
 
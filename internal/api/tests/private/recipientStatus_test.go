@@ -36,6 +36,7 @@ var _ = Describe("recipient status", func() {
 			Expect((*result)[0].OrgId).To(Equal(payload[0].OrgId))
 			Expect((*result)[0].Recipient).To(Equal(payload[0].Recipient))
 			Expect((*result)[0].Connected).To(BeTrue())
+			Expect((*result)[0].Status).To(Equal(Connected))
 		})
 
 		It("multiple recipients", func() {
@@ -60,7 +61,47 @@ var _ = Describe("recipient status", func() {
 			Expect((*result)[1].OrgId).To(Equal(payload[1].OrgId))
 			Expect((*result)[1].Recipient).To(Equal(payload[1].Recipient))
 			Expect((*result)[1].Connected).To(BeFalse())
+			Expect((*result)[1].Status).To(Equal(Disconnected))
 		})
+
+		It("keeps the order of the recipients", func() {
+			payload := ApiInternalV2RecipientsStatusJSONRequestBody{}
+			for i := 0; i < 25; i++ {
+				payload = append(payload, RecipientWithOrg{
+					OrgId:     "5318290",
+					Recipient: uuid.New(),
+				})
+			}
+
+			result, _ := getStatus(payload)
+
+			Expect(*result).To(HaveLen(25))
+			for i, status := range *result {
+				Expect(status.Recipient).To(Equal(payload[i].Recipient))
+				Expect(status.Status).To(Equal(Connected))
+			}
+		})
+	})
+
+	It("reports unknown status if the connection status cannot be read", func() {
+		payload := ApiInternalV2RecipientsStatusJSONRequestBody{
+			RecipientWithOrg{
+				OrgId:     "5318290",
+				Recipient: uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587"),
+			},
+			RecipientWithOrg{
+				OrgId:     "5318290",
+				Recipient: uuid.MustParse("214f2dc3-eda5-4230-9800-579b020be25b"),
+			},
+		}
+
+		result, _ := getStatus(payload)
+
+		Expect(*result).To(HaveLen(2))
+		Expect((*result)[0].Connected).To(BeFalse())
+		Expect((*result)[0].Status).To(Equal(Unknown))
+		Expect((*result)[1].Connected).To(BeTrue())
+		Expect((*result)[1].Status).To(Equal(Connected))
 	})
 
 	It("Handles an anemic tenant", func() {
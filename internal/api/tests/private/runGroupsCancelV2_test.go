@@ -0,0 +1,82 @@
+package private
+
+import (
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func cancelRunGroupV2(payload *ApiInternalV2RunGroupsCancelJSONRequestBody) (*RunsCanceled, *ApiInternalV2RunGroupsCancelResponse) {
+	resp, err := client.ApiInternalV2RunGroupsCancel(test.TestContext(), *payload)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunGroupsCancelResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(res.StatusCode()).To(Equal(http.StatusMultiStatus))
+
+	return res.JSON207, res
+}
+
+var _ = Describe("runGroupsCancel V2", func() {
+	db := test.WithDatabase()
+
+	newGroup := func() dbModel.RunGroup {
+		group := test.NewRunGroup(orgId())
+		Expect(db().Create(&group).Error).ToNot(HaveOccurred())
+		return group
+	}
+
+	newRun := func(group dbModel.RunGroup, status string) dbModel.Run {
+		run := test.NewRunWithStatus(orgId(), status)
+		run.GroupID = &group.ID
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+		return run
+	}
+
+	runIds := func(runs *RunsCanceled) (result []uuid.UUID) {
+		for _, run := range *runs {
+			result = append(result, uuid.UUID(run.RunId))
+		}
+		return
+	}
+
+	It("cancels running and scheduled runs of the group", func() {
+		group := newGroup()
+		running := newRun(group, dbModel.RunStatusRunning)
+		scheduled := newRun(group, dbModel.RunStatusScheduled)
+		newRun(group, dbModel.RunStatusSuccess)
+		newRun(newGroup(), dbModel.RunStatusRunning)
+
+		runs, _ := cancelRunGroupV2(&ApiInternalV2RunGroupsCancelJSONRequestBody{
+			GroupId:   group.ID,
+			OrgId:     OrgId(orgId()),
+			Principal: Principal("test_user"),
+		})
+
+		Expect(*runs).To(HaveLen(2))
+		Expect(runIds(runs)).To(ConsistOf(running.ID, scheduled.ID))
+		for _, run := range *runs {
+			Expect(run.Code).To(Equal(202))
+		}
+
+		var result dbModel.Run
+		Expect(db().First(&result, scheduled.ID).Error).ToNot(HaveOccurred())
+		Expect(result.Status).To(Equal(dbModel.RunStatusCanceled))
+	})
+
+	It("does not cancel runs of another organization", func() {
+		group := newGroup()
+		newRun(group, dbModel.RunStatusRunning)
+
+		runs, _ := cancelRunGroupV2(&ApiInternalV2RunGroupsCancelJSONRequestBody{
+			GroupId:   group.ID,
+			OrgId:     OrgId("1234"),
+			Principal: Principal("test_user"),
+		})
+
+		Expect(*runs).To(BeEmpty())
+	})
+})
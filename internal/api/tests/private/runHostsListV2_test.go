@@ -76,7 +76,7 @@ var _ = Describe("runHostsListV2", func() {
 			// Internal endpoint should return ALL 3 hosts (no RBAC filtering)
 			// Unlike public endpoint which would filter based on user permissions
 			Expect(result.Meta.Count).To(Equal(3))
-			Expect(result.Meta.Total).To(Equal(3))
+			Expect(*result.Meta.Total).To(Equal(3))
 			Expect(result.Data).To(HaveLen(3))
 		})
 
@@ -165,7 +165,7 @@ var _ = Describe("runHostsListV2", func() {
 			Expect(resp.StatusCode).To(Equal(http.StatusOK))
 
 			Expect(result.Meta.Count).To(Equal(2))
-			Expect(result.Meta.Total).To(Equal(5))
+			Expect(*result.Meta.Total).To(Equal(5))
 			Expect(result.Data).To(HaveLen(2))
 		})
 
@@ -303,5 +303,32 @@ var _ = Describe("runHostsListV2", func() {
 			Expect(result.Data[0].Stdout).ToNot(BeNil())
 			Expect(*result.Data[0].Stdout).To(ContainSubstring("PLAY [all]"))
 		})
+
+		It("filters by and returns the play recap", func() {
+			run := test.NewRun(orgId())
+			dbInsertRuns(run)
+
+			host1 := test.NewRunHost(run.ID, "success", nil)
+			host1.Host = "host1"
+			host1.Stats = &dbModel.RunHostStats{Ok: 2, Changed: 1}
+			host2 := test.NewRunHost(run.ID, "failure", nil)
+			host2.Host = "host2"
+			host2.Stats = &dbModel.RunHostStats{Ok: 1, Failures: 1}
+
+			dbInsertHosts(host1, host2)
+
+			result, resp := doGetRunHosts("filter[changed]", "true", "fields[data]", "host,stats")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			Expect(result.Data).To(HaveLen(1))
+			Expect(*result.Data[0].Host).To(Equal("host1"))
+			Expect(*result.Data[0].Stats).To(Equal(public.RunHostStats{Ok: 2, Changed: 1}))
+
+			result, resp = doGetRunHosts("filter[failed]", "false", "fields[data]", "host")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			Expect(result.Data).To(HaveLen(1))
+			Expect(*result.Data[0].Host).To(Equal("host1"))
+		})
 	})
 })
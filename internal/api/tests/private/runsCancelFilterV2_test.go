@@ -0,0 +1,146 @@
+package private
+
+import (
+	"net/http"
+	"playbook-dispatcher/internal/api/controllers/public"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func cancelFilterV2(payload *ApiInternalV2RunsCancelFilterJSONRequestBody) (*RunsCanceled, *ApiInternalV2RunsCancelFilterResponse) {
+	resp, err := client.ApiInternalV2RunsCancelFilter(test.TestContext(), *payload)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunsCancelFilterResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(res.StatusCode()).To(Equal(http.StatusMultiStatus))
+
+	return res.JSON207, res
+}
+
+var _ = Describe("runsCancelFilter V2", func() {
+	db := test.WithDatabase()
+
+	var service string
+
+	BeforeEach(func() {
+		// unique service name so that runs created by other tests are not matched
+		service = "cancel-" + uuid.New().String()
+	})
+
+	newRun := func(status string, labels dbModel.Labels) dbModel.Run {
+		data := test.NewRunWithStatus(orgId(), status)
+		data.Service = service
+		data.Labels = labels
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+		return data
+	}
+
+	runIds := func(runs *RunsCanceled) (result []uuid.UUID) {
+		for _, run := range *runs {
+			result = append(result, uuid.UUID(run.RunId))
+		}
+		return
+	}
+
+	It("cancels running runs of the service", func() {
+		run1 := newRun("running", dbModel.Labels{"foo": "bar"})
+		run2 := newRun("running", dbModel.Labels{"foo": "baz"})
+		newRun("success", dbModel.Labels{"foo": "bar"})
+
+		runs, res := cancelFilterV2(&ApiInternalV2RunsCancelFilterJSONRequestBody{
+			OrgId:     OrgId(orgId()),
+			Principal: Principal("test_user"),
+			Filter: CancelFilter{
+				Service: service,
+			},
+		})
+
+		Expect(res.HTTPResponse.Header.Get("Truncated")).To(BeEmpty())
+		Expect(*runs).To(HaveLen(2))
+		Expect(runIds(runs)).To(ConsistOf(run1.ID, run2.ID))
+		for _, run := range *runs {
+			Expect(run.Code).To(Equal(202))
+		}
+	})
+
+	It("cancels scheduled runs of the service", func() {
+		scheduled := newRun(dbModel.RunStatusScheduled, dbModel.Labels{"rollout": "1"})
+
+		runs, _ := cancelFilterV2(&ApiInternalV2RunsCancelFilterJSONRequestBody{
+			OrgId:     OrgId(orgId()),
+			Principal: Principal("test_user"),
+			Filter: CancelFilter{
+				Service: service,
+			},
+		})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].RunId).To(BeEquivalentTo(scheduled.ID))
+		Expect((*runs)[0].Code).To(Equal(202))
+
+		var result dbModel.Run
+		Expect(db().First(&result, scheduled.ID).Error).ToNot(HaveOccurred())
+		Expect(result.Status).To(Equal(dbModel.RunStatusCanceled))
+	})
+
+	It("cancels only runs matching the label selector", func() {
+		run1 := newRun("running", dbModel.Labels{"foo": "bar", "rollout": "1"})
+		newRun("running", dbModel.Labels{"foo": "bar", "rollout": "2"})
+		newRun("running", nil)
+
+		runs, _ := cancelFilterV2(&ApiInternalV2RunsCancelFilterJSONRequestBody{
+			OrgId:     OrgId(orgId()),
+			Principal: Principal("test_user"),
+			Filter: CancelFilter{
+				Service: service,
+				Labels:  &public.Labels{"rollout": "1"},
+			},
+		})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(202))
+		Expect((*runs)[0].RunId).To(BeEquivalentTo(run1.ID))
+	})
+
+	It("cancels at most 1000 runs, the oldest first", func() {
+		created := time.Now().Add(-time.Hour)
+		data := make([]dbModel.Run, 1001)
+		for i := range data {
+			data[i] = test.NewRunWithStatus(orgId(), "running")
+			data[i].Service = service
+			data[i].CreatedAt = created.Add(time.Duration(i) * time.Second)
+		}
+		Expect(db().CreateInBatches(&data, 100).Error).ToNot(HaveOccurred())
+
+		runs, res := cancelFilterV2(&ApiInternalV2RunsCancelFilterJSONRequestBody{
+			OrgId:     OrgId(orgId()),
+			Principal: Principal("test_user"),
+			Filter: CancelFilter{
+				Service: service,
+			},
+		})
+
+		Expect(res.HTTPResponse.Header.Get("Truncated")).To(Equal("true"))
+		Expect(*runs).To(HaveLen(1000))
+		Expect(runIds(runs)).ToNot(ContainElement(data[1000].ID))
+	})
+
+	It("does not cancel runs of another organization", func() {
+		newRun("running", dbModel.Labels{"foo": "bar"})
+
+		runs, _ := cancelFilterV2(&ApiInternalV2RunsCancelFilterJSONRequestBody{
+			OrgId:     OrgId("1234"),
+			Principal: Principal("test_user"),
+			Filter: CancelFilter{
+				Service: service,
+			},
+		})
+
+		Expect(*runs).To(BeEmpty())
+	})
+})
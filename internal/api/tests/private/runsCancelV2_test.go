@@ -4,7 +4,9 @@ import (
 	"net/http"
 	"playbook-dispatcher/internal/api/controllers/public"
 	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/common/utils/test"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo"
@@ -105,7 +107,7 @@ var _ = Describe("runsCancel V2", func() {
 		Expect((*runs)[0].RunId).To(BeEquivalentTo(data.ID))
 	})
 
-	It("400s if run is not of type satellite RHC", func() {
+	It("creates cancelation message for rhc-worker-playbook runs", func() {
 		var data = test.NewRun(orgId())
 		data.Labels = dbModel.Labels{"foo": "bar"}
 		data.Timeout = 600
@@ -117,6 +119,47 @@ var _ = Describe("runsCancel V2", func() {
 
 		runs, _ := cancelV2(&ApiInternalV2RunsCancelJSONRequestBody{payload})
 
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(202))
+		Expect((*runs)[0].RunId).To(BeEquivalentTo(data.ID))
+	})
+
+	It("cancels a scheduled run without sending a message", func() {
+		var data = test.NewRunWithStatus(orgId(), dbModel.RunStatusScheduled)
+		// recipient the cloud connector mock fails for
+		data.Recipient = uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587")
+		data.NotBefore = utils.TimeRef(time.Now().Add(time.Hour))
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		payload := minimalV2Cancel()
+		payload.RunId = public.RunId(data.ID)
+		payload.OrgId = OrgId(data.OrgID)
+
+		runs, _ := cancelV2(&ApiInternalV2RunsCancelJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(202))
+
+		var run dbModel.Run
+		Expect(db().First(&run, data.ID).Error).ToNot(HaveOccurred())
+		Expect(run.Status).To(Equal(dbModel.RunStatusCanceled))
+	})
+
+	It("400s if satellite run is missing the satellite organization", func() {
+		satId := uuid.MustParse("95cbea43-bb85-4153-96c2-eb2474b3e2b3")
+
+		var data = test.NewRun(orgId())
+		data.Labels = dbModel.Labels{"foo": "bar"}
+		data.Timeout = 600
+		data.SatId = &satId
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		payload := minimalV2Cancel()
+		payload.RunId = public.RunId(data.ID)
+		payload.OrgId = OrgId(data.OrgID)
+
+		runs, _ := cancelV2(&ApiInternalV2RunsCancelJSONRequestBody{payload})
+
 		Expect(*runs).To(HaveLen(1))
 		Expect((*runs)[0].Code).To(Equal(400))
 	})
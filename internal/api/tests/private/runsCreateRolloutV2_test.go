@@ -0,0 +1,184 @@
+package private
+
+import (
+	"io"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func dispatchRolloutV2(payload *ApiInternalV2RunsCreateRolloutJSONRequestBody) (*RunsCreated, *ApiInternalV2RunsCreateRolloutResponse) {
+	runs, res := dispatchRolloutV2Raw(payload)
+	Expect(res.StatusCode()).To(Equal(http.StatusMultiStatus))
+
+	return runs, res
+}
+
+func dispatchRolloutV2Raw(payload *ApiInternalV2RunsCreateRolloutJSONRequestBody) (*RunsCreated, *ApiInternalV2RunsCreateRolloutResponse) {
+	resp, err := client.ApiInternalV2RunsCreateRollout(test.TestContext(), nil, *payload)
+	Expect(err).ToNot(HaveOccurred())
+	res, err := ParseApiInternalV2RunsCreateRolloutResponse(resp)
+	Expect(err).ToNot(HaveOccurred())
+
+	return res.JSON207, res
+}
+
+var _ = Describe("runsCreate rollout V2", func() {
+	db := test.WithDatabase()
+
+	fetchRun := func(id uuid.UUID) dbModel.Run {
+		var run dbModel.Run
+		Expect(db().First(&run, id).Error).ToNot(HaveOccurred())
+		return run
+	}
+
+	fetchRollout := func(groupID uuid.UUID) dbModel.Rollout {
+		var rollout dbModel.Rollout
+		Expect(db().First(&rollout, "group_id = ?", groupID).Error).ToNot(HaveOccurred())
+		return rollout
+	}
+
+	payloads := func(count int) []RunInputV2 {
+		result := make([]RunInputV2, count)
+		for i := range result {
+			result[i] = minimalV2Payload(uuid.New())
+		}
+		return result
+	}
+
+	It("dispatches the canary wave and holds the remaining waves", func() {
+		runs, _ := dispatchRolloutV2(&ApiInternalV2RunsCreateRolloutJSONRequestBody{
+			Strategy: RolloutStrategy{Canary: utils.IntRef(1), WaveSize: 2},
+			Runs:     payloads(4),
+		})
+
+		Expect(*runs).To(HaveLen(4))
+
+		canary := fetchRun(*(*runs)[0].Id)
+		Expect(canary.Status).To(Equal(dbModel.RunStatusRunning))
+		Expect(*canary.RolloutWave).To(Equal(0))
+
+		for i, wave := range []int{1, 1, 2} {
+			run := fetchRun(*(*runs)[i+1].Id)
+			Expect(run.Status).To(Equal(dbModel.RunStatusScheduled))
+			Expect(run.NotBefore).To(BeNil())
+			Expect(*run.RolloutWave).To(Equal(wave))
+			Expect(run.GroupID).To(Equal((*runs)[0].GroupId))
+		}
+
+		rollout := fetchRollout(*(*runs)[0].GroupId)
+		Expect(rollout.Status).To(Equal(dbModel.RolloutStatusInProgress))
+		Expect(rollout.Wave).To(Equal(0))
+		Expect(rollout.Waves).To(Equal(3))
+		Expect(rollout.WaveSize).To(Equal(2))
+		Expect(rollout.Pause).To(Equal(0))
+		Expect(rollout.SuccessThreshold).To(Equal(100))
+		Expect(rollout.FailureThreshold).To(Equal(0))
+	})
+
+	It("counts canary runs rejected when dispatched", func() {
+		payload := payloads(3)
+		payload[0].Recipient = uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199")
+
+		runs, _ := dispatchRolloutV2(&ApiInternalV2RunsCreateRolloutJSONRequestBody{
+			Strategy: RolloutStrategy{Canary: utils.IntRef(2), WaveSize: 1},
+			Runs:     payload,
+		})
+
+		Expect((*runs)[0].Code).To(Equal(404))
+
+		rollout := fetchRollout(*(*runs)[1].GroupId)
+		Expect(rollout.Status).To(Equal(dbModel.RolloutStatusInProgress))
+		Expect(rollout.Rejected).To(Equal(1))
+	})
+
+	It("rounds up the canary percentage", func() {
+		runs, _ := dispatchRolloutV2(&ApiInternalV2RunsCreateRolloutJSONRequestBody{
+			Strategy: RolloutStrategy{CanaryPercentage: utils.IntRef(25), WaveSize: 10},
+			Runs:     payloads(5),
+		})
+
+		Expect(fetchRun(*(*runs)[0].Id).Status).To(Equal(dbModel.RunStatusRunning))
+		Expect(fetchRun(*(*runs)[1].Id).Status).To(Equal(dbModel.RunStatusRunning))
+		Expect(fetchRun(*(*runs)[2].Id).Status).To(Equal(dbModel.RunStatusScheduled))
+		Expect(fetchRollout(*(*runs)[0].GroupId).Waves).To(Equal(2))
+	})
+
+	It("completes a rollout that consists of the canary wave only", func() {
+		runs, _ := dispatchRolloutV2(&ApiInternalV2RunsCreateRolloutJSONRequestBody{
+			Strategy: RolloutStrategy{Canary: utils.IntRef(5), WaveSize: 1},
+			Runs:     payloads(2),
+		})
+
+		Expect(fetchRun(*(*runs)[1].Id).Status).To(Equal(dbModel.RunStatusRunning))
+		Expect(fetchRollout(*(*runs)[0].GroupId).Status).To(Equal(dbModel.RolloutStatusCompleted))
+	})
+
+	It("stores the given thresholds", func() {
+		runs, _ := dispatchRolloutV2(&ApiInternalV2RunsCreateRolloutJSONRequestBody{
+			Strategy: RolloutStrategy{
+				WaveSize:         1,
+				Pause:            utils.IntRef(600),
+				SuccessThreshold: utils.IntRef(90),
+				FailureThreshold: utils.IntRef(10),
+			},
+			Runs: payloads(2),
+		})
+
+		rollout := fetchRollout(*(*runs)[0].GroupId)
+		Expect(rollout.Pause).To(Equal(600))
+		Expect(rollout.SuccessThreshold).To(Equal(90))
+		Expect(rollout.FailureThreshold).To(Equal(10))
+	})
+
+	It("rejects runs of different organizations", func() {
+		runs := payloads(2)
+		runs[1].OrgId = "12900172"
+
+		_, res := dispatchRolloutV2Raw(&ApiInternalV2RunsCreateRolloutJSONRequestBody{
+			Strategy: RolloutStrategy{WaveSize: 1},
+			Runs:     runs,
+		})
+
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(res.JSON400.Message).To(Equal("all runs of a rollout need to belong to the same organization"))
+	})
+
+	It("rejects runs with not_before", func() {
+		runs := payloads(2)
+		runs[1].NotBefore = utils.TimeRef(time.Now().Add(time.Hour))
+
+		_, res := dispatchRolloutV2Raw(&ApiInternalV2RunsCreateRolloutJSONRequestBody{
+			Strategy: RolloutStrategy{WaveSize: 1},
+			Runs:     runs,
+		})
+
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(res.JSON400.Message).To(Equal("not_before cannot be used together with a rollout"))
+	})
+
+	DescribeTable("validation",
+		func(payload, expected string) {
+			resp, err := client.ApiInternalV2RunsCreateRolloutWithBody(test.TestContext(), nil, "application/json", strings.NewReader(payload))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(body)).To(ContainSubstring(expected))
+		},
+
+		Entry("no runs", `{"strategy": {"wave_size": 1}, "runs": []}`, "minimum number of items is 1"),
+		Entry("missing wave size", `{"strategy": {}, "runs": [{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test_user", "url": "http://example.com", "name": "ansible playbook"}]}`, "wave_size"),
+		Entry("zero wave size", `{"strategy": {"wave_size": 0}, "runs": [{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test_user", "url": "http://example.com", "name": "ansible playbook"}]}`, "number must be at least 1"),
+		Entry("canary percentage out of range", `{"strategy": {"wave_size": 1, "canary_percentage": 101}, "runs": [{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test_user", "url": "http://example.com", "name": "ansible playbook"}]}`, "number must be at most 100"),
+		Entry("canary and canary percentage", `{"strategy": {"wave_size": 1, "canary": 1, "canary_percentage": 10}, "runs": [{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test_user", "url": "http://example.com", "name": "ansible playbook"}]}`, "canary and canary_percentage cannot be used together"),
+	)
+})
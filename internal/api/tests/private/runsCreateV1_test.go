@@ -131,7 +131,7 @@ var _ = Describe("runsCreate V1", func() {
 			Expect((*runs)[0].Code).To(Equal(404))
 		})
 
-		It("500s on cloud connector error", func() {
+		It("leaves the signal pending on cloud connector error", func() {
 			recipient := uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587")
 			url := "http://example.com"
 
@@ -147,7 +147,12 @@ var _ = Describe("runsCreate V1", func() {
 			runs, _ := dispatch(&payload)
 
 			Expect(*runs).To(HaveLen(1))
-			Expect((*runs)[0].Code).To(Equal(500))
+			Expect((*runs)[0].Code).To(Equal(201))
+
+			var signal dbModel.OutboxSignal
+			result := db().Where("run_id = ?", (*runs)[0].Id).First(&signal)
+			Expect(result.Error).ToNot(HaveOccurred())
+			Expect(signal.Status).To(Equal(dbModel.OutboxSignalStatusPending))
 		})
 
 		It("stores the principal as owning service", func() {
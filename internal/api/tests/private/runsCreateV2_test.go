@@ -4,8 +4,10 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"playbook-dispatcher/internal/api/controllers/public"
 	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/common/utils/test"
 	"strings"
 	"time"
@@ -17,7 +19,11 @@ import (
 )
 
 func dispatchV2(payload *ApiInternalV2RunsCreateJSONRequestBody) (*RunsCreated, *ApiInternalV2RunsCreateResponse) {
-	resp, err := client.ApiInternalV2RunsCreate(test.TestContext(), *payload)
+	return dispatchV2WithParams(nil, payload)
+}
+
+func dispatchV2WithParams(params *ApiInternalV2RunsCreateParams, payload *ApiInternalV2RunsCreateJSONRequestBody) (*RunsCreated, *ApiInternalV2RunsCreateResponse) {
+	resp, err := client.ApiInternalV2RunsCreate(test.TestContext(), params, *payload)
 	Expect(err).ToNot(HaveOccurred())
 	res, err := ParseApiInternalV2RunsCreateResponse(resp)
 	Expect(err).ToNot(HaveOccurred())
@@ -299,7 +305,7 @@ var _ = Describe("runsCreate V2", func() {
 		Expect(*run.SatOrgId).To(Equal(satOrgId))
 	})
 
-	It("fails on sat_id mismatch in cloud connector", func() {
+	It("leaves the signal pending on sat_id mismatch in cloud connector", func() {
 		recipient := uuid.MustParse("9200e4a3-c97c-4021-9856-82fa4673e8d2")
 		url := "http://example.com"
 		orgId := "5318290"
@@ -328,7 +334,12 @@ var _ = Describe("runsCreate V2", func() {
 		runs, _ := dispatchV2(&payload)
 
 		Expect(*runs).To(HaveLen(1))
-		Expect((*runs)[0].Code).To(Equal(500))
+		Expect((*runs)[0].Code).To(Equal(201))
+
+		var signal dbModel.OutboxSignal
+		result := db().Where("run_id = ?", (*runs)[0].Id).First(&signal)
+		Expect(result.Error).ToNot(HaveOccurred())
+		Expect(signal.Status).To(Equal(dbModel.OutboxSignalStatusPending))
 	})
 
 	It("sets default for webConsoleUrl", func() {
@@ -348,11 +359,16 @@ var _ = Describe("runsCreate V2", func() {
 
 	It("404s if the recipient is not known", func() {
 		payload := minimalV2Payload(uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199"))
+		payload.Labels = &public.Labels{"outbox": "not-found"}
 
 		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
 
 		Expect(*runs).To(HaveLen(1))
 		Expect((*runs)[0].Code).To(Equal(404))
+
+		var count int64
+		db().Model(&dbModel.Run{}).Where("recipient = ?", payload.Recipient).Where("labels ->> 'outbox' = ?", "not-found").Count(&count)
+		Expect(count).To(BeZero())
 	})
 
 	It("Successfully handles an anemic tenant", func() {
@@ -365,13 +381,35 @@ var _ = Describe("runsCreate V2", func() {
 		Expect((*runs)[0].Code).To(Equal(201))
 	})
 
-	It("500s on cloud connector error", func() {
+	It("leaves the signal pending on cloud connector error", func() {
 		payload := minimalV2Payload(uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587"))
 
 		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
 
 		Expect(*runs).To(HaveLen(1))
-		Expect((*runs)[0].Code).To(Equal(500))
+		Expect((*runs)[0].Code).To(Equal(201))
+
+		var signal dbModel.OutboxSignal
+		result := db().Where("run_id = ?", (*runs)[0].Id).First(&signal)
+		Expect(result.Error).ToNot(HaveOccurred())
+		Expect(signal.Status).To(Equal(dbModel.OutboxSignalStatusPending))
+		Expect(signal.Attempts).To(BeZero())
+	})
+
+	It("marks the signal as sent", func() {
+		payload := minimalV2Payload(uuid.New())
+
+		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(201))
+
+		var signal dbModel.OutboxSignal
+		result := db().Where("run_id = ?", (*runs)[0].Id).First(&signal)
+		Expect(result.Error).ToNot(HaveOccurred())
+		Expect(signal.Status).To(Equal(dbModel.OutboxSignalStatusSent))
+		Expect(signal.Directive).To(Equal("rhc-worker-playbook"))
+		Expect(signal.MessageID).ToNot(BeNil())
 	})
 
 	It("stores the principal as owning service", func() {
@@ -380,7 +418,7 @@ var _ = Describe("runsCreate V2", func() {
 		}
 
 		ctx := context.WithValue(test.TestContext(), pskKey, "9yh9WuXWDj") //nolint:staticcheck
-		resp, err := client.ApiInternalV2RunsCreate(ctx, payload)
+		resp, err := client.ApiInternalV2RunsCreate(ctx, nil, payload)
 		Expect(err).ToNot(HaveOccurred())
 		res, err := ParseApiInternalRunsCreateResponse(resp)
 		Expect(err).ToNot(HaveOccurred())
@@ -396,6 +434,347 @@ var _ = Describe("runsCreate V2", func() {
 		Expect(run.Service).To(Equal("test02"))
 	})
 
+	It("stores the callback", func() {
+		payload := minimalV2Payload(uuid.New())
+		payload.Callback = &RunCallback{
+			Url:       "https://example.com/callback",
+			SecretRef: "test",
+		}
+
+		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(201))
+
+		var run dbModel.Run
+		result := db().Where("id = ?", (*runs)[0].Id).First(&run)
+		Expect(result.Error).ToNot(HaveOccurred())
+		Expect(*run.CallbackUrl).To(Equal("https://example.com/callback"))
+		Expect(*run.CallbackSecretRef).To(Equal("test"))
+	})
+
+	It("rejects a callback secret_ref that belongs to another principal", func() {
+		payload := minimalV2Payload(uuid.New())
+		payload.Callback = &RunCallback{
+			Url:       "https://example.com/callback",
+			SecretRef: "test",
+		}
+
+		ctx := context.WithValue(test.TestContext(), pskKey, "9yh9WuXWDj") //nolint:staticcheck
+		resp, err := client.ApiInternalV2RunsCreate(ctx, nil, ApiInternalV2RunsCreateJSONRequestBody{payload})
+		Expect(err).ToNot(HaveOccurred())
+		res, err := ParseApiInternalV2RunsCreateResponse(resp)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		Expect(string(res.Body)).To(ContainSubstring("Callback secret_ref test does not belong to test02"))
+	})
+
+	It("schedules a run with not_before in the future", func() {
+		notBefore := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+		notAfter := notBefore.Add(time.Hour)
+
+		payload := minimalV2Payload(uuid.New())
+		payload.NotBefore = &notBefore
+		payload.NotAfter = &notAfter
+
+		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(201))
+
+		var run dbModel.Run
+		result := db().Where("id = ?", (*runs)[0].Id).First(&run)
+		Expect(result.Error).ToNot(HaveOccurred())
+		Expect(run.Status).To(Equal(dbModel.RunStatusScheduled))
+		Expect(run.NotBefore.Equal(notBefore)).To(BeTrue())
+		Expect(run.NotAfter.Equal(notAfter)).To(BeTrue())
+		Expect(run.DispatchedAt).To(BeNil())
+	})
+
+	It("dispatches a run with not_before in the past right away", func() {
+		notBefore := time.Now().Add(-time.Hour)
+
+		payload := minimalV2Payload(uuid.New())
+		payload.NotBefore = &notBefore
+
+		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+		Expect(*runs).To(HaveLen(1))
+		Expect((*runs)[0].Code).To(Equal(201))
+
+		var run dbModel.Run
+		result := db().Where("id = ?", (*runs)[0].Id).First(&run)
+		Expect(result.Error).ToNot(HaveOccurred())
+		Expect(run.Status).To(Equal(dbModel.RunStatusRunning))
+		Expect(run.DispatchedAt).ToNot(BeNil())
+	})
+
+	Describe("idempotency", func() {
+		countSignals := func(runID uuid.UUID) int64 {
+			var count int64
+			Expect(db().Model(&dbModel.OutboxSignal{}).Where("run_id = ?", runID).Count(&count).Error).ToNot(HaveOccurred())
+			return count
+		}
+
+		It("returns the original run for a repeated idempotency_key", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.IdempotencyKey = utils.StringRef(uuid.New().String())
+
+			first, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			second, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			Expect((*first)[0].Code).To(Equal(201))
+			Expect((*second)[0].Code).To(Equal(201))
+			Expect((*second)[0].Id).To(Equal((*first)[0].Id))
+
+			var count int64
+			db().Model(&dbModel.Run{}).Where("idempotency_key = ?", *payload.IdempotencyKey).Count(&count)
+			Expect(count).To(Equal(int64(1)))
+			Expect(countSignals(*(*first)[0].Id)).To(Equal(int64(1)))
+		})
+
+		It("rejects an idempotency_key reused for a different Playbook run", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.IdempotencyKey = utils.StringRef(uuid.New().String())
+
+			first, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*first)[0].Code).To(Equal(201))
+
+			payload.Url = "http://example.com/other-playbook"
+			second, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			Expect((*second)[0].Code).To(Equal(422))
+			Expect(*(*second)[0].Message).To(Equal("Idempotency key " + *payload.IdempotencyKey + " has been used for a different Playbook run"))
+			Expect((*second)[0].Id).To(BeNil())
+
+			var count int64
+			db().Model(&dbModel.Run{}).Where("idempotency_key = ?", *payload.IdempotencyKey).Count(&count)
+			Expect(count).To(Equal(int64(1)))
+		})
+
+		It("returns the original scheduled run for a repeated idempotency_key", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.IdempotencyKey = utils.StringRef(uuid.New().String())
+			payload.NotBefore = utils.TimeRef(time.Now().Add(time.Hour))
+
+			first, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			second, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			Expect((*second)[0].Code).To(Equal(201))
+			Expect((*second)[0].Id).To(Equal((*first)[0].Id))
+		})
+
+		It("derives the keys of the runs from the Idempotency-Key header", func() {
+			key := uuid.New().String()
+			payload := ApiInternalV2RunsCreateJSONRequestBody{minimalV2Payload(uuid.New()), minimalV2Payload(uuid.New())}
+			params := &ApiInternalV2RunsCreateParams{IdempotencyKey: &key}
+
+			first, _ := dispatchV2WithParams(params, &payload)
+			second, _ := dispatchV2WithParams(params, &payload)
+
+			Expect(*second).To(HaveLen(2))
+			Expect((*second)[0].Id).To(Equal((*first)[0].Id))
+			Expect((*second)[1].Id).To(Equal((*first)[1].Id))
+			Expect((*first)[0].Id).ToNot(Equal((*first)[1].Id))
+
+			var run dbModel.Run
+			Expect(db().First(&run, *(*first)[1].Id).Error).ToNot(HaveOccurred())
+			Expect(*run.IdempotencyKey).To(Equal(key + ":1"))
+		})
+
+		It("prefers the idempotency_key of the run over the header", func() {
+			key := uuid.New().String()
+			payload := minimalV2Payload(uuid.New())
+			payload.IdempotencyKey = utils.StringRef(uuid.New().String())
+
+			runs, _ := dispatchV2WithParams(&ApiInternalV2RunsCreateParams{IdempotencyKey: &key}, &ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			var run dbModel.Run
+			Expect(db().First(&run, *(*runs)[0].Id).Error).ToNot(HaveOccurred())
+			Expect(run.IdempotencyKey).To(Equal(payload.IdempotencyKey))
+		})
+
+		It("creates a new run once the idempotency window has passed", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.IdempotencyKey = utils.StringRef(uuid.New().String())
+
+			first, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect(db().Model(&dbModel.Run{}).Where("id = ?", *(*first)[0].Id).Update("created_at", time.Now().Add(-48*time.Hour)).Error).ToNot(HaveOccurred())
+
+			second, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			Expect((*second)[0].Code).To(Equal(201))
+			Expect((*second)[0].Id).ToNot(Equal((*first)[0].Id))
+
+			var run dbModel.Run
+			Expect(db().First(&run, *(*first)[0].Id).Error).ToNot(HaveOccurred())
+			Expect(run.IdempotencyKey).To(BeNil())
+		})
+
+		It("does not share keys between organizations", func() {
+			key := utils.StringRef(uuid.New().String())
+
+			payload := minimalV2Payload(uuid.New())
+			payload.IdempotencyKey = key
+			first, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			payload.OrgId = "12900172"
+			second, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			Expect((*second)[0].Code).To(Equal(201))
+			Expect((*second)[0].Id).ToNot(Equal((*first)[0].Id))
+		})
+	})
+
+	Describe("concurrency policy", func() {
+		newRunningRun := func(recipient uuid.UUID) dbModel.Run {
+			run := test.NewRun("5318290")
+			run.Recipient = recipient
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+			return run
+		}
+
+		policyPayload := func(recipient uuid.UUID, policy ConcurrencyPolicy) RunInputV2 {
+			payload := minimalV2Payload(recipient)
+			payload.ConcurrencyPolicy = &policy
+			return payload
+		}
+
+		fetchRun := func(id uuid.UUID) dbModel.Run {
+			var run dbModel.Run
+			Expect(db().First(&run, id).Error).ToNot(HaveOccurred())
+			return run
+		}
+
+		It("409s if another run is running on the recipient", func() {
+			recipient := uuid.New()
+			newRunningRun(recipient)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{policyPayload(recipient, Reject)})
+
+			Expect(*runs).To(HaveLen(1))
+			Expect((*runs)[0].Code).To(Equal(409))
+
+			var count int64
+			db().Model(&dbModel.Run{}).Where("recipient = ?", recipient).Count(&count)
+			Expect(count).To(Equal(int64(1)))
+		})
+
+		It("queues the run if another run is running on the recipient", func() {
+			recipient := uuid.New()
+			newRunningRun(recipient)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{policyPayload(recipient, Queue)})
+
+			Expect(*runs).To(HaveLen(1))
+			Expect((*runs)[0].Code).To(Equal(201))
+
+			run := fetchRun(*(*runs)[0].Id)
+			Expect(run.Status).To(Equal(dbModel.RunStatusScheduled))
+			Expect(run.NotBefore).ToNot(BeNil())
+			Expect(run.DispatchedAt).To(BeNil())
+			Expect(*run.ConcurrencyPolicy).To(Equal(dbModel.ConcurrencyPolicyQueue))
+
+			var count int64
+			db().Model(&dbModel.OutboxSignal{}).Where("run_id = ?", run.ID).Count(&count)
+			Expect(count).To(BeZero())
+		})
+
+		DescribeTable("dispatches the run if the recipient is free",
+			func(policy ConcurrencyPolicy) {
+				recipient := uuid.New()
+				finished := test.NewRunWithStatus("5318290", dbModel.RunStatusSuccess)
+				finished.Recipient = recipient
+				Expect(db().Create(&finished).Error).ToNot(HaveOccurred())
+
+				runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{policyPayload(recipient, policy)})
+
+				Expect((*runs)[0].Code).To(Equal(201))
+				Expect(fetchRun(*(*runs)[0].Id).Status).To(Equal(dbModel.RunStatusRunning))
+			},
+
+			Entry("reject", Reject),
+			Entry("queue", Queue),
+		)
+
+		It("dispatches the run regardless using the allow policy", func() {
+			recipient := uuid.New()
+			newRunningRun(recipient)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{policyPayload(recipient, Allow)})
+
+			Expect((*runs)[0].Code).To(Equal(201))
+			Expect(fetchRun(*(*runs)[0].Id).Status).To(Equal(dbModel.RunStatusRunning))
+		})
+
+		It("checks the hosts of a satellite run", func() {
+			inventoryId := uuid.New()
+			satId := uuid.New().String()
+			satOrgId := "123"
+
+			running := newRunningRun(uuid.New())
+			host := test.NewRunHost(running.ID, dbModel.RunStatusRunning, &inventoryId)
+			Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+			busy := policyPayload(uuid.New(), Reject)
+			busy.Hosts = &RunInputHosts{{InventoryId: &inventoryId}}
+			busy.RecipientConfig = &RecipientConfig{SatId: &satId, SatOrgId: &satOrgId}
+
+			otherHost := uuid.New()
+			free := policyPayload(uuid.New(), Reject)
+			free.Hosts = &RunInputHosts{{InventoryId: &otherHost}}
+			free.RecipientConfig = &RecipientConfig{SatId: &satId, SatOrgId: &satOrgId}
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{busy, free})
+
+			Expect(*runs).To(HaveLen(2))
+			Expect((*runs)[0].Code).To(Equal(409))
+			Expect((*runs)[1].Code).To(Equal(201))
+		})
+	})
+
+	It("429s items over the quota of the organization", func() {
+		payload := ApiInternalV2RunsCreateJSONRequestBody{
+			minimalV2Payload(uuid.New()),
+			minimalV2Payload(uuid.New()),
+			minimalV2Payload(uuid.New()),
+		}
+
+		for i := range payload {
+			payload[i].OrgId = quotaOrgId
+		}
+
+		runs, _ := dispatchV2(&payload)
+
+		Expect(*runs).To(HaveLen(3))
+
+		codes := []int{}
+		for _, run := range *runs {
+			codes = append(codes, run.Code)
+
+			if run.Code == http.StatusTooManyRequests {
+				Expect(*run.Message).To(Equal("Quota exceeded for org_id " + quotaOrgId))
+				Expect(run.Id).To(BeNil())
+			}
+		}
+
+		Expect(codes).To(ConsistOf(201, 201, 429))
+	})
+
+	It("does not count a run that is not created against the quota", func() {
+		rejected := minimalV2Payload(uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199"))
+		rejected.OrgId = refundQuotaOrgId
+
+		runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{rejected})
+		Expect((*runs)[0].Code).To(Equal(404))
+
+		accepted := minimalV2Payload(uuid.New())
+		accepted.OrgId = refundQuotaOrgId
+
+		runs, _ = dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{accepted})
+		Expect((*runs)[0].Code).To(Equal(201))
+	})
+
 	It("enforces rate limit", func() {
 		payload := ApiInternalV2RunsCreateJSONRequestBody{
 			minimalV2Payload(uuid.New()),
@@ -404,7 +783,7 @@ var _ = Describe("runsCreate V2", func() {
 		start := time.Now()
 		// send 10 requests
 		for i := 0; i < 10; i++ {
-			_, err := client.ApiInternalV2RunsCreate(test.TestContext(), payload)
+			_, err := client.ApiInternalV2RunsCreate(test.TestContext(), nil, payload)
 			Expect(err).ToNot(HaveOccurred())
 		}
 		end := time.Since(start)
@@ -412,9 +791,202 @@ var _ = Describe("runsCreate V2", func() {
 		Expect(end).To(BeNumerically(">=", time.Second))
 	})
 
+	Describe("run groups", func() {
+		fetchGroup := func(id uuid.UUID) (dbModel.RunGroup, error) {
+			var group dbModel.RunGroup
+			err := db().First(&group, id).Error
+			return group, err
+		}
+
+		It("puts the runs of a request in a single group", func() {
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{
+				minimalV2Payload(uuid.New()),
+				minimalV2Payload(uuid.New()),
+			})
+
+			Expect(*runs).To(HaveLen(2))
+			Expect((*runs)[0].GroupId).ToNot(BeNil())
+			Expect((*runs)[1].GroupId).To(Equal((*runs)[0].GroupId))
+
+			group, err := fetchGroup(*(*runs)[0].GroupId)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(group.OrgID).To(Equal("5318290"))
+			Expect(group.Service).To(Equal("test"))
+
+			var run dbModel.Run
+			Expect(db().First(&run, *(*runs)[0].Id).Error).ToNot(HaveOccurred())
+			Expect(run.GroupID).To(Equal((*runs)[0].GroupId))
+		})
+
+		It("creates a group per organization", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.OrgId = "12900172"
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{minimalV2Payload(uuid.New()), payload})
+
+			Expect(*runs).To(HaveLen(2))
+			Expect((*runs)[0].GroupId).ToNot(Equal((*runs)[1].GroupId))
+		})
+
+		It("does not keep a group none of the runs were created in", func() {
+			payload := minimalV2Payload(uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199"))
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+
+			Expect((*runs)[0].Code).To(Equal(404))
+			Expect((*runs)[0].GroupId).To(BeNil())
+		})
+	})
+
+	Describe("retry policy", func() {
+		It("stores the retry policy of the run", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.RetryPolicy = &RetryPolicy{
+				MaxAttempts: 3,
+				Statuses:    []RetryPolicyStatuses{Failure, Unreachable},
+			}
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*runs)[0].Code).To(Equal(201))
+
+			var run dbModel.Run
+			Expect(db().First(&run, *(*runs)[0].Id).Error).ToNot(HaveOccurred())
+			Expect(run.Attempt).To(Equal(1))
+			Expect(run.ParentRunID).To(BeNil())
+			Expect(run.RetryPolicy.MaxAttempts).To(Equal(3))
+			Expect(run.RetryPolicy.Backoff).To(Equal(60))
+			Expect(run.RetryPolicy.Statuses).To(Equal([]string{"failure", "unreachable"}))
+		})
+	})
+
+	Describe("playbook registry", func() {
+		It("does not create a run if the Playbook cannot be fetched", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.Url = "http://localhost:1/playbook.yml"
+			payload.PlaybookSignature = utils.StringRef("c2lnbmF0dXJl")
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*runs)[0].Code).To(Equal(502))
+			Expect(*(*runs)[0].Message).To(ContainSubstring("Playbook cannot be fetched"))
+			Expect((*runs)[0].Id).To(BeNil())
+		})
+	})
+
+	Describe("extra vars", func() {
+		It("stores the extra vars of the run", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.ExtraVars = &ExtraVars{"package": "openssl", "token": "s3cr3t"}
+			payload.ExtraVarsSchema = &ExtraVarsSchema{"type": "object", "required": []string{"package"}}
+			payload.SecretVars = &SecretVars{"token"}
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*runs)[0].Code).To(Equal(201))
+
+			var run dbModel.Run
+			result := db().Where("id = ?", (*runs)[0].Id).First(&run)
+			Expect(result.Error).ToNot(HaveOccurred())
+			Expect(run.ExtraVars.Values).To(Equal(map[string]interface{}{"package": "openssl", "token": "s3cr3t"}))
+			Expect(run.ExtraVars.Secrets).To(Equal([]string{"token"}))
+		})
+	})
+
+	Describe("check mode", func() {
+		It("flags the run as a check run", func() {
+			payload := minimalV2Payload(uuid.New())
+			mode := public.RunModeCheck
+			payload.Mode = &mode
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*runs)[0].Code).To(Equal(201))
+
+			var run dbModel.Run
+			result := db().Where("id = ?", (*runs)[0].Id).First(&run)
+			Expect(result.Error).ToNot(HaveOccurred())
+			Expect(run.Mode).To(Equal(dbModel.RunModeCheck))
+		})
+
+		It("defaults to the run mode", func() {
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{minimalV2Payload(uuid.New())})
+			Expect((*runs)[0].Code).To(Equal(201))
+
+			var run dbModel.Run
+			result := db().Where("id = ?", (*runs)[0].Id).First(&run)
+			Expect(result.Error).ToNot(HaveOccurred())
+			Expect(run.Mode).To(Equal(dbModel.RunModeRun))
+		})
+	})
+
+	Describe("preflight", func() {
+		servePlaybook := func(content string) *httptest.Server {
+			return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(content))
+			}))
+		}
+
+		It("creates a run if the Playbook passes the preflight", func() {
+			server := servePlaybook(`
+- hosts: localhost
+  vars:
+    insights_signature_exclude: /hosts,/vars/insights_signature
+    insights_signature: c2lnbmF0dXJl
+  tasks:
+  - ping:
+`)
+			defer server.Close()
+
+			payload := minimalV2Payload(uuid.New())
+			payload.Url = server.URL
+			payload.Preflight = utils.BoolRef(true)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*runs)[0].Code).To(Equal(201))
+		})
+
+		It("does not create a run if the Playbook is malformed", func() {
+			server := servePlaybook("- hosts: localhost\n  tasks:\n  - ping:\n")
+			defer server.Close()
+
+			payload := minimalV2Payload(uuid.New())
+			payload.Url = server.URL
+			payload.Preflight = utils.BoolRef(true)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*runs)[0].Code).To(Equal(400))
+			Expect(*(*runs)[0].Message).To(Equal("Playbook preflight failed: play 0 does not define vars"))
+			Expect((*runs)[0].Id).To(BeNil())
+		})
+
+		It("does not count a rejected Playbook against the quota", func() {
+			server := servePlaybook("- hosts: localhost\n  tasks:\n  - ping:\n")
+			defer server.Close()
+
+			rejected := minimalV2Payload(uuid.New())
+			rejected.OrgId = preflightQuotaOrgId
+			rejected.Url = server.URL
+			rejected.Preflight = utils.BoolRef(true)
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{rejected})
+			Expect((*runs)[0].Code).To(Equal(400))
+
+			accepted := minimalV2Payload(uuid.New())
+			accepted.OrgId = preflightQuotaOrgId
+
+			runs, _ = dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{accepted})
+			Expect((*runs)[0].Code).To(Equal(201))
+		})
+
+		It("does not fetch the Playbook unless asked to", func() {
+			payload := minimalV2Payload(uuid.New())
+			payload.Url = "http://localhost:1/playbook.yml"
+
+			runs, _ := dispatchV2(&ApiInternalV2RunsCreateJSONRequestBody{payload})
+			Expect((*runs)[0].Code).To(Equal(201))
+		})
+	})
+
 	DescribeTable("validation",
 		func(payload, expected string) {
-			resp, err := client.ApiInternalV2RunsCreateWithBody(test.TestContext(), "application/json", strings.NewReader(payload))
+			resp, err := client.ApiInternalV2RunsCreateWithBody(test.TestContext(), nil, "application/json", strings.NewReader(payload))
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
 			body, err := io.ReadAll(resp.Body)
@@ -514,5 +1086,104 @@ var _ = Describe("runsCreate V2", func() {
 			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "recipient_config": {"sat_org_id": "1"}}]`,
 			`Both sat_id and sat_org need to be defined`,
 		),
+		Entry(
+			"check mode on Satellite",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "recipient_config": {"sat_id": "e7ee7fdd-b732-4eea-a070-40025b3dddd9", "sat_org_id": "1"}, "hosts": [{"inventory_id": "16372e6f-1c18-4cdb-b780-50ab4b88e74b"}], "mode": "check"}]`,
+			`Check mode is not supported for Satellite`,
+		),
+
+		// callback
+		Entry(
+			"invalid callback URL",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "callback": {"url": "blahblah", "secret_ref": "test"}}]`,
+			`string doesn't match the format \"url\"`,
+		),
+		Entry(
+			"callback secret_ref missing",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "callback": {"url": "https://example.com/callback"}}]`,
+			`property \"secret_ref\" is missing`,
+		),
+		Entry(
+			"callback URL not using https",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "callback": {"url": "http://example.com/callback", "secret_ref": "test"}}]`,
+			`Invalid callback URL: URL needs to use https: http://example.com/callback`,
+		),
+		Entry(
+			"callback host not allowed",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "callback": {"url": "https://169.254.169.254/latest/meta-data", "secret_ref": "test"}}]`,
+			`Invalid callback URL: Host not allowed: 169.254.169.254`,
+		),
+		Entry(
+			"unknown callback secret_ref",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "callback": {"url": "https://example.com/callback", "secret_ref": "salad"}}]`,
+			`Unknown callback secret_ref: salad`,
+		),
+
+		// schedule
+		Entry(
+			"invalid not_before",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "not_before": "tomorrow"}]`,
+			`string doesn't match the format \"date-time\"`,
+		),
+		Entry(
+			"not_after without not_before",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "not_after": "2099-01-01T02:00:00Z"}]`,
+			`not_after requires not_before to be defined`,
+		),
+		Entry(
+			"not_after before not_before",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "not_before": "2099-01-01T02:00:00Z", "not_after": "2099-01-01T01:00:00Z"}]`,
+			`not_after needs to be later than not_before`,
+		),
+
+		// concurrency policy
+		Entry(
+			"invalid concurrency_policy",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "concurrency_policy": "wait"}]`,
+			"value is not one of the allowed values",
+		),
+
+		// retry policy
+		Entry(
+			"retry_policy with a single attempt",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "retry_policy": {"max_attempts": 1, "statuses": ["failure"]}}]`,
+			"number must be at least 2",
+		),
+		Entry(
+			"retry_policy without statuses",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "retry_policy": {"max_attempts": 3, "statuses": []}}]`,
+			"minimum number of items is 1",
+		),
+		Entry(
+			"retry_policy with a status that is not final",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "retry_policy": {"max_attempts": 3, "statuses": ["running"]}}]`,
+			"value is not one of the allowed values",
+		),
+
+		// idempotency
+		Entry(
+			"empty idempotency_key",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "idempotency_key": ""}]`,
+			"minimum string length is 1",
+		),
+
+		// playbook registry
+		Entry(
+			"playbook_digest of another algorithm",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "playbook_digest": "md5:9e107d9d372bb6826bd81d3542a419d6"}]`,
+			"doesn't match the regular expression",
+		),
+
+		// extra vars
+		Entry(
+			"extra_vars not matching extra_vars_schema",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "extra_vars": {"reboot": "yes"}, "extra_vars_schema": {"properties": {"reboot": {"type": "boolean"}}}}]`,
+			"extra_vars do not match extra_vars_schema",
+		),
+		Entry(
+			"secret_vars without extra_vars",
+			`[{"recipient": "3831fec2-1875-432a-bb58-08e71908f0e6", "org_id": "5318290", "principal": "test-user", "url": "http://example.com", "name": "Red Hat Playbook", "secret_vars": ["token"]}]`,
+			"secret_vars require extra_vars",
+		),
 	)
 })
@@ -17,13 +17,103 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for RunGroupRolloutStatus.
+const (
+	RunGroupRolloutStatusCompleted  RunGroupRolloutStatus = "completed"
+	RunGroupRolloutStatusHalted     RunGroupRolloutStatus = "halted"
+	RunGroupRolloutStatusInProgress RunGroupRolloutStatus = "in_progress"
+)
+
+// Valid indicates whether the value is a known member of the RunGroupRolloutStatus enum.
+func (e RunGroupRolloutStatus) Valid() bool {
+	switch e {
+	case RunGroupRolloutStatusCompleted:
+		return true
+	case RunGroupRolloutStatusHalted:
+		return true
+	case RunGroupRolloutStatusInProgress:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunGroupStatus.
+const (
+	RunGroupStatusFailure RunGroupStatus = "failure"
+	RunGroupStatusPartial RunGroupStatus = "partial"
+	RunGroupStatusRunning RunGroupStatus = "running"
+	RunGroupStatusSuccess RunGroupStatus = "success"
+)
+
+// Valid indicates whether the value is a known member of the RunGroupStatus enum.
+func (e RunGroupStatus) Valid() bool {
+	switch e {
+	case RunGroupStatusFailure:
+		return true
+	case RunGroupStatusPartial:
+		return true
+	case RunGroupStatusRunning:
+		return true
+	case RunGroupStatusSuccess:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunGroupStatusNullable.
+const (
+	RunGroupStatusNullableFailure RunGroupStatusNullable = "failure"
+	RunGroupStatusNullablePartial RunGroupStatusNullable = "partial"
+	RunGroupStatusNullableRunning RunGroupStatusNullable = "running"
+	RunGroupStatusNullableSuccess RunGroupStatusNullable = "success"
+)
+
+// Valid indicates whether the value is a known member of the RunGroupStatusNullable enum.
+func (e RunGroupStatusNullable) Valid() bool {
+	switch e {
+	case RunGroupStatusNullableFailure:
+		return true
+	case RunGroupStatusNullablePartial:
+		return true
+	case RunGroupStatusNullableRunning:
+		return true
+	case RunGroupStatusNullableSuccess:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunMode.
+const (
+	RunModeCheck RunMode = "check"
+	RunModeRun   RunMode = "run"
+)
+
+// Valid indicates whether the value is a known member of the RunMode enum.
+func (e RunMode) Valid() bool {
+	switch e {
+	case RunModeCheck:
+		return true
+	case RunModeRun:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for RunStatus.
 const (
-	RunStatusCanceled RunStatus = "canceled"
-	RunStatusFailure  RunStatus = "failure"
-	RunStatusRunning  RunStatus = "running"
-	RunStatusSuccess  RunStatus = "success"
-	RunStatusTimeout  RunStatus = "timeout"
+	RunStatusCanceled    RunStatus = "canceled"
+	RunStatusExpired     RunStatus = "expired"
+	RunStatusFailure     RunStatus = "failure"
+	RunStatusRunning     RunStatus = "running"
+	RunStatusScheduled   RunStatus = "scheduled"
+	RunStatusSuccess     RunStatus = "success"
+	RunStatusTimeout     RunStatus = "timeout"
+	RunStatusUnreachable RunStatus = "unreachable"
 )
 
 // Valid indicates whether the value is a known member of the RunStatus enum.
@@ -31,14 +121,38 @@ func (e RunStatus) Valid() bool {
 	switch e {
 	case RunStatusCanceled:
 		return true
+	case RunStatusExpired:
+		return true
 	case RunStatusFailure:
 		return true
 	case RunStatusRunning:
 		return true
+	case RunStatusScheduled:
+		return true
 	case RunStatusSuccess:
 		return true
 	case RunStatusTimeout:
 		return true
+	case RunStatusUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for RunStatusChangeType.
+const (
+	RunStatusChangeTypeRun     RunStatusChangeType = "run"
+	RunStatusChangeTypeRunHost RunStatusChangeType = "run_host"
+)
+
+// Valid indicates whether the value is a known member of the RunStatusChangeType enum.
+func (e RunStatusChangeType) Valid() bool {
+	switch e {
+	case RunStatusChangeTypeRun:
+		return true
+	case RunStatusChangeTypeRunHost:
+		return true
 	default:
 		return false
 	}
@@ -46,11 +160,14 @@ func (e RunStatus) Valid() bool {
 
 // Defines values for StatusNullable.
 const (
-	StatusNullableCanceled StatusNullable = "canceled"
-	StatusNullableFailure  StatusNullable = "failure"
-	StatusNullableRunning  StatusNullable = "running"
-	StatusNullableSuccess  StatusNullable = "success"
-	StatusNullableTimeout  StatusNullable = "timeout"
+	StatusNullableCanceled    StatusNullable = "canceled"
+	StatusNullableExpired     StatusNullable = "expired"
+	StatusNullableFailure     StatusNullable = "failure"
+	StatusNullableRunning     StatusNullable = "running"
+	StatusNullableScheduled   StatusNullable = "scheduled"
+	StatusNullableSuccess     StatusNullable = "success"
+	StatusNullableTimeout     StatusNullable = "timeout"
+	StatusNullableUnreachable StatusNullable = "unreachable"
 )
 
 // Valid indicates whether the value is a known member of the StatusNullable enum.
@@ -58,14 +175,47 @@ func (e StatusNullable) Valid() bool {
 	switch e {
 	case StatusNullableCanceled:
 		return true
+	case StatusNullableExpired:
+		return true
 	case StatusNullableFailure:
 		return true
 	case StatusNullableRunning:
 		return true
+	case StatusNullableScheduled:
+		return true
 	case StatusNullableSuccess:
 		return true
 	case StatusNullableTimeout:
 		return true
+	case StatusNullableUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for TaskResultStatus.
+const (
+	TaskResultStatusChanged     TaskResultStatus = "changed"
+	TaskResultStatusFailed      TaskResultStatus = "failed"
+	TaskResultStatusOk          TaskResultStatus = "ok"
+	TaskResultStatusSkipped     TaskResultStatus = "skipped"
+	TaskResultStatusUnreachable TaskResultStatus = "unreachable"
+)
+
+// Valid indicates whether the value is a known member of the TaskResultStatus enum.
+func (e TaskResultStatus) Valid() bool {
+	switch e {
+	case TaskResultStatusChanged:
+		return true
+	case TaskResultStatusFailed:
+		return true
+	case TaskResultStatusOk:
+		return true
+	case TaskResultStatusSkipped:
+		return true
+	case TaskResultStatusUnreachable:
+		return true
 	default:
 		return false
 	}
@@ -98,6 +248,7 @@ const (
 	ApiRunHostsListParamsFieldsDataInventoryId ApiRunHostsListParamsFieldsData = "inventory_id"
 	ApiRunHostsListParamsFieldsDataLinks       ApiRunHostsListParamsFieldsData = "links"
 	ApiRunHostsListParamsFieldsDataRun         ApiRunHostsListParamsFieldsData = "run"
+	ApiRunHostsListParamsFieldsDataStats       ApiRunHostsListParamsFieldsData = "stats"
 	ApiRunHostsListParamsFieldsDataStatus      ApiRunHostsListParamsFieldsData = "status"
 	ApiRunHostsListParamsFieldsDataStdout      ApiRunHostsListParamsFieldsData = "stdout"
 )
@@ -113,6 +264,8 @@ func (e ApiRunHostsListParamsFieldsData) Valid() bool {
 		return true
 	case ApiRunHostsListParamsFieldsDataRun:
 		return true
+	case ApiRunHostsListParamsFieldsDataStats:
+		return true
 	case ApiRunHostsListParamsFieldsDataStatus:
 		return true
 	case ApiRunHostsListParamsFieldsDataStdout:
@@ -124,12 +277,17 @@ func (e ApiRunHostsListParamsFieldsData) Valid() bool {
 
 // Defines values for ApiRunsListParamsFieldsData.
 const (
+	ApiRunsListParamsFieldsDataAttempt       ApiRunsListParamsFieldsData = "attempt"
 	ApiRunsListParamsFieldsDataCorrelationId ApiRunsListParamsFieldsData = "correlation_id"
 	ApiRunsListParamsFieldsDataCreatedAt     ApiRunsListParamsFieldsData = "created_at"
+	ApiRunsListParamsFieldsDataExtraVars     ApiRunsListParamsFieldsData = "extra_vars"
+	ApiRunsListParamsFieldsDataGroupId       ApiRunsListParamsFieldsData = "group_id"
 	ApiRunsListParamsFieldsDataId            ApiRunsListParamsFieldsData = "id"
 	ApiRunsListParamsFieldsDataLabels        ApiRunsListParamsFieldsData = "labels"
+	ApiRunsListParamsFieldsDataMode          ApiRunsListParamsFieldsData = "mode"
 	ApiRunsListParamsFieldsDataName          ApiRunsListParamsFieldsData = "name"
 	ApiRunsListParamsFieldsDataOrgId         ApiRunsListParamsFieldsData = "org_id"
+	ApiRunsListParamsFieldsDataParentRunId   ApiRunsListParamsFieldsData = "parent_run_id"
 	ApiRunsListParamsFieldsDataRecipient     ApiRunsListParamsFieldsData = "recipient"
 	ApiRunsListParamsFieldsDataService       ApiRunsListParamsFieldsData = "service"
 	ApiRunsListParamsFieldsDataStatus        ApiRunsListParamsFieldsData = "status"
@@ -142,18 +300,28 @@ const (
 // Valid indicates whether the value is a known member of the ApiRunsListParamsFieldsData enum.
 func (e ApiRunsListParamsFieldsData) Valid() bool {
 	switch e {
+	case ApiRunsListParamsFieldsDataAttempt:
+		return true
 	case ApiRunsListParamsFieldsDataCorrelationId:
 		return true
 	case ApiRunsListParamsFieldsDataCreatedAt:
 		return true
+	case ApiRunsListParamsFieldsDataExtraVars:
+		return true
+	case ApiRunsListParamsFieldsDataGroupId:
+		return true
 	case ApiRunsListParamsFieldsDataId:
 		return true
 	case ApiRunsListParamsFieldsDataLabels:
 		return true
+	case ApiRunsListParamsFieldsDataMode:
+		return true
 	case ApiRunsListParamsFieldsDataName:
 		return true
 	case ApiRunsListParamsFieldsDataOrgId:
 		return true
+	case ApiRunsListParamsFieldsDataParentRunId:
+		return true
 	case ApiRunsListParamsFieldsDataRecipient:
 		return true
 	case ApiRunsListParamsFieldsDataService:
@@ -194,12 +362,99 @@ func (e ApiRunsListParamsSortBy) Valid() bool {
 	}
 }
 
+// Defines values for ApiRunGetParamsFieldsData.
+const (
+	ApiRunGetParamsFieldsDataAttempt       ApiRunGetParamsFieldsData = "attempt"
+	ApiRunGetParamsFieldsDataCorrelationId ApiRunGetParamsFieldsData = "correlation_id"
+	ApiRunGetParamsFieldsDataCreatedAt     ApiRunGetParamsFieldsData = "created_at"
+	ApiRunGetParamsFieldsDataExtraVars     ApiRunGetParamsFieldsData = "extra_vars"
+	ApiRunGetParamsFieldsDataGroupId       ApiRunGetParamsFieldsData = "group_id"
+	ApiRunGetParamsFieldsDataHostsSummary  ApiRunGetParamsFieldsData = "hosts_summary"
+	ApiRunGetParamsFieldsDataId            ApiRunGetParamsFieldsData = "id"
+	ApiRunGetParamsFieldsDataLabels        ApiRunGetParamsFieldsData = "labels"
+	ApiRunGetParamsFieldsDataMode          ApiRunGetParamsFieldsData = "mode"
+	ApiRunGetParamsFieldsDataName          ApiRunGetParamsFieldsData = "name"
+	ApiRunGetParamsFieldsDataOrgId         ApiRunGetParamsFieldsData = "org_id"
+	ApiRunGetParamsFieldsDataParentRunId   ApiRunGetParamsFieldsData = "parent_run_id"
+	ApiRunGetParamsFieldsDataRecipient     ApiRunGetParamsFieldsData = "recipient"
+	ApiRunGetParamsFieldsDataService       ApiRunGetParamsFieldsData = "service"
+	ApiRunGetParamsFieldsDataStatus        ApiRunGetParamsFieldsData = "status"
+	ApiRunGetParamsFieldsDataTimeout       ApiRunGetParamsFieldsData = "timeout"
+	ApiRunGetParamsFieldsDataUpdatedAt     ApiRunGetParamsFieldsData = "updated_at"
+	ApiRunGetParamsFieldsDataUrl           ApiRunGetParamsFieldsData = "url"
+	ApiRunGetParamsFieldsDataWebConsoleUrl ApiRunGetParamsFieldsData = "web_console_url"
+)
+
+// Valid indicates whether the value is a known member of the ApiRunGetParamsFieldsData enum.
+func (e ApiRunGetParamsFieldsData) Valid() bool {
+	switch e {
+	case ApiRunGetParamsFieldsDataAttempt:
+		return true
+	case ApiRunGetParamsFieldsDataCorrelationId:
+		return true
+	case ApiRunGetParamsFieldsDataCreatedAt:
+		return true
+	case ApiRunGetParamsFieldsDataExtraVars:
+		return true
+	case ApiRunGetParamsFieldsDataGroupId:
+		return true
+	case ApiRunGetParamsFieldsDataHostsSummary:
+		return true
+	case ApiRunGetParamsFieldsDataId:
+		return true
+	case ApiRunGetParamsFieldsDataLabels:
+		return true
+	case ApiRunGetParamsFieldsDataMode:
+		return true
+	case ApiRunGetParamsFieldsDataName:
+		return true
+	case ApiRunGetParamsFieldsDataOrgId:
+		return true
+	case ApiRunGetParamsFieldsDataParentRunId:
+		return true
+	case ApiRunGetParamsFieldsDataRecipient:
+		return true
+	case ApiRunGetParamsFieldsDataService:
+		return true
+	case ApiRunGetParamsFieldsDataStatus:
+		return true
+	case ApiRunGetParamsFieldsDataTimeout:
+		return true
+	case ApiRunGetParamsFieldsDataUpdatedAt:
+		return true
+	case ApiRunGetParamsFieldsDataUrl:
+		return true
+	case ApiRunGetParamsFieldsDataWebConsoleUrl:
+		return true
+	default:
+		return false
+	}
+}
+
 // Account Identifier of the tenant
 type Account = string
 
 // CreatedAt A timestamp when the entry was created
 type CreatedAt = time.Time
 
+// DiffChange A single change as reported by Ansible (--diff)
+type DiffChange struct {
+	// After State after the change
+	After *interface{} `json:"after,omitempty"`
+
+	// AfterHeader Label of the state after the change (e.g. a file path)
+	AfterHeader *string `json:"after_header,omitempty"`
+
+	// Before State before the change
+	Before *interface{} `json:"before,omitempty"`
+
+	// BeforeHeader Label of the state before the change (e.g. a file path)
+	BeforeHeader *string `json:"before_header,omitempty"`
+
+	// Prepared Change pre-rendered by the module (e.g. a unified diff)
+	Prepared *string `json:"prepared,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
@@ -216,8 +471,8 @@ type Links struct {
 	// First relative link to the first page of the query results
 	First string `json:"first"`
 
-	// Last relative link to the last page of the query results
-	Last string `json:"last"`
+	// Last relative link to the last page of the query results. Omitted if the total number of results is not known.
+	Last *string `json:"last,omitempty"`
 
 	// Next relative link to the next page of the query results
 	Next *string `json:"next,omitempty"`
@@ -231,13 +486,16 @@ type Meta struct {
 	// Count number of results returned
 	Count int `json:"count"`
 
-	// Total total number of results matching the query
-	Total int `json:"total"`
+	// Total total number of results matching the query. Omitted if `include_total` is false.
+	Total *int `json:"total,omitempty"`
 }
 
 // OrgId Identifier of the tenant
 type OrgId = string
 
+// ParentRunId Identifier of the Playbook run that the given Playbook run retries. Not set for the initial attempt.
+type ParentRunId = openapi_types.UUID
+
 // PlaybookName Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
 type PlaybookName = string
 
@@ -247,24 +505,42 @@ type Run struct {
 	// Deprecated: this property has been marked as deprecated upstream, but no `x-deprecated-reason` was set
 	Account *Account `json:"account,omitempty"`
 
+	// Attempt Sequence number of the attempt, starting with 1 for the initial Playbook run
+	Attempt *RunAttempt `json:"attempt,omitempty"`
+
 	// CorrelationId Unique identifier used to match work request with responses
 	CorrelationId *RunCorrelationId `json:"correlation_id,omitempty"`
 
 	// CreatedAt A timestamp when the entry was created
 	CreatedAt *CreatedAt `json:"created_at,omitempty"`
 
+	// ExtraVars Extra vars passed to the Playbook. The values of secret vars are redacted.
+	ExtraVars *RunExtraVars `json:"extra_vars,omitempty"`
+
+	// GroupId Unique identifier of a run group
+	GroupId *RunGroupId `json:"group_id,omitempty"`
+
+	// HostsSummary Number of hosts involved in the Playbook run grouped by their status
+	HostsSummary *RunHostsSummary `json:"hosts_summary,omitempty"`
+
 	// Id Unique identifier of a Playbook run
 	Id *RunId `json:"id,omitempty"`
 
 	// Labels Additional metadata about the Playbook run. Can be used for filtering purposes.
 	Labels *Labels `json:"labels,omitempty"`
 
+	// Mode Mode in which the Playbook is executed. In the check mode Ansible is run with --check --diff, i.e. the changes the Playbook would make are reported but not applied.
+	Mode *RunMode `json:"mode,omitempty"`
+
 	// Name Human readable name of the playbook run. Used to present the given playbook run in external systems (Satellite).
 	Name *PlaybookName `json:"name,omitempty"`
 
 	// OrgId Identifier of the tenant
 	OrgId *OrgId `json:"org_id,omitempty"`
 
+	// ParentRunId Identifier of the Playbook run that the given Playbook run retries. Not set for the initial attempt.
+	ParentRunId *ParentRunId `json:"parent_run_id,omitempty"`
+
 	// Recipient Identifier of the host to which a given Playbook is addressed
 	Recipient *RunRecipient `json:"recipient,omitempty"`
 
@@ -287,9 +563,96 @@ type Run struct {
 	WebConsoleUrl *WebConsoleUrl `json:"web_console_url,omitempty"`
 }
 
+// RunAttempt Sequence number of the attempt, starting with 1 for the initial Playbook run
+type RunAttempt = int
+
 // RunCorrelationId Unique identifier used to match work request with responses
 type RunCorrelationId = string
 
+// RunDiffs defines model for RunDiffs.
+type RunDiffs struct {
+	Data []TaskDiff `json:"data"`
+}
+
+// RunExtraVars Extra vars passed to the Playbook. The values of secret vars are redacted.
+type RunExtraVars map[string]interface{}
+
+// RunGroup defines model for RunGroup.
+type RunGroup struct {
+	// CreatedAt A timestamp when the entry was created
+	CreatedAt CreatedAt `json:"created_at"`
+
+	// Id Unique identifier of a run group
+	Id RunGroupId `json:"id"`
+
+	// OrgId Identifier of the tenant
+	OrgId OrgId `json:"org_id"`
+
+	// Rollout Progress of the rollout the Playbook runs of the group are dispatched in. Only present if the group was dispatched in waves.
+	Rollout *RunGroupRollout `json:"rollout,omitempty"`
+
+	// RunsSummary Number of Playbook runs in the group grouped by their status
+	RunsSummary RunGroupRunsSummary `json:"runs_summary"`
+
+	// Service Service that triggered the given Playbook run
+	Service Service `json:"service"`
+
+	// Status Aggregate status of the Playbook runs in the group. Only the latest attempt of a retried Playbook run is taken into account. The group is `running` until all of its Playbook runs finish. Afterwards it is `success` if all of its Playbook runs succeeded, `failure` if none of them did and `partial` otherwise.
+	Status RunGroupStatus `json:"status"`
+}
+
+// RunGroupId Unique identifier of a run group
+type RunGroupId = openapi_types.UUID
+
+// RunGroupRollout Progress of the rollout the Playbook runs of the group are dispatched in. Only present if the group was dispatched in waves.
+type RunGroupRollout struct {
+	// Status in_progress - waves are still being dispatched
+	// completed - all waves have been dispatched
+	// halted - the rollout was halted and the Playbook runs of the remaining waves were canceled
+	Status RunGroupRolloutStatus `json:"status"`
+
+	// Wave Index of the last wave dispatched, starting with 0 for the canary wave
+	Wave int `json:"wave"`
+
+	// Waves Total number of waves
+	Waves int `json:"waves"`
+}
+
+// RunGroupRolloutStatus in_progress - waves are still being dispatched
+// completed - all waves have been dispatched
+// halted - the rollout was halted and the Playbook runs of the remaining waves were canceled
+type RunGroupRolloutStatus string
+
+// RunGroupRunsSummary Number of Playbook runs in the group grouped by their status
+type RunGroupRunsSummary struct {
+	Canceled  int `json:"canceled"`
+	Expired   int `json:"expired"`
+	Failure   int `json:"failure"`
+	Running   int `json:"running"`
+	Scheduled int `json:"scheduled"`
+	Success   int `json:"success"`
+	Timeout   int `json:"timeout"`
+
+	// Total total number of Playbook runs in the group
+	Total       int `json:"total"`
+	Unreachable int `json:"unreachable"`
+}
+
+// RunGroupStatus Aggregate status of the Playbook runs in the group. Only the latest attempt of a retried Playbook run is taken into account. The group is `running` until all of its Playbook runs finish. Afterwards it is `success` if all of its Playbook runs succeeded, `failure` if none of them did and `partial` otherwise.
+type RunGroupStatus string
+
+// RunGroupStatusNullable defines model for RunGroupStatusNullable.
+type RunGroupStatusNullable string
+
+// RunGroups defines model for RunGroups.
+type RunGroups struct {
+	Data  []RunGroup `json:"data"`
+	Links Links      `json:"links"`
+
+	// Meta Information about returned entities
+	Meta Meta `json:"meta"`
+}
+
 // RunHost defines model for RunHost.
 type RunHost struct {
 	// Host Name used to identify a host within Ansible inventory
@@ -298,6 +661,9 @@ type RunHost struct {
 	Links       *RunHostLinks       `json:"links,omitempty"`
 	Run         *Run                `json:"run,omitempty"`
 
+	// Stats Play recap of the host - the number of tasks by their outcome. Only available once the Playbook run finishes on the host.
+	Stats *RunHostStats `json:"stats,omitempty"`
+
 	// Status Current status of a Playbook run
 	Status *RunStatus `json:"status,omitempty"`
 
@@ -310,6 +676,17 @@ type RunHostLinks struct {
 	InventoryHost *string `json:"inventory_host,omitempty"`
 }
 
+// RunHostStats Play recap of the host - the number of tasks by their outcome. Only available once the Playbook run finishes on the host.
+type RunHostStats struct {
+	Changed     int `json:"changed"`
+	Failures    int `json:"failures"`
+	Ignored     int `json:"ignored"`
+	Ok          int `json:"ok"`
+	Rescued     int `json:"rescued"`
+	Skipped     int `json:"skipped"`
+	Unreachable int `json:"unreachable"`
+}
+
 // RunHosts defines model for RunHosts.
 type RunHosts struct {
 	Data  []RunHost `json:"data"`
@@ -319,18 +696,56 @@ type RunHosts struct {
 	Meta Meta `json:"meta"`
 }
 
+// RunHostsSummary Number of hosts involved in the Playbook run grouped by their status
+type RunHostsSummary struct {
+	Canceled  int `json:"canceled"`
+	Expired   int `json:"expired"`
+	Failure   int `json:"failure"`
+	Running   int `json:"running"`
+	Scheduled int `json:"scheduled"`
+	Success   int `json:"success"`
+	Timeout   int `json:"timeout"`
+
+	// Total total number of hosts involved in the Playbook run
+	Total       int `json:"total"`
+	Unreachable int `json:"unreachable"`
+}
+
 // RunId Unique identifier of a Playbook run
 type RunId = openapi_types.UUID
 
 // RunLabelsNullable defines model for RunLabelsNullable.
 type RunLabelsNullable map[string]string
 
+// RunMode Mode in which the Playbook is executed. In the check mode Ansible is run with --check --diff, i.e. the changes the Playbook would make are reported but not applied.
+type RunMode string
+
 // RunRecipient Identifier of the host to which a given Playbook is addressed
 type RunRecipient = openapi_types.UUID
 
 // RunStatus Current status of a Playbook run
 type RunStatus string
 
+// RunStatusChange Status transition of a Playbook run or of a host involved in a Playbook run
+type RunStatusChange struct {
+	// Host Name used to identify a host within Ansible inventory
+	Host        *string             `json:"host,omitempty"`
+	InventoryId *openapi_types.UUID `json:"inventory_id,omitempty"`
+	Run         Run                 `json:"run"`
+
+	// Status Current status of a Playbook run
+	Status RunStatus `json:"status"`
+
+	// Timestamp A timestamp when the status changed
+	Timestamp time.Time `json:"timestamp"`
+
+	// Type Indicates whether the status of the Playbook run itself or of one of its hosts changed
+	Type RunStatusChangeType `json:"type"`
+}
+
+// RunStatusChangeType Indicates whether the status of the Playbook run itself or of one of its hosts changed
+type RunStatusChangeType string
+
 // RunTimeout Amount of seconds after which the run is considered failed due to timeout
 type RunTimeout = int
 
@@ -352,6 +767,52 @@ type ServiceNullable = string
 // StatusNullable defines model for StatusNullable.
 type StatusNullable string
 
+// TaskDiff Changes reported by a task on a single host
+type TaskDiff struct {
+	Changes []DiffChange `json:"changes"`
+
+	// Host Name used to identify a host within Ansible inventory
+	Host string `json:"host"`
+
+	// Play Name of the play the task belongs to
+	Play string `json:"play"`
+
+	// Task Name of the task
+	Task string `json:"task"`
+}
+
+// TaskResult Outcome of a task on a single host
+type TaskResult struct {
+	// Duration Time spent executing the task on the host (in seconds)
+	Duration *float32 `json:"duration,omitempty"`
+
+	// Host Name used to identify a host within Ansible inventory
+	Host string `json:"host"`
+
+	// Ignored Indicates that the task failed but the failure was ignored (ignore_errors)
+	Ignored bool `json:"ignored"`
+
+	// Message Message reported by the task (e.g. the reason of a failure)
+	Message *string `json:"message,omitempty"`
+
+	// Play Name of the play the task belongs to
+	Play string `json:"play"`
+
+	// Status defines model for TaskResultStatus.
+	Status TaskResultStatus `json:"status"`
+
+	// Task Name of the task
+	Task string `json:"task"`
+}
+
+// TaskResultStatus defines model for TaskResultStatus.
+type TaskResultStatus string
+
+// TaskResults defines model for TaskResults.
+type TaskResults struct {
+	Data []TaskResult `json:"data"`
+}
+
 // UpdatedAt A timestamp when the entry was last updated
 type UpdatedAt = time.Time
 
@@ -361,12 +822,32 @@ type Url = string
 // WebConsoleUrl URL that points to the section of the web console where the user find more information about the playbook run. The field is optional but highly suggested.
 type WebConsoleUrl = string
 
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// IncludeTotal defines model for IncludeTotal.
+type IncludeTotal = bool
+
 // Limit defines model for Limit.
 type Limit = int
 
 // Offset defines model for Offset.
 type Offset = int
 
+// RunFields defines model for RunFields.
+type RunFields struct {
+	Data *[]string `json:"data,omitempty"`
+}
+
+// RunGroupIdPath Unique identifier of a run group
+type RunGroupIdPath = RunGroupId
+
+// RunGroupsFilter defines model for RunGroupsFilter.
+type RunGroupsFilter struct {
+	Service *ServiceNullable        `json:"service,omitempty"`
+	Status  *RunGroupStatusNullable `json:"status,omitempty"`
+}
+
 // RunHostFields defines model for RunHostFields.
 type RunHostFields struct {
 	Data *[]string `json:"data,omitempty"`
@@ -374,6 +855,11 @@ type RunHostFields struct {
 
 // RunHostFilter defines model for RunHostFilter.
 type RunHostFilter struct {
+	// Changed Only include hosts with (or without) tasks that changed the host according to the play recap
+	Changed *bool `json:"changed,omitempty"`
+
+	// Failed Only include hosts with (or without) failed tasks according to the play recap
+	Failed      *bool                `json:"failed,omitempty"`
 	InventoryId *InventoryIdNullable `json:"inventory_id,omitempty"`
 	Run         *struct {
 		Id      *string            `json:"id,omitempty"`
@@ -381,8 +867,14 @@ type RunHostFilter struct {
 		Service *ServiceNullable   `json:"service,omitempty"`
 	} `json:"run,omitempty"`
 	Status *StatusNullable `json:"status,omitempty"`
+
+	// Unreachable Only include hosts that were (or were not) unreachable according to the play recap
+	Unreachable *bool `json:"unreachable,omitempty"`
 }
 
+// RunIdPath Unique identifier of a Playbook run
+type RunIdPath = RunId
+
 // RunsFields defines model for RunsFields.
 type RunsFields struct {
 	Data *[]string `json:"data,omitempty"`
@@ -405,6 +897,30 @@ type BadRequest = Error
 // Forbidden defines model for Forbidden.
 type Forbidden = Error
 
+// NotFound defines model for NotFound.
+type NotFound = Error
+
+// ApiRunEventsStreamParams defines parameters for ApiRunEventsStream.
+type ApiRunEventsStreamParams struct {
+	// Filter Allows for filtering based on various criteria
+	Filter *RunsFilter `json:"filter,omitempty"`
+}
+
+// ApiRunGroupsListParams defines parameters for ApiRunGroupsList.
+type ApiRunGroupsListParams struct {
+	// Filter Allows for filtering based on various criteria
+	Filter *RunGroupsFilter `json:"filter,omitempty"`
+
+	// Limit Maximum number of results to return
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
+	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// IncludeTotal Whether to count the results matching the query. Set to false to skip counting on large result sets, in which case `meta.total` and `links.last` are omitted.
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
+}
+
 // ApiRunHostsListParams defines parameters for ApiRunHostsList.
 type ApiRunHostsListParams struct {
 	// Filter Allows for filtering based on various criteria
@@ -418,6 +934,12 @@ type ApiRunHostsListParams struct {
 
 	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque position in the result set used for keyset pagination. An empty value starts at the first page, the cursor for the following page is returned in `links.next`. Cannot be combined with `offset`.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the results matching the query. Set to false to skip counting on large result sets, in which case `meta.total` and `links.last` are omitted.
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
 }
 
 // ApiRunHostsListParamsFieldsData defines parameters for ApiRunHostsList.
@@ -439,6 +961,12 @@ type ApiRunsListParams struct {
 
 	// Offset Indicates the starting position of the query relative to the complete set of items that match the query
 	Offset *Offset `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque position in the result set used for keyset pagination. An empty value starts at the first page, the cursor for the following page is returned in `links.next`. Cannot be combined with `offset`.
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// IncludeTotal Whether to count the results matching the query. Set to false to skip counting on large result sets, in which case `meta.total` and `links.last` are omitted.
+	IncludeTotal *IncludeTotal `form:"include_total,omitempty" json:"include_total,omitempty"`
 }
 
 // ApiRunsListParamsFieldsData defines parameters for ApiRunsList.
@@ -447,6 +975,15 @@ type ApiRunsListParamsFieldsData string
 // ApiRunsListParamsSortBy defines parameters for ApiRunsList.
 type ApiRunsListParamsSortBy string
 
+// ApiRunGetParams defines parameters for ApiRunGet.
+type ApiRunGetParams struct {
+	// Fields Defines fields to be returned in the response.
+	Fields *RunFields `json:"fields,omitempty"`
+}
+
+// ApiRunGetParamsFieldsData defines parameters for ApiRunGet.
+type ApiRunGetParamsFieldsData string
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -520,15 +1057,33 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ApiRunEventsStream request
+	ApiRunEventsStream(ctx context.Context, params *ApiRunEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunGroupsList request
+	ApiRunGroupsList(ctx context.Context, params *ApiRunGroupsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunGroupGet request
+	ApiRunGroupGet(ctx context.Context, id RunGroupIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ApiRunHostsList request
 	ApiRunHostsList(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ApiRunsList request
 	ApiRunsList(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunGet request
+	ApiRunGet(ctx context.Context, id RunIdPath, params *ApiRunGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunDiffsList request
+	ApiRunDiffsList(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ApiRunTaskResultsList request
+	ApiRunTaskResultsList(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) ApiRunHostsList(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiRunHostsListRequest(c.Server, params)
+func (c *Client) ApiRunEventsStream(ctx context.Context, params *ApiRunEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunEventsStreamRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -539,8 +1094,8 @@ func (c *Client) ApiRunHostsList(ctx context.Context, params *ApiRunHostsListPar
 	return c.Client.Do(req)
 }
 
-func (c *Client) ApiRunsList(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewApiRunsListRequest(c.Server, params)
+func (c *Client) ApiRunGroupsList(ctx context.Context, params *ApiRunGroupsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunGroupsListRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -551,30 +1106,102 @@ func (c *Client) ApiRunsList(ctx context.Context, params *ApiRunsListParams, req
 	return c.Client.Do(req)
 }
 
-// NewApiRunHostsListRequest generates requests for ApiRunHostsList
-func NewApiRunHostsListRequest(server string, params *ApiRunHostsListParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ApiRunGroupGet(ctx context.Context, id RunGroupIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunGroupGetRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ApiRunHostsList(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunHostsListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunsList(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunsListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunGet(ctx context.Context, id RunIdPath, params *ApiRunGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunGetRequest(c.Server, id, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunDiffsList(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunDiffsListRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ApiRunTaskResultsList(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewApiRunTaskResultsListRequest(c.Server, id)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewApiRunEventsStreamRequest generates requests for ApiRunEventsStream
+func NewApiRunEventsStreamRequest(server string, params *ApiRunEventsStreamParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/run_events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Filter != nil {
-
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
 			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
@@ -589,9 +1216,42 @@ func NewApiRunHostsListRequest(server string, params *ApiRunHostsListParams) (*h
 
 		}
 
-		if params.Fields != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunGroupsListRequest generates requests for ApiRunGroupsList
+func NewApiRunGroupsListRequest(server string, params *ApiRunGroupsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/run_groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -637,6 +1297,22 @@ func NewApiRunHostsListRequest(server string, params *ApiRunHostsListParams) (*h
 
 		}
 
+		if params.IncludeTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_total", *params.IncludeTotal, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -648,16 +1324,50 @@ func NewApiRunHostsListRequest(server string, params *ApiRunHostsListParams) (*h
 	return req, nil
 }
 
-// NewApiRunsListRequest generates requests for ApiRunsList
-func NewApiRunsListRequest(server string, params *ApiRunsListParams) (*http.Request, error) {
+// NewApiRunGroupGetRequest generates requests for ApiRunGroupGet
+func NewApiRunGroupGetRequest(server string, id RunGroupIdPath) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs")
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/run_groups/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunHostsListRequest generates requests for ApiRunHostsList
+func NewApiRunHostsListRequest(server string, params *ApiRunHostsListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/run_hosts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -702,9 +1412,9 @@ func NewApiRunsListRequest(server string, params *ApiRunsListParams) (*http.Requ
 
 		}
 
-		if params.SortBy != nil {
+		if params.Limit != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort_by", *params.SortBy, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -718,9 +1428,9 @@ func NewApiRunsListRequest(server string, params *ApiRunsListParams) (*http.Requ
 
 		}
 
-		if params.Limit != nil {
+		if params.Offset != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -734,9 +1444,25 @@ func NewApiRunsListRequest(server string, params *ApiRunsListParams) (*http.Requ
 
 		}
 
-		if params.Offset != nil {
+		if params.Cursor != nil {
 
-			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_total", *params.IncludeTotal, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -761,71 +1487,430 @@ func NewApiRunsListRequest(server string, params *ApiRunsListParams) (*http.Requ
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+// NewApiRunsListRequest generates requests for ApiRunsList
+func NewApiRunsListRequest(server string, params *ApiRunsListParams) (*http.Request, error) {
+	var err error
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ApiRunHostsListWithResponse request
-	ApiRunHostsListWithResponse(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*ApiRunHostsListResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ApiRunsListWithResponse request
-	ApiRunsListWithResponse(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*ApiRunsListResponse, error)
-}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-type ApiRunHostsListResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RunHosts
-	JSON400      *BadRequest
-	JSON403      *Forbidden
-}
+		if params.Filter != nil {
 
-// Status returns HTTPResponse.Status
-func (r ApiRunHostsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "filter", *params.Filter, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.SortBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "sort_by", *params.SortBy, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "limit", *params.Limit, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "offset", *params.Offset, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "integer", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "cursor", *params.Cursor, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "string", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeTotal != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("form", true, "include_total", *params.IncludeTotal, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "boolean", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunGetRequest generates requests for ApiRunGet
+func NewApiRunGetRequest(server string, id RunIdPath, params *ApiRunGetParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithOptions("deepObject", true, "fields", *params.Fields, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationQuery, Type: "object", Format: ""}); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunDiffsListRequest generates requests for ApiRunDiffsList
+func NewApiRunDiffsListRequest(server string, id RunIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/diffs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewApiRunTaskResultsListRequest generates requests for ApiRunTaskResultsList
+func NewApiRunTaskResultsListRequest(server string, id RunIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithOptions("simple", false, "id", id, runtime.StyleParamOptions{ParamLocation: runtime.ParamLocationPath, Type: "string", Format: "uuid"})
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/playbook-dispatcher/v1/runs/%s/task_results", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ApiRunEventsStreamWithResponse request
+	ApiRunEventsStreamWithResponse(ctx context.Context, params *ApiRunEventsStreamParams, reqEditors ...RequestEditorFn) (*ApiRunEventsStreamResponse, error)
+
+	// ApiRunGroupsListWithResponse request
+	ApiRunGroupsListWithResponse(ctx context.Context, params *ApiRunGroupsListParams, reqEditors ...RequestEditorFn) (*ApiRunGroupsListResponse, error)
+
+	// ApiRunGroupGetWithResponse request
+	ApiRunGroupGetWithResponse(ctx context.Context, id RunGroupIdPath, reqEditors ...RequestEditorFn) (*ApiRunGroupGetResponse, error)
+
+	// ApiRunHostsListWithResponse request
+	ApiRunHostsListWithResponse(ctx context.Context, params *ApiRunHostsListParams, reqEditors ...RequestEditorFn) (*ApiRunHostsListResponse, error)
+
+	// ApiRunsListWithResponse request
+	ApiRunsListWithResponse(ctx context.Context, params *ApiRunsListParams, reqEditors ...RequestEditorFn) (*ApiRunsListResponse, error)
+
+	// ApiRunGetWithResponse request
+	ApiRunGetWithResponse(ctx context.Context, id RunIdPath, params *ApiRunGetParams, reqEditors ...RequestEditorFn) (*ApiRunGetResponse, error)
+
+	// ApiRunDiffsListWithResponse request
+	ApiRunDiffsListWithResponse(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*ApiRunDiffsListResponse, error)
+
+	// ApiRunTaskResultsListWithResponse request
+	ApiRunTaskResultsListWithResponse(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*ApiRunTaskResultsListResponse, error)
+}
+
+type ApiRunEventsStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunEventsStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunEventsStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunGroupsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunGroups
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunGroupsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunGroupsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunGroupGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunGroup
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunGroupGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunGroupGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunHostsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunHosts
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunHostsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
 // StatusCode returns HTTPResponse.StatusCode
 func (r ApiRunHostsListResponse) StatusCode() int {
@@ -843,20 +1928,122 @@ type ApiRunsListResponse struct {
 	JSON403      *Forbidden
 }
 
-// Status returns HTTPResponse.Status
-func (r ApiRunsListResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// Status returns HTTPResponse.Status
+func (r ApiRunsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Run
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ApiRunDiffsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RunDiffs
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+type ApiRunTaskResultsListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TaskResults
+	JSON400      *BadRequest
+	JSON403      *Forbidden
+	JSON404      *NotFound
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunDiffsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// Status returns HTTPResponse.Status
+func (r ApiRunTaskResultsListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunDiffsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ApiRunTaskResultsListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ApiRunEventsStreamWithResponse request returning *ApiRunEventsStreamResponse
+func (c *ClientWithResponses) ApiRunEventsStreamWithResponse(ctx context.Context, params *ApiRunEventsStreamParams, reqEditors ...RequestEditorFn) (*ApiRunEventsStreamResponse, error) {
+	rsp, err := c.ApiRunEventsStream(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunEventsStreamResponse(rsp)
+}
+
+// ApiRunGroupsListWithResponse request returning *ApiRunGroupsListResponse
+func (c *ClientWithResponses) ApiRunGroupsListWithResponse(ctx context.Context, params *ApiRunGroupsListParams, reqEditors ...RequestEditorFn) (*ApiRunGroupsListResponse, error) {
+	rsp, err := c.ApiRunGroupsList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	return ParseApiRunGroupsListResponse(rsp)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ApiRunsListResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ApiRunGroupGetWithResponse request returning *ApiRunGroupGetResponse
+func (c *ClientWithResponses) ApiRunGroupGetWithResponse(ctx context.Context, id RunGroupIdPath, reqEditors ...RequestEditorFn) (*ApiRunGroupGetResponse, error) {
+	rsp, err := c.ApiRunGroupGet(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	return ParseApiRunGroupGetResponse(rsp)
 }
 
 // ApiRunHostsListWithResponse request returning *ApiRunHostsListResponse
@@ -877,6 +2064,153 @@ func (c *ClientWithResponses) ApiRunsListWithResponse(ctx context.Context, param
 	return ParseApiRunsListResponse(rsp)
 }
 
+// ApiRunGetWithResponse request returning *ApiRunGetResponse
+func (c *ClientWithResponses) ApiRunGetWithResponse(ctx context.Context, id RunIdPath, params *ApiRunGetParams, reqEditors ...RequestEditorFn) (*ApiRunGetResponse, error) {
+	rsp, err := c.ApiRunGet(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunGetResponse(rsp)
+}
+
+// ApiRunDiffsListWithResponse request returning *ApiRunDiffsListResponse
+func (c *ClientWithResponses) ApiRunDiffsListWithResponse(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*ApiRunDiffsListResponse, error) {
+	rsp, err := c.ApiRunDiffsList(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunDiffsListResponse(rsp)
+}
+
+// ApiRunTaskResultsListWithResponse request returning *ApiRunTaskResultsListResponse
+func (c *ClientWithResponses) ApiRunTaskResultsListWithResponse(ctx context.Context, id RunIdPath, reqEditors ...RequestEditorFn) (*ApiRunTaskResultsListResponse, error) {
+	rsp, err := c.ApiRunTaskResultsList(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseApiRunTaskResultsListResponse(rsp)
+}
+
+// ParseApiRunEventsStreamResponse parses an HTTP response from a ApiRunEventsStreamWithResponse call
+func ParseApiRunEventsStreamResponse(rsp *http.Response) (*ApiRunEventsStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunEventsStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunGroupsListResponse parses an HTTP response from a ApiRunGroupsListWithResponse call
+func ParseApiRunGroupsListResponse(rsp *http.Response) (*ApiRunGroupsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunGroupsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunGroups
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunGroupGetResponse parses an HTTP response from a ApiRunGroupGetWithResponse call
+func ParseApiRunGroupGetResponse(rsp *http.Response) (*ApiRunGroupGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunGroupGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunGroup
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseApiRunHostsListResponse parses an HTTP response from a ApiRunHostsListWithResponse call
 func ParseApiRunHostsListResponse(rsp *http.Response) (*ApiRunHostsListResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -956,3 +2290,144 @@ func ParseApiRunsListResponse(rsp *http.Response) (*ApiRunsListResponse, error)
 
 	return response, nil
 }
+
+// ParseApiRunGetResponse parses an HTTP response from a ApiRunGetWithResponse call
+func ParseApiRunGetResponse(rsp *http.Response) (*ApiRunGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Run
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunDiffsListResponse parses an HTTP response from a ApiRunDiffsListWithResponse call
+func ParseApiRunDiffsListResponse(rsp *http.Response) (*ApiRunDiffsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunDiffsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RunDiffs
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseApiRunTaskResultsListResponse parses an HTTP response from a ApiRunTaskResultsListWithResponse call
+func ParseApiRunTaskResultsListResponse(rsp *http.Response) (*ApiRunTaskResultsListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ApiRunTaskResultsListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TaskResults
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,94 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func listDiffs(id uuid.UUID) (*RunDiffs, *ApiRunDiffsListResponse) {
+	raw := doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s/diffs", id))
+	res, err := ParseApiRunDiffsListResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+var _ = Describe("runDiffsList", func() {
+	db := test.WithDatabase()
+
+	It("lists the changes predicted by a check run", func() {
+		data := test.NewRunWithStatus(orgId(), "success")
+		data.Mode = dbModel.RunModeCheck
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		host2 := test.NewRunHostWithHostname(data.ID, "success", "host2")
+		host2.Diffs = dbModel.RunHostDiffs{{
+			Play: "play",
+			Task: "install",
+			Changes: []dbModel.DiffChange{{
+				Prepared: utils.StringRef("openssl updated to 3.0.7"),
+			}},
+		}}
+
+		host1 := test.NewRunHostWithHostname(data.ID, "success", "host1")
+		host1.Diffs = dbModel.RunHostDiffs{{
+			Play: "play",
+			Task: "configure",
+			Changes: []dbModel.DiffChange{{
+				Before:       "PermitRootLogin yes\n",
+				After:        "PermitRootLogin no\n",
+				BeforeHeader: utils.StringRef("/etc/ssh/sshd_config"),
+				AfterHeader:  utils.StringRef("/etc/ssh/sshd_config"),
+			}},
+		}}
+
+		Expect(db().Create([]dbModel.RunHost{host2, host1}).Error).ToNot(HaveOccurred())
+
+		diffs, res := listDiffs(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(diffs.Data).To(HaveLen(2))
+
+		Expect(diffs.Data[0].Host).To(Equal("host1"))
+		Expect(diffs.Data[0].Play).To(Equal("play"))
+		Expect(diffs.Data[0].Task).To(Equal("configure"))
+		Expect(diffs.Data[0].Changes).To(HaveLen(1))
+		Expect(*diffs.Data[0].Changes[0].Before).To(Equal("PermitRootLogin yes\n"))
+		Expect(*diffs.Data[0].Changes[0].After).To(Equal("PermitRootLogin no\n"))
+		Expect(*diffs.Data[0].Changes[0].BeforeHeader).To(Equal("/etc/ssh/sshd_config"))
+		Expect(diffs.Data[0].Changes[0].Prepared).To(BeNil())
+
+		Expect(diffs.Data[1].Host).To(Equal("host2"))
+		Expect(diffs.Data[1].Task).To(Equal("install"))
+		Expect(diffs.Data[1].Changes[0].Before).To(BeNil())
+		Expect(*diffs.Data[1].Changes[0].Prepared).To(Equal("openssl updated to 3.0.7"))
+	})
+
+	It("returns an empty list for a run without diffs", func() {
+		data := test.NewRun(orgId())
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+		Expect(db().Create(test.NewRunHost(data.ID, "running", nil)).Error).ToNot(HaveOccurred())
+
+		diffs, res := listDiffs(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(diffs.Data).To(BeEmpty())
+	})
+
+	It("404s on unknown run", func() {
+		_, res := listDiffs(uuid.New())
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("404s on run of a different tenant", func() {
+		data := test.NewRun("1234567")
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		_, res := listDiffs(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+})
@@ -0,0 +1,140 @@
+package public
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"playbook-dispatcher/internal/common/notify"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// streamRunEvents opens the event stream and returns the data of the received events
+func streamRunEvents(ctx context.Context, keysAndValues ...interface{}) (*http.Response, <-chan RunStatusChange) {
+	url := utils.BuildUrl("http://localhost:9002/api/playbook-dispatcher/v1/run_events", keysAndValues...)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	Expect(err).ToNot(HaveOccurred())
+	req.Header.Set("x-rh-identity", test.IdentityHeaderMinimal(orgId()))
+
+	// test.Client cannot be used as its timeout would cut the stream
+	res, err := http.DefaultClient.Do(req)
+	Expect(err).ToNot(HaveOccurred())
+
+	events := make(chan RunStatusChange, 10)
+
+	go func() {
+		defer GinkgoRecover()
+		defer close(events)
+		defer res.Body.Close()
+
+		scanner := bufio.NewScanner(res.Body)
+		for scanner.Scan() {
+			if data := strings.TrimPrefix(scanner.Text(), "data: "); data != scanner.Text() {
+				event := RunStatusChange{}
+				Expect(json.Unmarshal([]byte(data), &event)).To(Succeed())
+				events <- event
+			}
+		}
+	}()
+
+	return res, events
+}
+
+func newStatusChange(orgId string, service string) notify.StatusChange {
+	return notify.StatusChange{
+		Type:      notify.TypeRun,
+		RunID:     uuid.New(),
+		OrgID:     orgId,
+		Service:   service,
+		Recipient: uuid.New(),
+		Labels:    map[string]string{"foo": "bar"},
+		Status:    "success",
+		Timestamp: time.Now(),
+	}
+}
+
+var _ = Describe("runEvents", func() {
+	db := test.WithDatabase()
+
+	var (
+		ctx    context.Context
+		cancel context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithTimeout(test.TestContext(), 5*time.Second)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	publish := func(changes ...notify.StatusChange) {
+		Expect(notify.Publish(ctx, db(), changes...)).To(Succeed())
+	}
+
+	It("streams status changes", func() {
+		res, events := streamRunEvents(ctx)
+		Expect(res.StatusCode).To(Equal(http.StatusOK))
+		Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		change := newStatusChange(orgId(), "remediations")
+		host := "localhost"
+		change.Type = notify.TypeRunHost
+		change.Host = &host
+		publish(change)
+
+		var event RunStatusChange
+		Eventually(events, 2*time.Second).Should(Receive(&event))
+		Expect(event.Type).To(Equal(RunStatusChangeTypeRunHost))
+		Expect(event.Status).To(Equal(RunStatusSuccess))
+		Expect(*event.Host).To(Equal(host))
+		Expect(*event.Run.Id).To(Equal(change.RunID))
+		Expect(*event.Run.Recipient).To(Equal(change.Recipient))
+		Expect(*event.Run.Service).To(Equal(change.Service))
+		Expect((*event.Run.Labels)["foo"]).To(Equal("bar"))
+	})
+
+	It("does not stream status changes of other tenants", func() {
+		_, events := streamRunEvents(ctx)
+
+		other := newStatusChange("1234567", "remediations")
+		own := newStatusChange(orgId(), "remediations")
+		publish(other, own)
+
+		var event RunStatusChange
+		Eventually(events, 2*time.Second).Should(Receive(&event))
+		Expect(*event.Run.Id).To(Equal(own.RunID))
+	})
+
+	It("filters status changes", func() {
+		_, events := streamRunEvents(ctx, "filter[service]", "config_manager", "filter[status]", "failure", "filter[labels][foo]", "bar")
+
+		wrongService := newStatusChange(orgId(), "remediations")
+		wrongService.Status = "failure"
+		wrongStatus := newStatusChange(orgId(), "config_manager")
+		wrongLabels := newStatusChange(orgId(), "config_manager")
+		wrongLabels.Status = "failure"
+		wrongLabels.Labels = map[string]string{"foo": "baz"}
+		matching := newStatusChange(orgId(), "config_manager")
+		matching.Status = "failure"
+		publish(wrongService, wrongStatus, wrongLabels, matching)
+
+		var event RunStatusChange
+		Eventually(events, 2*time.Second).Should(Receive(&event))
+		Expect(*event.Run.Id).To(Equal(matching.RunID))
+	})
+
+	It("400s on invalid status filter", func() {
+		res, _ := streamRunEvents(ctx, "filter[status]", "salad")
+		Expect(res.StatusCode).To(Equal(http.StatusBadRequest))
+	})
+})
@@ -0,0 +1,153 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func getRun(id uuid.UUID, keysAndValues ...interface{}) (*Run, *ApiRunGetResponse) {
+	raw := doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s", id), keysAndValues...)
+	res, err := ParseApiRunGetResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+var _ = Describe("runGet", func() {
+	db := test.WithDatabase()
+
+	It("returns the given run", func() {
+		var data = test.NewRunWithStatus(orgId(), "success")
+		data.Labels = dbModel.Labels{"foo": "bar"}
+		data.Timeout = 600
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		run, res := getRun(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*run.Id).To(BeEquivalentTo(data.ID))
+		Expect((*run.Labels)["foo"]).To(Equal(data.Labels["foo"]))
+		Expect(*run.Recipient).To(BeEquivalentTo(data.Recipient))
+		Expect(*run.Status).To(BeEquivalentTo(data.Status))
+		Expect(*run.Timeout).To(BeEquivalentTo(data.Timeout))
+		Expect(*run.Url).To(BeEquivalentTo(data.URL))
+		Expect(run.HostsSummary).To(BeNil())
+	})
+
+	It("properly infers run status", func() {
+		var data = test.NewRunWithStatus(orgId(), "running")
+		data.CreatedAt = time.Date(2020, time.January, 2, 10, 45, 3, 0, time.UTC)
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		run, res := getRun(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*run.Status).To(BeEquivalentTo("timeout"))
+	})
+
+	It("404s on unknown run", func() {
+		_, res := getRun(uuid.New())
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+		Expect(res.JSON404.Message).To(Equal("Run not found"))
+	})
+
+	It("404s on run of a different tenant", func() {
+		var data = test.NewRun("1234567")
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		_, res := getRun(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("returns only the requested fields", func() {
+		var data = test.NewRun(orgId())
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		run, res := getRun(data.ID, "fields[data]", "service,created_at")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*run.Service).To(Equal(data.Service))
+		Expect(run.CreatedAt).ToNot(BeNil())
+		Expect(run.Id).To(BeNil())
+		Expect(run.Status).To(BeNil())
+	})
+
+	It("redacts secret extra vars", func() {
+		var data = test.NewRun(orgId())
+		data.ExtraVars = &dbModel.ExtraVars{
+			Values:  map[string]interface{}{"package": "openssl", "token": "s3cr3t"},
+			Secrets: []string{"token"},
+		}
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		run, res := getRun(data.ID, "fields[data]", "extra_vars")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*run.ExtraVars).To(Equal(RunExtraVars{"package": "openssl", "token": "[REDACTED]"}))
+	})
+
+	It("returns the mode of a check run", func() {
+		var data = test.NewRun(orgId())
+		data.Mode = dbModel.RunModeCheck
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		run, res := getRun(data.ID, "fields[data]", "mode")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*run.Mode).To(Equal(RunModeCheck))
+	})
+
+	It("400s on unknown field", func() {
+		var data = test.NewRun(orgId())
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		_, res := getRun(data.ID, "fields[data]", "salad")
+		Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+	})
+
+	It("returns hosts summary", func() {
+		var data = test.NewRun(orgId())
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		hosts := []dbModel.RunHost{
+			test.NewRunHost(data.ID, "success", nil),
+			test.NewRunHost(data.ID, "success", nil),
+			test.NewRunHost(data.ID, "failure", nil),
+			test.NewRunHost(data.ID, "running", nil),
+			test.NewRunHost(data.ID, "unreachable", nil),
+		}
+		Expect(db().Create(hosts).Error).ToNot(HaveOccurred())
+
+		run, res := getRun(data.ID, "fields[data]", "id,hosts_summary")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*run.Id).To(BeEquivalentTo(data.ID))
+		Expect(*run.HostsSummary).To(Equal(RunHostsSummary{
+			Total:       5,
+			Running:     1,
+			Success:     2,
+			Failure:     1,
+			Unreachable: 1,
+		}))
+	})
+
+	It("reports hosts of an expired run as timed out", func() {
+		var data = test.NewRunWithStatus(orgId(), "running")
+		data.CreatedAt = time.Date(2020, time.January, 2, 10, 45, 3, 0, time.UTC)
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		hosts := []dbModel.RunHost{
+			test.NewRunHost(data.ID, "running", nil),
+			test.NewRunHost(data.ID, "success", nil),
+		}
+		Expect(db().Create(hosts).Error).ToNot(HaveOccurred())
+
+		run, res := getRun(data.ID, "fields[data]", "hosts_summary")
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(*run.HostsSummary).To(Equal(RunHostsSummary{
+			Total:   2,
+			Success: 1,
+			Timeout: 1,
+		}))
+	})
+})
@@ -0,0 +1,184 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+func getRunGroup(id uuid.UUID) (*RunGroup, *ApiRunGroupGetResponse) {
+	raw := doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/run_groups/%s", id))
+	res, err := ParseApiRunGroupGetResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+func listRunGroups(keysAndValues ...interface{}) (*RunGroups, *ApiRunGroupsListResponse) {
+	raw := doGet("http://localhost:9002/api/playbook-dispatcher/v1/run_groups", keysAndValues...)
+	res, err := ParseApiRunGroupsListResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+var _ = Describe("runGroups", func() {
+	db := test.WithDatabase()
+
+	var service string
+
+	BeforeEach(func() {
+		// unique service name so that groups created by other tests are not matched
+		service = "group-" + uuid.New().String()
+	})
+
+	newGroup := func(orgId string, statuses ...string) (dbModel.RunGroup, []dbModel.Run) {
+		group := test.NewRunGroup(orgId)
+		group.Service = service
+		Expect(db().Create(&group).Error).ToNot(HaveOccurred())
+
+		runs := make([]dbModel.Run, len(statuses))
+		for i, status := range statuses {
+			runs[i] = test.NewRunWithStatus(orgId, status)
+			runs[i].Service = service
+			runs[i].GroupID = &group.ID
+			Expect(db().Create(&runs[i]).Error).ToNot(HaveOccurred())
+		}
+
+		return group, runs
+	}
+
+	Describe("get", func() {
+		It("returns the given group", func() {
+			group, _ := newGroup(orgId(), "success", "failure", "unreachable")
+
+			result, res := getRunGroup(group.ID)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(result.Id).To(BeEquivalentTo(group.ID))
+			Expect(result.OrgId).To(Equal(orgId()))
+			Expect(result.Service).To(Equal(service))
+			Expect(result.Status).To(Equal(RunGroupStatusPartial))
+			Expect(result.RunsSummary).To(Equal(RunGroupRunsSummary{Total: 3, Success: 1, Failure: 1, Unreachable: 1}))
+			Expect(result.Rollout).To(BeNil())
+		})
+
+		It("returns the progress of the rollout of the group", func() {
+			group, _ := newGroup(orgId(), "success", "running", "scheduled")
+
+			rollout := dbModel.Rollout{GroupID: group.ID, WaveSize: 1, Status: dbModel.RolloutStatusInProgress, Wave: 1, Waves: 3}
+			Expect(db().Create(&rollout).Error).ToNot(HaveOccurred())
+
+			result, res := getRunGroup(group.ID)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(*result.Rollout).To(Equal(RunGroupRollout{Status: RunGroupRolloutStatusInProgress, Wave: 1, Waves: 3}))
+		})
+
+		DescribeTable("derives the status of the group from its runs",
+			func(expected RunGroupStatus, statuses ...string) {
+				group, _ := newGroup(orgId(), statuses...)
+
+				result, res := getRunGroup(group.ID)
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(result.Status).To(Equal(expected))
+			},
+
+			Entry("all succeeded", RunGroupStatusSuccess, "success", "success"),
+			Entry("some succeeded", RunGroupStatusPartial, "success", "timeout"),
+			Entry("none succeeded", RunGroupStatusFailure, "failure", "canceled", "expired"),
+			Entry("some running", RunGroupStatusRunning, "success", "running"),
+			Entry("some scheduled", RunGroupStatusRunning, "failure", "scheduled"),
+		)
+
+		It("only counts the latest attempt of a retried run", func() {
+			group, runs := newGroup(orgId(), "success", "failure")
+
+			retry := test.NewRunWithStatus(orgId(), "success")
+			retry.Service = service
+			retry.GroupID = &group.ID
+			retry.ParentRunID = &runs[1].ID
+			retry.Attempt = 2
+			Expect(db().Create(&retry).Error).ToNot(HaveOccurred())
+
+			result, res := getRunGroup(group.ID)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(result.Status).To(Equal(RunGroupStatusSuccess))
+			Expect(result.RunsSummary.Total).To(Equal(2))
+			Expect(result.RunsSummary.Success).To(Equal(2))
+		})
+
+		It("404s on unknown group", func() {
+			_, res := getRunGroup(uuid.New())
+			Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+			Expect(res.JSON404.Message).To(Equal("Run group not found"))
+		})
+
+		It("404s on group of a different tenant", func() {
+			group, _ := newGroup("1234567", "success")
+
+			_, res := getRunGroup(group.ID)
+			Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("list", func() {
+		It("lists the groups of the service", func() {
+			first, _ := newGroup(orgId(), "success")
+			second, _ := newGroup(orgId(), "running")
+			newGroup("1234567", "success")
+
+			groups, res := listRunGroups("filter[service]", service)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(groups.Meta.Count).To(Equal(2))
+			Expect(*groups.Meta.Total).To(Equal(2))
+			Expect(groups.Data[0].Id).To(BeEquivalentTo(second.ID))
+			Expect(groups.Data[1].Id).To(BeEquivalentTo(first.ID))
+			Expect(groups.Data[0].RunsSummary.Running).To(Equal(1))
+			Expect(groups.Data[1].RunsSummary.Success).To(Equal(1))
+		})
+
+		DescribeTable("filters groups by status",
+			func(status string, index int) {
+				data := []dbModel.RunGroup{}
+				for _, statuses := range [][]string{{"success"}, {"success", "failure"}, {"failure"}, {"running"}} {
+					group, _ := newGroup(orgId(), statuses...)
+					data = append(data, group)
+				}
+
+				groups, res := listRunGroups("filter[service]", service, "filter[status]", status)
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(groups.Meta.Count).To(Equal(1))
+				Expect(groups.Data[0].Id).To(BeEquivalentTo(data[index].ID))
+			},
+
+			Entry("success", "success", 0),
+			Entry("partial", "partial", 1),
+			Entry("failure", "failure", 2),
+			Entry("running", "running", 3),
+		)
+
+		It("400s on invalid status", func() {
+			_, res := listRunGroups("filter[status]", "salad")
+			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+
+		It("paginates the groups", func() {
+			newGroup(orgId(), "success")
+			newGroup(orgId(), "success")
+			newGroup(orgId(), "success")
+
+			groups, res := listRunGroups("filter[service]", service, "limit", 2)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(groups.Data).To(HaveLen(2))
+			Expect(groups.Links.Next).ToNot(BeNil())
+
+			groups, res = listRunGroups("filter[service]", service, "limit", 2, "offset", 2)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(groups.Data).To(HaveLen(1))
+			Expect(groups.Links.Next).To(BeNil())
+		})
+	})
+})
@@ -68,6 +68,17 @@ var _ = Describe("runHostList", func() {
 				Expect(*runs.Data[0].Run.Id).To(BeEquivalentTo(data[1].ID))
 			})
 
+			It("filters by unreachable host status", func() {
+				run := test.NewRunWithStatus(orgId(), "unreachable")
+				dbInsertRuns(run)
+				dbInsertHosts(test.NewRunHost(run.ID, "success", nil), test.NewRunHost(run.ID, "unreachable", nil))
+
+				runs, res := listRunHosts("filter[status]", "unreachable")
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(runs.Data).To(HaveLen(1))
+				Expect(*runs.Data[0].Status).To(Equal(RunStatusUnreachable))
+			})
+
 			It("filters by run id", func() {
 				data := []dbModel.Run{
 					test.NewRun(orgId()),
@@ -156,6 +167,62 @@ var _ = Describe("runHostList", func() {
 				Expect(*runs.Data[0].Run.Id).To(BeEquivalentTo(data[1].ID))
 			})
 
+			Describe("play recap", func() {
+				var hosts []dbModel.RunHost
+
+				BeforeEach(func() {
+					run := test.NewRunWithStatus(orgId(), "failure")
+					dbInsertRuns(run)
+
+					hosts = []dbModel.RunHost{
+						test.NewRunHost(run.ID, "success", nil),
+						test.NewRunHost(run.ID, "failure", nil),
+						test.NewRunHost(run.ID, "failure", nil),
+					}
+
+					hosts[0].Host = "01.example.com"
+					hosts[0].Stats = &dbModel.RunHostStats{Ok: 3, Changed: 2}
+					hosts[1].Host = "02.example.com"
+					hosts[1].Stats = &dbModel.RunHostStats{Ok: 1, Failures: 1, Skipped: 1}
+					hosts[2].Host = "03.example.com"
+					hosts[2].Stats = &dbModel.RunHostStats{Unreachable: 1}
+
+					dbInsertHosts(hosts...)
+				})
+
+				DescribeTable("filters by play recap",
+					func(filter, value string, expected ...int) {
+						runs, res := listRunHosts("filter["+filter+"]", value, "fields[data]", "host,stats")
+						Expect(res.StatusCode()).To(Equal(http.StatusOK))
+
+						names := []string{}
+						for _, host := range runs.Data {
+							names = append(names, *host.Host)
+						}
+
+						expectedNames := []string{}
+						for _, i := range expected {
+							expectedNames = append(expectedNames, hosts[i].Host)
+						}
+
+						Expect(names).To(ConsistOf(expectedNames))
+					},
+					Entry("changed", "changed", "true", 0),
+					Entry("not changed", "changed", "false", 1, 2),
+					Entry("failed", "failed", "true", 1),
+					Entry("not failed", "failed", "false", 0, 2),
+					Entry("unreachable", "unreachable", "true", 2),
+					Entry("reachable", "unreachable", "false", 0, 1),
+				)
+
+				It("returns the play recap", func() {
+					runs, res := listRunHosts("filter[failed]", "true", "fields[data]", "stats")
+					Expect(res.StatusCode()).To(Equal(http.StatusOK))
+					Expect(runs.Data).To(HaveLen(1))
+					Expect(*runs.Data[0].Stats).To(Equal(RunHostStats{Ok: 1, Failures: 1, Skipped: 1}))
+				})
+			})
+
 			It("handle invalid inventory id filter", func() {
 				_, res := listRunHosts("filter[inventory_id]", "fred-flintstone-barney-rubble-not-uuid")
 				Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
@@ -169,13 +236,15 @@ var _ = Describe("runHostList", func() {
 			run := test.NewRun(orgId())
 			dbInsertRuns(run)
 			inventoryID := uuid.New()
-			dbInsertHosts(test.NewRunHost(run.ID, "running", &inventoryID))
+			host := test.NewRunHost(run.ID, "running", &inventoryID)
+			host.Stats = &dbModel.RunHostStats{Ok: 1}
+			dbInsertHosts(host)
 		})
 
 		DescribeTable("happy path", fieldTester(listRunHostsRaw),
 			Entry("single field", "host"),
 			Entry("defaults defined explicitly", "host", "status", "run"),
-			Entry("all fields", "host", "status", "run", "stdout", "links", "inventory_id"),
+			Entry("all fields", "host", "status", "run", "stdout", "links", "inventory_id", "stats"),
 		)
 
 		It("accepts repeated fields[data] parameters (production format)", func() {
@@ -218,7 +287,7 @@ var _ = Describe("runHostList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 			Expect(runs.Data).To(HaveLen(expected))
 			Expect(runs.Meta.Count).To(Equal(expected))
-			Expect(runs.Meta.Total).To(Equal(12))
+			Expect(*runs.Meta.Total).To(Equal(12))
 		},
 
 		Entry("limit=2", 2, 2, 0),
@@ -279,7 +348,7 @@ var _ = Describe("runHostList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 
 			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=50&offset=0"))
-			Expect((*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=50&offset=0"))
+			Expect(*(*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=50&offset=0"))
 			Expect((*runs).Links.Next).To(BeNil())
 			Expect((*runs).Links.Previous).To(BeNil())
 		})
@@ -289,7 +358,7 @@ var _ = Describe("runHostList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 
 			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=1&offset=0"))
-			Expect((*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=1&offset=4"))
+			Expect(*(*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=1&offset=4"))
 			Expect(*(*runs).Links.Next).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=1&offset=2"))
 			Expect(*(*runs).Links.Previous).To(Equal("/api/playbook-dispatcher/v1/run_hosts?limit=1&offset=0"))
 		})
@@ -299,9 +368,36 @@ var _ = Describe("runHostList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 
 			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/run_hosts?fields%5Bdata%5D=host&filter%5Bstatus%5D=running&limit=1&offset=0"))
-			Expect((*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/run_hosts?fields%5Bdata%5D=host&filter%5Bstatus%5D=running&limit=1&offset=4"))
+			Expect(*(*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/run_hosts?fields%5Bdata%5D=host&filter%5Bstatus%5D=running&limit=1&offset=4"))
 			Expect(*(*runs).Links.Next).To(Equal("/api/playbook-dispatcher/v1/run_hosts?fields%5Bdata%5D=host&filter%5Bstatus%5D=running&limit=1&offset=2"))
 			Expect(*(*runs).Links.Previous).To(Equal("/api/playbook-dispatcher/v1/run_hosts?fields%5Bdata%5D=host&filter%5Bstatus%5D=running&limit=1&offset=0"))
 		})
+
+		It("traverses all hosts using the cursor", func() {
+			runIds := map[string]bool{}
+			cursor := ""
+
+			for page := 0; page < 3; page++ {
+				hosts, res := listRunHosts("limit", 2, "cursor", cursor, "include_total", false)
+				Expect(res.StatusCode()).To(Equal(http.StatusOK))
+				Expect(hosts.Meta.Total).To(BeNil())
+				Expect(hosts.Links.Last).To(BeNil())
+
+				for _, host := range hosts.Data {
+					runIds[host.Run.Id.String()] = true
+				}
+
+				if page < 2 {
+					Expect(hosts.Data).To(HaveLen(2))
+					cursor = cursorOf(hosts.Links.Next)
+				} else {
+					Expect(hosts.Data).To(HaveLen(1))
+					Expect(hosts.Links.Next).To(BeNil())
+				}
+			}
+
+			// every host appears exactly once
+			Expect(runIds).To(HaveLen(5))
+		})
 	})
 })
@@ -0,0 +1,107 @@
+package public
+
+import (
+	"fmt"
+	"net/http"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func listTaskResults(id uuid.UUID) (*TaskResults, *ApiRunTaskResultsListResponse) {
+	raw := doGet(fmt.Sprintf("http://localhost:9002/api/playbook-dispatcher/v1/runs/%s/task_results", id))
+	res, err := ParseApiRunTaskResultsListResponse(raw)
+	Expect(err).ToNot(HaveOccurred())
+	return res.JSON200, res
+}
+
+func taskResultEvent(counter int, event, host, task string, res *messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes) messageModel.PlaybookRunResponseMessageYamlEventsElem {
+	duration := 0.5
+
+	return messageModel.PlaybookRunResponseMessageYamlEventsElem{
+		Event:   event,
+		Uuid:    uuid.New().String(),
+		Counter: counter,
+		EventData: &messageModel.PlaybookRunResponseMessageYamlEventsElemEventData{
+			Host:     &host,
+			Play:     utils.StringRef("play"),
+			Task:     &task,
+			Duration: &duration,
+			Res:      res,
+		},
+	}
+}
+
+var _ = Describe("runTaskResultsList", func() {
+	db := test.WithDatabase()
+
+	It("lists the results of tasks", func() {
+		data := test.NewRunWithStatus(orgId(), "failure")
+		data.Events = utils.MustMarshal([]messageModel.PlaybookRunResponseMessageYamlEventsElem{
+			test.EventPlaybookOnStart(),
+			taskResultEvent(3, "runner_on_failed", "host2", "install", &messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{Msg: "No package matching 'foo' found"}),
+			taskResultEvent(2, "runner_on_ok", "host1", "install", &messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{Changed: true}),
+		})
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		results, res := listTaskResults(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(results.Data).To(HaveLen(2))
+
+		Expect(results.Data[0].Host).To(Equal("host1"))
+		Expect(results.Data[0].Play).To(Equal("play"))
+		Expect(results.Data[0].Task).To(Equal("install"))
+		Expect(results.Data[0].Status).To(Equal(TaskResultStatusChanged))
+		Expect(*results.Data[0].Duration).To(BeEquivalentTo(0.5))
+		Expect(results.Data[0].Message).To(BeNil())
+
+		Expect(results.Data[1].Host).To(Equal("host2"))
+		Expect(results.Data[1].Status).To(Equal(TaskResultStatusFailed))
+		Expect(results.Data[1].Ignored).To(BeFalse())
+		Expect(*results.Data[1].Message).To(Equal("No package matching 'foo' found"))
+	})
+
+	It("redacts the values of secret extra vars from the messages", func() {
+		data := test.NewRunWithStatus(orgId(), "failure")
+		data.ExtraVars = &dbModel.ExtraVars{
+			Values:  map[string]interface{}{"token": "s3cr3t"},
+			Secrets: []string{"token"},
+		}
+		data.Events = utils.MustMarshal([]messageModel.PlaybookRunResponseMessageYamlEventsElem{
+			taskResultEvent(2, "runner_on_failed", "host1", "login", &messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{Msg: "Invalid token s3cr3t"}),
+		})
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		results, res := listTaskResults(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(results.Data).To(HaveLen(1))
+		Expect(*results.Data[0].Message).To(Equal("Invalid token [REDACTED]"))
+	})
+
+	It("returns an empty list for a run without events", func() {
+		data := test.NewRun(orgId())
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		results, res := listTaskResults(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusOK))
+		Expect(results.Data).To(BeEmpty())
+	})
+
+	It("404s on unknown run", func() {
+		_, res := listTaskResults(uuid.New())
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	It("404s on run of a different tenant", func() {
+		data := test.NewRun("1234567")
+		Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+		_, res := listTaskResults(data.ID)
+		Expect(res.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+})
@@ -2,6 +2,7 @@ package public
 
 import (
 	"net/http"
+	"net/url"
 	dbModel "playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/utils/test"
 	"time"
@@ -16,6 +17,13 @@ func listRunsRaw(keysAndValues ...interface{}) *http.Response {
 	return doGet("http://localhost:9002/api/playbook-dispatcher/v1/runs", keysAndValues...)
 }
 
+func cursorOf(link *string) string {
+	Expect(link).ToNot(BeNil())
+	parsed, err := url.Parse(*link)
+	Expect(err).ToNot(HaveOccurred())
+	return parsed.Query().Get("cursor")
+}
+
 func listRuns(keysAndValues ...interface{}) (*Runs, *ApiRunsListResponse) {
 	raw := listRunsRaw(keysAndValues...)
 	res, err := ParseApiRunsListResponse(raw)
@@ -372,6 +380,25 @@ var _ = Describe("runsList", func() {
 		})
 	})
 
+	Describe("retry chain", func() {
+		It("returns parent_run_id and attempt", func() {
+			parent := test.NewRunWithStatus(orgId(), dbModel.RunStatusFailure)
+			Expect(db().Create(&parent).Error).ToNot(HaveOccurred())
+
+			retry := test.NewRun(orgId())
+			retry.ParentRunID = &parent.ID
+			retry.Attempt = 2
+			Expect(db().Create(&retry).Error).ToNot(HaveOccurred())
+
+			runs, res := listRuns("fields[data]", "id,parent_run_id,attempt", "filter[status]", "running")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(1))
+			Expect(*runs.Data[0].Id).To(BeEquivalentTo(retry.ID))
+			Expect(*runs.Data[0].ParentRunId).To(BeEquivalentTo(parent.ID))
+			Expect(*runs.Data[0].Attempt).To(Equal(2))
+		})
+	})
+
 	Describe("RBAC", func() {
 		var data []dbModel.Run
 
@@ -421,7 +448,7 @@ var _ = Describe("runsList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 
 			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/runs?limit=50&offset=0"))
-			Expect((*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?limit=50&offset=0"))
+			Expect(*(*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?limit=50&offset=0"))
 			Expect((*runs).Links.Next).To(BeNil())
 			Expect((*runs).Links.Previous).To(BeNil())
 		})
@@ -431,7 +458,7 @@ var _ = Describe("runsList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 
 			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/runs?limit=1&offset=0"))
-			Expect((*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?limit=1&offset=4"))
+			Expect(*(*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?limit=1&offset=4"))
 			Expect(*(*runs).Links.Next).To(Equal("/api/playbook-dispatcher/v1/runs?limit=1&offset=2"))
 			Expect(*(*runs).Links.Previous).To(Equal("/api/playbook-dispatcher/v1/runs?limit=1&offset=0"))
 		})
@@ -441,7 +468,7 @@ var _ = Describe("runsList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 
 			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/runs?limit=2&offset=0"))
-			Expect((*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?limit=2&offset=4"))
+			Expect(*(*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?limit=2&offset=4"))
 			Expect(*(*runs).Links.Next).To(Equal("/api/playbook-dispatcher/v1/runs?limit=2&offset=3"))
 			Expect(*(*runs).Links.Previous).To(Equal("/api/playbook-dispatcher/v1/runs?limit=2&offset=0"))
 		})
@@ -451,9 +478,117 @@ var _ = Describe("runsList", func() {
 			Expect(res.StatusCode()).To(Equal(http.StatusOK))
 
 			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/runs?fields%5Bdata%5D=id&filter%5Bstatus%5D=running&limit=1&offset=0&sort_by=created_at%3Adesc"))
-			Expect((*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?fields%5Bdata%5D=id&filter%5Bstatus%5D=running&limit=1&offset=4&sort_by=created_at%3Adesc"))
+			Expect(*(*runs).Links.Last).To(Equal("/api/playbook-dispatcher/v1/runs?fields%5Bdata%5D=id&filter%5Bstatus%5D=running&limit=1&offset=4&sort_by=created_at%3Adesc"))
 			Expect(*(*runs).Links.Next).To(Equal("/api/playbook-dispatcher/v1/runs?fields%5Bdata%5D=id&filter%5Bstatus%5D=running&limit=1&offset=2&sort_by=created_at%3Adesc"))
 			Expect(*(*runs).Links.Previous).To(Equal("/api/playbook-dispatcher/v1/runs?fields%5Bdata%5D=id&filter%5Bstatus%5D=running&limit=1&offset=0&sort_by=created_at%3Adesc"))
 		})
 	})
+
+	Describe("cursor", func() {
+		var data []dbModel.Run
+
+		BeforeEach(func() {
+			data = []dbModel.Run{
+				test.NewRun(orgId()),
+				test.NewRun(orgId()),
+				test.NewRun(orgId()),
+				test.NewRun(orgId()),
+				test.NewRun(orgId()),
+			}
+
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+		})
+
+		DescribeTable("traverses all runs",
+			func(sortBy string) {
+				ids := []uuid.UUID{}
+				cursor := ""
+
+				for page := 0; page < 3; page++ {
+					runs, res := listRuns("limit", 2, "cursor", cursor, "sort_by", sortBy)
+					Expect(res.StatusCode()).To(Equal(http.StatusOK))
+					Expect(*runs.Meta.Total).To(Equal(5))
+					Expect(runs.Links.Last).To(BeNil())
+					Expect(runs.Links.Previous).To(BeNil())
+
+					for _, run := range runs.Data {
+						ids = append(ids, *run.Id)
+					}
+
+					if page < 2 {
+						Expect(runs.Data).To(HaveLen(2))
+						cursor = cursorOf(runs.Links.Next)
+					} else {
+						Expect(runs.Data).To(HaveLen(1))
+						Expect(runs.Links.Next).To(BeNil())
+					}
+				}
+
+				Expect(ids).To(ConsistOf(data[0].ID, data[1].ID, data[2].ID, data[3].ID, data[4].ID))
+			},
+
+			Entry("newest first", "created_at:desc"),
+			Entry("oldest first", "created_at:asc"),
+		)
+
+		It("builds the cursor if id and created_at are not requested", func() {
+			runs, res := listRuns("limit", 3, "cursor", "", "fields[data]", "status")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(3))
+			Expect(runs.Data[0].Id).To(BeNil())
+			Expect(runs.Data[0].CreatedAt).To(BeNil())
+
+			runs, res = listRuns("limit", 3, "cursor", cursorOf(runs.Links.Next), "fields[data]", "status")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(2))
+		})
+
+		It("is not affected by runs created while paginating", func() {
+			runs, res := listRuns("limit", 2, "cursor", "")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(2))
+
+			newRun := test.NewRun(orgId())
+			Expect(db().Create(&newRun).Error).ToNot(HaveOccurred())
+
+			runs, res = listRuns("limit", 10, "cursor", cursorOf(runs.Links.Next))
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(3))
+
+			for _, run := range runs.Data {
+				Expect(*run.Id).ToNot(Equal(newRun.ID))
+			}
+		})
+
+		It("returns cursor links", func() {
+			runs, res := listRuns("limit", 2, "cursor", "", "filter[status]", "running")
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+
+			Expect((*runs).Links.First).To(Equal("/api/playbook-dispatcher/v1/runs?cursor=&filter%5Bstatus%5D=running&limit=2"))
+			Expect(*(*runs).Links.Next).To(HavePrefix("/api/playbook-dispatcher/v1/runs?cursor="))
+			Expect(*(*runs).Links.Next).To(HaveSuffix("&filter%5Bstatus%5D=running&limit=2"))
+		})
+
+		It("skips the total if not requested", func() {
+			runs, res := listRuns("limit", 2, "offset", 2, "include_total", false)
+			Expect(res.StatusCode()).To(Equal(http.StatusOK))
+			Expect(runs.Data).To(HaveLen(2))
+
+			Expect(runs.Meta.Count).To(Equal(2))
+			Expect(runs.Meta.Total).To(BeNil())
+			Expect(runs.Links.Last).To(BeNil())
+			Expect(*runs.Links.Next).To(Equal("/api/playbook-dispatcher/v1/runs?include_total=false&limit=2&offset=4"))
+			Expect(*runs.Links.Previous).To(Equal("/api/playbook-dispatcher/v1/runs?include_total=false&limit=2&offset=0"))
+		})
+
+		It("400s if cursor is combined with offset", func() {
+			_, res := listRuns("cursor", "", "offset", 2)
+			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+
+		It("400s on invalid cursor", func() {
+			_, res := listRuns("cursor", "abc")
+			Expect(res.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+	})
 })
@@ -0,0 +1,69 @@
+package instrumentation
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	callbackDeliveredTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "callback_worker_delivered_total",
+		Help: "The total number of successfully delivered callbacks",
+	})
+
+	callbackAttemptFailedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "callback_worker_attempt_failed_total",
+		Help: "The total number of callback delivery attempts that failed and will be retried",
+	})
+
+	callbackAbandonedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "callback_worker_abandoned_total",
+		Help: "The total number of callbacks given up on after the maximum number of attempts",
+	})
+
+	errorTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "callback_worker_error_total",
+		Help: "The total number of errors during callback processing",
+	}, []string{"type"})
+)
+
+const (
+	labelDbClaim  = "db_claim"
+	labelDbUpdate = "db_update"
+)
+
+func CallbackDelivered(ctx context.Context, deliveryId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Infow("Delivered callback", "delivery_id", deliveryId.String(), "run_id", runId.String(), "attempts", attempts)
+	callbackDeliveredTotal.Inc()
+}
+
+func CallbackAttemptFailed(ctx context.Context, err error, deliveryId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Warnw("Callback delivery attempt failed", "error", err, "delivery_id", deliveryId.String(), "run_id", runId.String(), "attempts", attempts)
+	callbackAttemptFailedTotal.Inc()
+}
+
+func CallbackAbandoned(ctx context.Context, err error, deliveryId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Errorw("Giving up on callback delivery", "error", err, "delivery_id", deliveryId.String(), "run_id", runId.String(), "attempts", attempts)
+	callbackAbandonedTotal.Inc()
+}
+
+func ClaimError(ctx context.Context, err error) {
+	utils.GetLogFromContext(ctx).Errorw("Error claiming pending callbacks", "error", err)
+	errorTotal.WithLabelValues(labelDbClaim).Inc()
+}
+
+func UpdateError(ctx context.Context, err error, deliveryId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error updating callback delivery", "error", err, "delivery_id", deliveryId.String())
+	errorTotal.WithLabelValues(labelDbUpdate).Inc()
+}
+
+func Start() {
+	// initialize label values
+	// https://www.robustperception.io/existential-issues-with-metrics
+	errorTotal.WithLabelValues(labelDbClaim)
+	errorTotal.WithLabelValues(labelDbUpdate)
+}
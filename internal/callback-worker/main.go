@@ -0,0 +1,70 @@
+package callbackWorker
+
+import (
+	"context"
+	"net/http"
+	"playbook-dispatcher/internal/callback-worker/instrumentation"
+	"playbook-dispatcher/internal/common/callback"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/queue"
+	"playbook-dispatcher/internal/common/utils"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+func Start(
+	ctx context.Context,
+	cfg *viper.Viper,
+	errors chan<- error,
+	ready, live *utils.ProbeHandler,
+	wg *sync.WaitGroup,
+) {
+	instrumentation.Start()
+
+	db, sql := db.Connect(ctx, cfg)
+	ready.Register(sql.Ping)
+	live.Register(sql.Ping)
+
+	secrets := callback.BuildSecretsFromEnv()
+	utils.GetLogFromContext(ctx).Infow("Callback signing keys loaded", "secret_refs", utils.MapKeysString(secrets))
+
+	allowedHosts := utils.ParseHostAllowlist(cfg.GetString("outbound.allowed.hosts"))
+
+	worker := &worker{
+		db: db,
+		client: &http.Client{
+			Timeout:       time.Duration(cfg.GetInt64("callback.timeout") * int64(time.Second)),
+			CheckRedirect: allowedHosts.CheckRedirect(true),
+		},
+		secrets:      secrets,
+		allowedHosts: allowedHosts,
+		Options:      queue.OptionsFromConfig(cfg, "callback"),
+	}
+
+	pollInterval := time.Duration(cfg.GetInt("callback.poll.interval")) * time.Second
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer utils.GetLogFromContext(ctx).Debug("Callback worker stopped")
+		defer sql.Close()
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			// keep going without waiting while there is a backlog of due callbacks
+			if worker.processBatch(ctx) == worker.BatchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,130 @@
+package callbackWorker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"playbook-dispatcher/internal/callback-worker/instrumentation"
+	"playbook-dispatcher/internal/common/callback"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/queue"
+	"playbook-dispatcher/internal/common/utils"
+	"strconv"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type worker struct {
+	db      *gorm.DB
+	client  *http.Client
+	secrets map[string]string
+	// checked again on delivery in case the allowlist has been narrowed since the run was created
+	allowedHosts utils.HostAllowlist
+
+	queue.Options
+}
+
+// processBatch delivers a batch of pending callbacks that are due.
+// Returns the number of callbacks processed.
+func (this *worker) processBatch(ctx context.Context) int {
+	deliveries, err := this.claim(ctx)
+	if err != nil {
+		instrumentation.ClaimError(ctx, err)
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery dbModel.CallbackDelivery) {
+			defer wg.Done()
+			this.process(ctx, delivery)
+		}(delivery)
+	}
+
+	wg.Wait()
+	return len(deliveries)
+}
+
+// claim leases pending callbacks that are due
+func (this *worker) claim(ctx context.Context) ([]dbModel.CallbackDelivery, error) {
+	var deliveries []dbModel.CallbackDelivery
+	err := this.Claim(this.db.WithContext(ctx), &deliveries, "callback_deliveries", "status = ?", dbModel.CallbackDeliveryStatusPending)
+	return deliveries, err
+}
+
+func (this *worker) process(ctx context.Context, delivery dbModel.CallbackDelivery) {
+	attempts := delivery.Attempts + 1
+
+	deliveryErr := this.deliver(ctx, delivery)
+
+	updates := map[string]interface{}{
+		"attempts":   attempts,
+		"updated_at": time.Now(),
+	}
+
+	switch {
+	case deliveryErr == nil:
+		updates["status"] = dbModel.CallbackDeliveryStatusDelivered
+		updates["last_error"] = nil
+		instrumentation.CallbackDelivered(ctx, delivery.ID, delivery.RunID, attempts)
+	case attempts >= this.MaxAttempts:
+		updates["status"] = dbModel.CallbackDeliveryStatusFailed
+		updates["last_error"] = queue.TruncateError(deliveryErr)
+		instrumentation.CallbackAbandoned(ctx, deliveryErr, delivery.ID, delivery.RunID, attempts)
+	default:
+		updates["next_attempt_at"] = time.Now().Add(this.Backoff(attempts))
+		updates["last_error"] = queue.TruncateError(deliveryErr)
+		instrumentation.CallbackAttemptFailed(ctx, deliveryErr, delivery.ID, delivery.RunID, attempts)
+	}
+
+	result := this.db.WithContext(ctx).Model(&dbModel.CallbackDelivery{}).
+		Where("id = ?", delivery.ID).
+		Where("status = ?", dbModel.CallbackDeliveryStatusPending).
+		Updates(updates)
+
+	if result.Error != nil {
+		instrumentation.UpdateError(ctx, result.Error, delivery.ID)
+	}
+}
+
+func (this *worker) deliver(ctx context.Context, delivery dbModel.CallbackDelivery) error {
+	secret, ok := this.secrets[delivery.SecretRef]
+	if !ok {
+		return fmt.Errorf("unknown secret_ref: %s", delivery.SecretRef)
+	}
+
+	if err := this.allowedHosts.CheckURL(delivery.URL, true); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set(callback.HeaderDeliveryId, delivery.ID.String())
+	req.Header.Set(callback.HeaderTimestamp, timestamp)
+	req.Header.Set(callback.HeaderSignature, callback.Sign(secret, timestamp, delivery.Payload))
+
+	res, err := this.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code: %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package callbackWorker
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"playbook-dispatcher/internal/common/callback"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/queue"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("worker", func() {
+	db := test.WithDatabase()
+
+	var (
+		server   *httptest.Server
+		status   int
+		location string
+		requests chan *http.Request
+		bodies   chan []byte
+	)
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		location = ""
+		requests = make(chan *http.Request, 10)
+		bodies = make(chan []byte, 10)
+
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests <- r
+			bodies <- body
+			if location != "" {
+				w.Header().Set("location", location)
+			}
+			w.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newWorker := func() *worker {
+		allowedHosts := utils.HostAllowlist{"127.0.0.1"}
+
+		client := server.Client()
+		client.CheckRedirect = allowedHosts.CheckRedirect(true)
+
+		return &worker{
+			db:           db(),
+			client:       client,
+			secrets:      map[string]string{"test": "secret"},
+			allowedHosts: allowedHosts,
+			Options: queue.Options{
+				BatchSize:   100,
+				Lease:       time.Minute,
+				MaxAttempts: 3,
+				BackoffBase: 10 * time.Second,
+				BackoffMax:  time.Hour,
+			},
+		}
+	}
+
+	newDelivery := func(attempts int) dbModel.CallbackDelivery {
+		run := test.NewRunWithStatus("5318290", dbModel.RunStatusSuccess)
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		delivery := dbModel.CallbackDelivery{
+			ID:            uuid.New(),
+			RunID:         run.ID,
+			URL:           server.URL,
+			SecretRef:     "test",
+			Payload:       []byte(`{"event_type":"update"}`),
+			Status:        dbModel.CallbackDeliveryStatusPending,
+			Attempts:      attempts,
+			NextAttemptAt: time.Now().Add(-time.Second),
+		}
+		Expect(db().Create(&delivery).Error).ToNot(HaveOccurred())
+
+		return delivery
+	}
+
+	getDelivery := func(id uuid.UUID) dbModel.CallbackDelivery {
+		delivery := dbModel.CallbackDelivery{}
+		Expect(db().Where("id = ?", id).First(&delivery).Error).ToNot(HaveOccurred())
+		return delivery
+	}
+
+	It("delivers a signed callback", func() {
+		delivery := newDelivery(0)
+
+		newWorker().processBatch(test.TestContext())
+
+		var request *http.Request
+		Eventually(requests).Should(Receive(&request))
+		Expect(request.Method).To(Equal(http.MethodPost))
+		Expect(request.Header.Get(callback.HeaderDeliveryId)).To(Equal(delivery.ID.String()))
+
+		timestamp := request.Header.Get(callback.HeaderTimestamp)
+		Expect(request.Header.Get(callback.HeaderSignature)).To(Equal(callback.Sign("secret", timestamp, delivery.Payload)))
+		Expect(<-bodies).To(MatchJSON(delivery.Payload))
+
+		updated := getDelivery(delivery.ID)
+		Expect(updated.Status).To(Equal(dbModel.CallbackDeliveryStatusDelivered))
+		Expect(updated.Attempts).To(Equal(1))
+		Expect(updated.LastError).To(BeNil())
+	})
+
+	It("reschedules a failed callback", func() {
+		status = http.StatusServiceUnavailable
+		delivery := newDelivery(0)
+
+		newWorker().processBatch(test.TestContext())
+
+		updated := getDelivery(delivery.ID)
+		Expect(updated.Status).To(Equal(dbModel.CallbackDeliveryStatusPending))
+		Expect(updated.Attempts).To(Equal(1))
+		Expect(*updated.LastError).To(Equal("unexpected status code: 503"))
+		Expect(updated.NextAttemptAt).To(BeTemporally("~", time.Now().Add(10*time.Second), 2*time.Second))
+	})
+
+	It("gives up once the maximum number of attempts is reached", func() {
+		status = http.StatusInternalServerError
+		delivery := newDelivery(2)
+
+		newWorker().processBatch(test.TestContext())
+
+		updated := getDelivery(delivery.ID)
+		Expect(updated.Status).To(Equal(dbModel.CallbackDeliveryStatusFailed))
+		Expect(updated.Attempts).To(Equal(3))
+	})
+
+	It("does not send a callback to a host that is not allowed", func() {
+		delivery := newDelivery(0)
+
+		worker := newWorker()
+		worker.allowedHosts = utils.HostAllowlist{"hooks.example.com"}
+		worker.processBatch(test.TestContext())
+
+		Consistently(requests, 100*time.Millisecond).ShouldNot(Receive())
+		Expect(*getDelivery(delivery.ID).LastError).To(Equal("Host not allowed: 127.0.0.1"))
+	})
+
+	It("does not follow a redirect to a host that is not allowed", func() {
+		status = http.StatusFound
+		location = "https://hooks.example.com/callback"
+		delivery := newDelivery(0)
+
+		newWorker().processBatch(test.TestContext())
+
+		Eventually(requests).Should(Receive())
+		Consistently(requests, 100*time.Millisecond).ShouldNot(Receive())
+
+		updated := getDelivery(delivery.ID)
+		Expect(updated.Status).To(Equal(dbModel.CallbackDeliveryStatusPending))
+		Expect(*updated.LastError).To(ContainSubstring("Host not allowed: hooks.example.com"))
+	})
+
+	It("does not pick up callbacks that are not due yet", func() {
+		delivery := newDelivery(0)
+		Expect(db().Model(&delivery).Update("next_attempt_at", time.Now().Add(time.Hour)).Error).ToNot(HaveOccurred())
+
+		newWorker().processBatch(test.TestContext())
+
+		Consistently(requests, 100*time.Millisecond).ShouldNot(Receive())
+		Expect(getDelivery(delivery.ID).Attempts).To(Equal(0))
+	})
+
+})
@@ -0,0 +1,341 @@
+package ansible
+
+import (
+	"encoding/json"
+	"fmt"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/utils"
+	"sort"
+	"strings"
+)
+
+const (
+	TaskStatusOk          = "ok"
+	TaskStatusChanged     = "changed"
+	TaskStatusFailed      = "failed"
+	TaskStatusSkipped     = "skipped"
+	TaskStatusUnreachable = "unreachable"
+)
+
+type TaskResult struct {
+	Host     string
+	Play     string
+	Task     string
+	Status   string
+	Ignored  bool
+	Duration *float64
+	Message  *string
+}
+
+// GetTaskResults determines the outcome of each task on each host.
+// The results are derived from the events Ansible emits once a task finishes on a host and are ordered by the event counter.
+func GetTaskResults(events []messageModel.PlaybookRunResponseMessageYamlEventsElem) []TaskResult {
+	sorted := make([]messageModel.PlaybookRunResponseMessageYamlEventsElem, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Counter < sorted[j].Counter
+	})
+
+	results := []TaskResult{}
+
+	for _, event := range sorted {
+		if event.EventData == nil || event.EventData.Host == nil {
+			continue
+		}
+
+		var status string
+		switch event.Event {
+		case "runner_on_ok":
+			status = TaskStatusOk
+			if event.EventData.Res != nil && event.EventData.Res.Changed == true {
+				status = TaskStatusChanged
+			}
+		case "runner_on_failed":
+			status = TaskStatusFailed
+		case "runner_on_skipped":
+			status = TaskStatusSkipped
+		case "runner_on_unreachable":
+			status = TaskStatusUnreachable
+		default:
+			continue
+		}
+
+		result := TaskResult{
+			Host:     *event.EventData.Host,
+			Play:     stringValue(event.EventData.Play),
+			Task:     stringValue(event.EventData.Task),
+			Status:   status,
+			Ignored:  status == TaskStatusFailed && event.EventData.IgnoreErrors != nil && *event.EventData.IgnoreErrors,
+			Duration: event.EventData.Duration,
+		}
+
+		if event.EventData.Res != nil {
+			result.Message = formatMessage(event.EventData.Res.Msg)
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
+// modules usually report a string but some report a list of messages or a structured value
+func formatMessage(msg interface{}) *string {
+	var result string
+
+	switch value := msg.(type) {
+	case nil:
+		return nil
+	case string:
+		result = value
+	default:
+		if encoded, err := json.Marshal(value); err == nil {
+			result = string(encoded)
+		} else {
+			result = fmt.Sprintf("%v", value)
+		}
+	}
+
+	if result == "" {
+		return nil
+	}
+
+	return &result
+}
+
+// GetHostStats determines the play recap of the given host from the playbook_on_stats event.
+// nil is returned if the playbook has not finished yet.
+func GetHostStats(events []messageModel.PlaybookRunResponseMessageYamlEventsElem, host string) *dbModel.RunHostStats {
+	var stats *dbModel.RunHostStats
+
+	for _, event := range events {
+		if event.Event != "playbook_on_stats" || event.EventData == nil {
+			continue
+		}
+
+		stats = &dbModel.RunHostStats{
+			Ok:          event.EventData.Ok[host],
+			Changed:     event.EventData.Changed[host],
+			Failures:    event.EventData.Failures[host],
+			Unreachable: event.EventData.Dark[host],
+			Skipped:     event.EventData.Skipped[host],
+			Rescued:     event.EventData.Rescued[host],
+			Ignored:     event.EventData.Ignored[host],
+		}
+	}
+
+	return stats
+}
+
+// GetHostDiffs collects the diffs reported by the tasks that changed (or in check mode would change) the given host, ordered by the event counter.
+// Modules report a diff as a single object or as a list of objects.
+// nil is returned if no task reported a diff, e.g. because the Playbook did not run in diff mode.
+func GetHostDiffs(events []messageModel.PlaybookRunResponseMessageYamlEventsElem, host string) dbModel.RunHostDiffs {
+	sorted := make([]messageModel.PlaybookRunResponseMessageYamlEventsElem, len(events))
+	copy(sorted, events)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Counter < sorted[j].Counter
+	})
+
+	var diffs dbModel.RunHostDiffs
+
+	for _, event := range sorted {
+		if event.Event != "runner_on_ok" || event.EventData == nil || event.EventData.Host == nil || *event.EventData.Host != host {
+			continue
+		}
+
+		if event.EventData.Res == nil || event.EventData.Res.Changed != true {
+			continue
+		}
+
+		changes := parseDiff(event.EventData.Res.Diff)
+		if len(changes) == 0 {
+			continue
+		}
+
+		diffs = append(diffs, dbModel.TaskDiff{
+			Play:    stringValue(event.EventData.Play),
+			Task:    stringValue(event.EventData.Task),
+			Changes: changes,
+		})
+	}
+
+	return diffs
+}
+
+// RedactDiffs returns a copy of the diffs in which the given secret values are redacted.
+// A diff shows the content a task wrote, which includes the values of any secret extra vars the task used.
+func RedactDiffs(diffs dbModel.RunHostDiffs, secrets dbModel.Secrets) dbModel.RunHostDiffs {
+	if diffs == nil || secrets.IsEmpty() {
+		return diffs
+	}
+
+	secrets = sortSecrets(secrets)
+	result := make(dbModel.RunHostDiffs, len(diffs))
+
+	for i, diff := range diffs {
+		result[i] = dbModel.TaskDiff{
+			Play:    redactString(diff.Play, secrets),
+			Task:    redactString(diff.Task, secrets),
+			Changes: make([]dbModel.DiffChange, len(diff.Changes)),
+		}
+
+		for j, change := range diff.Changes {
+			result[i].Changes[j] = dbModel.DiffChange{
+				Before:       redactValue(change.Before, secrets),
+				After:        redactValue(change.After, secrets),
+				BeforeHeader: redactOptionalString(change.BeforeHeader, secrets),
+				AfterHeader:  redactOptionalString(change.AfterHeader, secrets),
+				Prepared:     redactOptionalString(change.Prepared, secrets),
+			}
+		}
+	}
+
+	return result
+}
+
+// RedactTaskResults returns a copy of the task results in which the given secret values are redacted.
+// The message of a task, e.g. that of a failed assertion or a debug task, may include the values of secret extra vars.
+func RedactTaskResults(results []TaskResult, secrets dbModel.Secrets) []TaskResult {
+	if secrets.IsEmpty() {
+		return results
+	}
+
+	secrets = sortSecrets(secrets)
+	redacted := make([]TaskResult, len(results))
+
+	for i, result := range results {
+		redacted[i] = result
+		redacted[i].Play = redactString(result.Play, secrets)
+		redacted[i].Task = redactString(result.Task, secrets)
+		redacted[i].Message = redactOptionalString(result.Message, secrets)
+	}
+
+	return redacted
+}
+
+// RedactText returns the text with every occurrence of the given secret strings redacted.
+func RedactText(text string, secrets dbModel.Secrets) string {
+	if len(secrets.Strings) == 0 {
+		return text
+	}
+
+	return redactString(text, sortSecrets(secrets))
+}
+
+// longer secrets go first so that a secret containing another one is redacted as a whole
+func sortSecrets(secrets dbModel.Secrets) dbModel.Secrets {
+	sorted := append([]string{}, secrets.Strings...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+
+	return dbModel.Secrets{Strings: sorted, Values: secrets.Values}
+}
+
+func redactValue(value interface{}, secrets dbModel.Secrets) interface{} {
+	switch value := value.(type) {
+	case string:
+		return redactString(value, secrets)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			result[key] = redactValue(item, secrets)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, item := range value {
+			result[i] = redactValue(item, secrets)
+		}
+		return result
+	case float64:
+		// a number equal to a secret or containing a secret string is redacted as a whole
+		if text := fmt.Sprint(value); isSecretValue(value, secrets) || redactString(text, secrets) != text {
+			return utils.RedactedValue
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+func redactOptionalString(value *string, secrets dbModel.Secrets) *string {
+	if value == nil {
+		return nil
+	}
+
+	result := redactString(*value, secrets)
+	return &result
+}
+
+func redactString(value string, secrets dbModel.Secrets) string {
+	if isSecretValue(value, secrets) {
+		return utils.RedactedValue
+	}
+
+	for _, secret := range secrets.Strings {
+		value = strings.ReplaceAll(value, secret, utils.RedactedValue)
+	}
+
+	return value
+}
+
+func isSecretValue(value interface{}, secrets dbModel.Secrets) bool {
+	for _, secret := range secrets.Values {
+		if value == secret {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseDiff(value interface{}) []dbModel.DiffChange {
+	var items []interface{}
+
+	switch diff := value.(type) {
+	case map[string]interface{}:
+		items = []interface{}{diff}
+	case []interface{}:
+		items = diff
+	default:
+		return nil
+	}
+
+	changes := []dbModel.DiffChange{}
+
+	for _, item := range items {
+		entry, ok := item.(map[string]interface{})
+		if !ok || len(entry) == 0 {
+			continue
+		}
+
+		changes = append(changes, dbModel.DiffChange{
+			Before:       entry["before"],
+			After:        entry["after"],
+			BeforeHeader: optionalString(entry["before_header"]),
+			AfterHeader:  optionalString(entry["after_header"]),
+			Prepared:     optionalString(entry["prepared"]),
+		})
+	}
+
+	return changes
+}
+
+func optionalString(value interface{}) *string {
+	if result, ok := value.(string); ok {
+		return &result
+	}
+
+	return nil
+}
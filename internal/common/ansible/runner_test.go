@@ -3,7 +3,9 @@ package ansible
 import (
 	"encoding/json"
 	"os"
+	dbModel "playbook-dispatcher/internal/common/model/db"
 	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/utils"
 	"strings"
 
 	. "github.com/onsi/ginkgo"
@@ -105,5 +107,187 @@ var _ = Describe("Ansible", func() {
 			stdout := GetStdout(events, nil)
 			Expect(stdout).To(Equal("\r\nPLAY [ping] ********************************************************************\n\r\nTASK [ping] ********************************************************************\n\x1b[0;32mok: [localhost]\x1b[0m\n\r\nPLAY RECAP *********************************************************************\r\n\x1b[0;32mlocalhost\x1b[0m                  : \x1b[0;32mok=1   \x1b[0m changed=0    unreachable=0    failed=0    skipped=0    rescued=0    ignored=0   \r\n\n"))
 		})
+
+		It("redacts secret values from stdout", func() {
+			secrets := dbModel.ExtraVars{
+				Values:  map[string]interface{}{"password": "abcdef", "key": "abcdefgh", "pin": "abc", "port": 1234.0},
+				Secrets: []string{"password", "key", "pin", "port"},
+			}.SecretValues()
+
+			// short strings and numbers would match unrelated output
+			Expect(RedactText("password=abcdef, key=abcdefgh, pin=abc, port=1234", secrets)).To(Equal("password=[REDACTED], key=[REDACTED], pin=abc, port=1234"))
+			Expect(RedactText("password=abcdef", dbModel.Secrets{})).To(Equal("password=abcdef"))
+		})
+	})
+
+	Describe("task results", func() {
+		It("determines task results from a successful run", func() {
+			events := loadFile("./test-events1.jsonl")
+			results := GetTaskResults(events)
+			Expect(results).To(HaveLen(1))
+			Expect(results[0]).To(Equal(TaskResult{
+				Host:   "localhost",
+				Play:   "ping",
+				Task:   "ping",
+				Status: TaskStatusOk,
+			}))
+		})
+
+		It("determines task results from a failed run", func() {
+			events := loadFile("./test-events2.jsonl")
+			results := GetTaskResults(events)
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Task).To(Equal("fail"))
+			Expect(results[0].Status).To(Equal(TaskStatusFailed))
+			Expect(results[0].Ignored).To(BeFalse())
+			Expect(*results[0].Message).To(Equal("Always fail"))
+		})
+
+		It("determines task results from an incomplete run", func() {
+			events := loadFile("./test-events3.jsonl")
+			Expect(GetTaskResults(events)).To(BeEmpty())
+		})
+
+		It("determines task results from a multi-host run", func() {
+			events := loadFile("./test-events8.jsonl")
+			results := GetTaskResults(events)
+			Expect(results).To(HaveLen(6))
+
+			Expect(results[0].Host).To(Equal("host1"))
+			Expect(results[0].Play).To(Equal("update hosts"))
+			Expect(results[0].Task).To(Equal("Gather facts"))
+			Expect(results[0].Status).To(Equal(TaskStatusOk))
+			Expect(*results[0].Duration).To(Equal(1.25))
+			Expect(results[0].Message).To(BeNil())
+
+			Expect(results[1].Host).To(Equal("host2"))
+			Expect(results[1].Status).To(Equal(TaskStatusUnreachable))
+			Expect(*results[1].Message).To(Equal("Failed to connect to the host via ssh"))
+
+			Expect(results[2].Task).To(Equal("Update packages"))
+			Expect(results[2].Status).To(Equal(TaskStatusChanged))
+
+			Expect(results[3].Task).To(Equal("Check services"))
+			Expect(results[3].Status).To(Equal(TaskStatusFailed))
+			Expect(results[3].Ignored).To(BeTrue())
+			Expect(*results[3].Message).To(Equal("non-zero return code"))
+
+			Expect(results[4].Task).To(Equal("Reboot"))
+			Expect(results[4].Status).To(Equal(TaskStatusSkipped))
+
+			Expect(results[5].Task).To(Equal("Verify"))
+			Expect(results[5].Ignored).To(BeFalse())
+			Expect(*results[5].Message).To(Equal(`["first problem","second problem"]`))
+		})
+
+		It("redacts secret values from the task results", func() {
+			results := []TaskResult{{
+				Host:    "localhost",
+				Play:    "configure app",
+				Task:    "Check abcdef",
+				Status:  TaskStatusFailed,
+				Message: utils.StringRef("Login with abcdef failed"),
+			}, {
+				Host:   "localhost",
+				Play:   "configure app",
+				Task:   "ping",
+				Status: TaskStatusOk,
+			}}
+
+			redacted := RedactTaskResults(results, dbModel.Secrets{Strings: []string{"abcdef"}})
+
+			Expect(redacted[0].Task).To(Equal("Check [REDACTED]"))
+			Expect(*redacted[0].Message).To(Equal("Login with [REDACTED] failed"))
+			Expect(redacted[1]).To(Equal(results[1]))
+
+			// the original results are left untouched
+			Expect(*results[0].Message).To(Equal("Login with abcdef failed"))
+		})
+
+		It("does not reorder the given events", func() {
+			events := loadFile("./test-events8.jsonl")
+			events[0], events[1] = events[1], events[0]
+			GetTaskResults(events)
+			Expect(events[0].Counter).To(Equal(2))
+		})
+	})
+
+	Describe("host stats", func() {
+		It("determines host stats from a successful run", func() {
+			events := loadFile("./test-events1.jsonl")
+			Expect(GetHostStats(events, "localhost")).To(Equal(&dbModel.RunHostStats{Ok: 1}))
+		})
+
+		It("determines host stats from a multi-host run", func() {
+			events := loadFile("./test-events8.jsonl")
+			Expect(GetHostStats(events, "host1")).To(Equal(&dbModel.RunHostStats{Ok: 2, Changed: 1, Failures: 1, Skipped: 1, Ignored: 1}))
+			Expect(GetHostStats(events, "host2")).To(Equal(&dbModel.RunHostStats{Unreachable: 1}))
+		})
+
+		It("does not determine host stats from an incomplete run", func() {
+			events := loadFile("./test-events3.jsonl")
+			Expect(GetHostStats(events, "localhost")).To(BeNil())
+		})
+	})
+
+	Describe("host diffs", func() {
+		It("determines the diffs of a check run", func() {
+			events := loadFile("./test-events9.jsonl")
+			diffs := GetHostDiffs(events, "host1")
+
+			Expect(diffs).To(HaveLen(2))
+			Expect(diffs[0].Play).To(Equal("harden hosts"))
+			Expect(diffs[0].Task).To(Equal("Disable root login"))
+			Expect(diffs[0].Changes).To(HaveLen(1))
+			Expect(diffs[0].Changes[0].Before).To(Equal("PermitRootLogin yes\n"))
+			Expect(diffs[0].Changes[0].After).To(Equal("PermitRootLogin no\n"))
+			Expect(*diffs[0].Changes[0].BeforeHeader).To(Equal("/etc/ssh/sshd_config (content)"))
+			Expect(diffs[0].Changes[0].Prepared).To(BeNil())
+
+			Expect(diffs[1].Task).To(Equal("Remove telnet"))
+			Expect(*diffs[1].Changes[0].Prepared).To(Equal("Removed: telnet-1:0.17-85.el9.x86_64"))
+		})
+
+		It("ignores the diffs of tasks that did not change the host", func() {
+			events := loadFile("./test-events9.jsonl")
+			Expect(GetHostDiffs(events, "host2")).To(BeNil())
+		})
+
+		It("redacts secret values from the diffs", func() {
+			diffs := dbModel.RunHostDiffs{{
+				Play: "configure app",
+				Task: "Write credentials",
+				Changes: []dbModel.DiffChange{{
+					Before:      "token=abcdef\npin=abc\n",
+					After:       map[string]interface{}{"token": "abcdefgh", "pin": "abc", "ports": []interface{}{8080.0, 1234.0, 12345.0}, "debug": true},
+					AfterHeader: utils.StringRef("/etc/app.conf (abcdefgh)"),
+				}},
+			}}
+
+			secrets := dbModel.ExtraVars{
+				Values:  map[string]interface{}{"token": "abcdef", "new_token": "abcdefgh", "pin": "abc", "port": 1234.0, "debug": true},
+				Secrets: []string{"token", "new_token", "pin", "port", "debug"},
+			}.SecretValues()
+
+			redacted := RedactDiffs(diffs, secrets)
+
+			Expect(redacted[0].Changes[0].Before).To(Equal("token=[REDACTED]\npin=abc\n"))
+			Expect(redacted[0].Changes[0].After).To(Equal(map[string]interface{}{
+				"token": "[REDACTED]",
+				"pin":   "[REDACTED]",
+				"ports": []interface{}{8080.0, "[REDACTED]", 12345.0},
+				"debug": true,
+			}))
+			Expect(*redacted[0].Changes[0].AfterHeader).To(Equal("/etc/app.conf ([REDACTED])"))
+			Expect(redacted[0].Changes[0].BeforeHeader).To(BeNil())
+
+			// the original diffs are left untouched
+			Expect(diffs[0].Changes[0].Before).To(Equal("token=abcdef\npin=abc\n"))
+		})
+
+		It("does not determine diffs if none are reported", func() {
+			events := loadFile("./test-events8.jsonl")
+			Expect(GetHostDiffs(events, "host1")).To(BeNil())
+		})
 	})
 })
@@ -0,0 +1,172 @@
+package callback
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	HeaderDeliveryId = "x-playbook-dispatcher-delivery"
+	HeaderTimestamp  = "x-playbook-dispatcher-timestamp"
+	HeaderSignature  = "x-playbook-dispatcher-signature"
+
+	eventTypeUpdate = "update"
+	signaturePrefix = "sha256="
+)
+
+var envMatcher = regexp.MustCompile(`^PSK_CALLBACK_(.+?)=(.+?)$`)
+
+// RunEvent follows the structure defined in schema/run.event.yaml
+type RunEvent struct {
+	EventType string          `json:"event_type"`
+	Payload   RunEventPayload `json:"payload"`
+}
+
+type RunEventPayload struct {
+	ID              uuid.UUID                `json:"id"`
+	OrgID           string                   `json:"org_id"`
+	Recipient       uuid.UUID                `json:"recipient"`
+	CorrelationID   uuid.UUID                `json:"correlation_id"`
+	Service         string                   `json:"service"`
+	URL             string                   `json:"url"`
+	Labels          map[string]string        `json:"labels"`
+	Name            *string                  `json:"name,omitempty"`
+	WebConsoleUrl   *string                  `json:"web_console_url,omitempty"`
+	RecipientConfig *RunEventRecipientConfig `json:"recipient_config,omitempty"`
+	Status          string                   `json:"status"`
+	Timeout         int                      `json:"timeout"`
+	CreatedAt       string                   `json:"created_at"`
+	UpdatedAt       string                   `json:"updated_at"`
+}
+
+type RunEventRecipientConfig struct {
+	SatID    *string `json:"sat_id,omitempty"`
+	SatOrgID *string `json:"sat_org_id,omitempty"`
+}
+
+// BuildSecretsFromEnv reads the keys used to sign callbacks from PSK_CALLBACK_<NAME> environment variables
+func BuildSecretsFromEnv() map[string]string {
+	result := map[string]string{}
+
+	for _, param := range os.Environ() {
+		match := envMatcher.FindStringSubmatch(param)
+
+		if len(match) != 3 {
+			continue
+		}
+
+		name := strings.ToLower(match[1])
+		result[name] = match[2]
+	}
+
+	return result
+}
+
+// Sign computes the value of the signature header for the given payload.
+// The signature is a HMAC-SHA256 of "<timestamp>.<payload>" so that a captured callback cannot be replayed with a different timestamp.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Enqueue stores a pending callback delivery for each of the given runs that defines a callback.
+// It is expected to be called in the same transaction that moves the runs to a final status.
+func Enqueue(ctx context.Context, tx *gorm.DB, runIDs ...uuid.UUID) error {
+	if len(runIDs) == 0 {
+		return nil
+	}
+
+	var runs []dbModel.Run
+
+	result := tx.Model(&dbModel.Run{}).
+		Select("id", "org_id", "recipient", "correlation_id", "service", "url", "labels", "status", "timeout", "created_at", "updated_at", "playbook_name", "playbook_run_url", "sat_id", "sat_org_id", "callback_url", "callback_secret_ref").
+		Where("id IN ?", runIDs).
+		Where("callback_url IS NOT NULL").
+		Find(&runs)
+
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if len(runs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	deliveries := make([]dbModel.CallbackDelivery, len(runs))
+
+	for i, run := range runs {
+		deliveries[i] = dbModel.CallbackDelivery{
+			ID:            uuid.New(),
+			RunID:         run.ID,
+			URL:           *run.CallbackUrl,
+			SecretRef:     *run.CallbackSecretRef,
+			Payload:       utils.MustMarshal(newRunEvent(run)),
+			Status:        dbModel.CallbackDeliveryStatusPending,
+			NextAttemptAt: now,
+		}
+
+		utils.GetLogFromContext(ctx).Debugw("Enqueuing callback", "run_id", run.ID.String(), "delivery_id", deliveries[i].ID.String())
+	}
+
+	if result := tx.Create(&deliveries); result.Error != nil {
+		return fmt.Errorf("error enqueuing callbacks: %w", result.Error)
+	}
+
+	return nil
+}
+
+func newRunEvent(run dbModel.Run) RunEvent {
+	payload := RunEventPayload{
+		ID:            run.ID,
+		OrgID:         run.OrgID,
+		Recipient:     run.Recipient,
+		CorrelationID: run.CorrelationID,
+		Service:       run.Service,
+		URL:           run.URL,
+		Labels:        run.Labels,
+		Name:          run.PlaybookName,
+		Status:        run.Status,
+		Timeout:       run.Timeout,
+		CreatedAt:     run.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:     run.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+
+	if payload.Labels == nil {
+		payload.Labels = map[string]string{}
+	}
+
+	if run.PlaybookRunUrl != "" {
+		payload.WebConsoleUrl = &run.PlaybookRunUrl
+	}
+
+	if run.SatId != nil {
+		satId := run.SatId.String()
+		payload.RecipientConfig = &RunEventRecipientConfig{
+			SatID:    &satId,
+			SatOrgID: run.SatOrgId,
+		}
+	}
+
+	return RunEvent{
+		EventType: eventTypeUpdate,
+		Payload:   payload,
+	}
+}
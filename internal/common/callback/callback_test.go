@@ -0,0 +1,85 @@
+package callback
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Callback", func() {
+	Describe("Sign", func() {
+		It("signs the timestamp and the payload", func() {
+			payload := []byte(`{"event_type":"update"}`)
+
+			mac := hmac.New(sha256.New, []byte("secret"))
+			mac.Write([]byte("1700000000." + string(payload)))
+			expected := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+			Expect(Sign("secret", "1700000000", payload)).To(Equal(expected))
+		})
+
+		It("produces a different signature for a different timestamp", func() {
+			payload := []byte(`{"event_type":"update"}`)
+			Expect(Sign("secret", "1700000000", payload)).ToNot(Equal(Sign("secret", "1700000001", payload)))
+		})
+	})
+
+	Describe("BuildSecretsFromEnv", func() {
+		It("reads secrets from the environment", func() {
+			Expect(os.Setenv("PSK_CALLBACK_CONFIG_MANAGER", "abc")).To(Succeed())
+			defer os.Unsetenv("PSK_CALLBACK_CONFIG_MANAGER")
+
+			Expect(BuildSecretsFromEnv()).To(HaveKeyWithValue("config_manager", "abc"))
+		})
+	})
+
+	Describe("Enqueue", func() {
+		db := test.WithDatabase()
+
+		getDeliveries := func(runID uuid.UUID) []dbModel.CallbackDelivery {
+			var deliveries []dbModel.CallbackDelivery
+			Expect(db().Where("run_id = ?", runID).Find(&deliveries).Error).ToNot(HaveOccurred())
+			return deliveries
+		}
+
+		It("enqueues a delivery for runs with a callback", func() {
+			run := test.NewRunWithStatus("5318290", dbModel.RunStatusSuccess)
+			run.CallbackUrl = utils.StringRef("https://example.com/callback")
+			run.CallbackSecretRef = utils.StringRef("test")
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+			Expect(Enqueue(test.TestContext(), db(), run.ID)).To(Succeed())
+
+			deliveries := getDeliveries(run.ID)
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].URL).To(Equal("https://example.com/callback"))
+			Expect(deliveries[0].SecretRef).To(Equal("test"))
+			Expect(deliveries[0].Status).To(Equal(dbModel.CallbackDeliveryStatusPending))
+			Expect(deliveries[0].Attempts).To(Equal(0))
+
+			event := RunEvent{}
+			Expect(json.Unmarshal(deliveries[0].Payload, &event)).To(Succeed())
+			Expect(event.EventType).To(Equal("update"))
+			Expect(event.Payload.ID).To(Equal(run.ID))
+			Expect(event.Payload.CorrelationID).To(Equal(run.CorrelationID))
+			Expect(event.Payload.Status).To(Equal(dbModel.RunStatusSuccess))
+		})
+
+		It("ignores runs without a callback", func() {
+			run := test.NewRunWithStatus("5318290", dbModel.RunStatusSuccess)
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+			Expect(Enqueue(test.TestContext(), db(), run.ID)).To(Succeed())
+			Expect(getDeliveries(run.ID)).To(BeEmpty())
+		})
+	})
+})
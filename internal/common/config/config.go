@@ -43,6 +43,53 @@ func Get() *viper.Viper {
 
 	options.SetDefault("default.run.timeout", 3600)
 
+	options.SetDefault("run.events.buffer", 100)
+	options.SetDefault("run.events.keepalive.interval", 15)
+	options.SetDefault("run.events.listener.retry.interval", 5)
+
+	// hosts that callbacks are sent to and Playbooks are fetched from, comma-separated; other hosts are rejected
+	options.SetDefault("outbound.allowed.hosts", "")
+
+	options.SetDefault("callback.poll.interval", 5)
+	options.SetDefault("callback.batch.size", 20)
+	options.SetDefault("callback.timeout", 10)
+	options.SetDefault("callback.lease", 60)
+	options.SetDefault("callback.max.attempts", 10)
+	options.SetDefault("callback.backoff.base", 10)
+	options.SetDefault("callback.backoff.max", 3600)
+
+	options.SetDefault("scheduler.poll.interval", 10)
+	options.SetDefault("scheduler.batch.size", 50)
+
+	options.SetDefault("outbox.poll.interval", 5)
+	options.SetDefault("outbox.batch.size", 20)
+	options.SetDefault("outbox.lease", 60)
+	options.SetDefault("outbox.max.attempts", 10)
+	options.SetDefault("outbox.backoff.base", 10)
+	options.SetDefault("outbox.backoff.max", 600)
+
+	options.SetDefault("simulator.port", 8090)
+	options.SetDefault("simulator.scenario", "success")
+	options.SetDefault("simulator.delay", 1)
+	options.SetDefault("simulator.slow.delay", 10)
+
+	options.SetDefault("idempotency.window", 86400)
+
+	options.SetDefault("retry.backoff.default", 60)
+
+	options.SetDefault("quota.impl", "memory")
+	options.SetDefault("quota.org.rps", 20)
+	options.SetDefault("quota.org.burst", 200)
+	options.SetDefault("quota.org.overrides", "")
+	options.SetDefault("quota.service.rps", 50)
+	options.SetDefault("quota.service.burst", 500)
+	options.SetDefault("quota.service.overrides", "")
+
+	options.SetDefault("playbook.registry.enabled", false)
+	options.SetDefault("playbook.registry.keys", "")
+	options.SetDefault("playbook.fetch.timeout", 10)
+	options.SetDefault("playbook.max.size", 1048576)
+
 	options.SetDefault("db.max.idle.connections", 10)
 	options.SetDefault("db.max.open.connections", 20)
 	options.SetDefault("migrations.dir", "./migrations")
@@ -80,6 +127,13 @@ func Get() *viper.Viper {
 	options.SetDefault("cloud.connector.psk", "")
 	options.SetDefault("cloud.connector.rps", 100)
 	options.SetDefault("cloud.connector.req.bucket", 60)
+	options.SetDefault("cloud.connector.status.concurrency", 10)
+	options.SetDefault("cloud.connector.status.cache.impl", "memory")
+	options.SetDefault("cloud.connector.status.cache.ttl.connected", 60)
+	options.SetDefault("cloud.connector.status.cache.ttl.disconnected", 10)
+	options.SetDefault("cloud.connector.breaker.failures", 5)
+	options.SetDefault("cloud.connector.breaker.cooldown", 30)
+	options.SetDefault("cloud.connector.bulkhead", 50)
 
 	options.SetDefault("return.url", "https://cloud.redhat.com/api/ingress/v1/upload")
 	options.SetDefault("web.console.url.default", "https://console.redhat.com")
@@ -98,14 +152,21 @@ func Get() *viper.Viper {
 	options.SetDefault("inventory.connector.ordered.by", "display_name")
 	options.SetDefault("inventory.connector.ordered.how", "ASC")
 	options.SetDefault("inventory.connector.limit", 100)
-	options.SetDefault("inventory.connector.offset", 0)
+	options.SetDefault("inventory.connector.batch.size", 50)
+	options.SetDefault("inventory.connector.concurrency", 5)
 	options.SetDefault("inventory.connector.timeout", 10)
+	options.SetDefault("inventory.connector.breaker.failures", 5)
+	options.SetDefault("inventory.connector.breaker.cooldown", 30)
+	options.SetDefault("inventory.connector.bulkhead", 20)
 
 	options.SetDefault("sources.impl", "mock")
 	options.SetDefault("sources.host", "sources")
 	options.SetDefault("sources.port", "8080")
 	options.SetDefault("sources.scheme", "http")
 	options.SetDefault("sources.timeout", 10)
+	options.SetDefault("sources.breaker.failures", 5)
+	options.SetDefault("sources.breaker.cooldown", 30)
+	options.SetDefault("sources.bulkhead", 20)
 
 	options.SetDefault("tenant.translator.impl", "dynamic-mock")
 	options.SetDefault("tenant.translator.host", "localhost")
@@ -183,6 +244,15 @@ func Get() *viper.Viper {
 			options.SetDefault("db.ca", *rdsCaPath)
 		}
 
+		if cfg.InMemoryDb != nil {
+			options.SetDefault("redis.host", cfg.InMemoryDb.Hostname)
+			options.SetDefault("redis.port", cfg.InMemoryDb.Port)
+
+			if cfg.InMemoryDb.Password != nil {
+				options.SetDefault("redis.password", *cfg.InMemoryDb.Password)
+			}
+		}
+
 		// Unleash (Feature Flags) configuration from Clowder
 		// Clowder provides this in stage/production environments
 		if cfg.FeatureFlags != nil {
@@ -229,8 +299,13 @@ func Get() *viper.Viper {
 		options.SetDefault("db.name", "insights")
 		options.SetDefault("db.username", "insights")
 		options.SetDefault("db.password", "insights")
+
+		options.SetDefault("redis.host", "localhost")
+		options.SetDefault("redis.port", 6379)
 	}
 
+	options.SetDefault("redis.password", "")
+
 	options.AutomaticEnv()
 	options.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CallbackDeliveryStatusPending   = "pending"
+	CallbackDeliveryStatusDelivered = "delivered"
+	CallbackDeliveryStatusFailed    = "failed"
+)
+
+type CallbackDelivery struct {
+	ID    uuid.UUID `gorm:"type:uuid"`
+	RunID uuid.UUID `gorm:"type:uuid"`
+
+	URL       string
+	SecretRef string
+	Payload   []byte
+
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
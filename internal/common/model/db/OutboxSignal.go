@@ -0,0 +1,35 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	OutboxSignalStatusPending = "pending"
+	OutboxSignalStatusSending = "sending"
+	OutboxSignalStatusSent    = "sent"
+	OutboxSignalStatusFailed  = "failed"
+)
+
+// OutboxSignal is a cloud connector message stored in the same transaction as the run it belongs to
+type OutboxSignal struct {
+	ID    uuid.UUID `gorm:"type:uuid"`
+	RunID uuid.UUID `gorm:"type:uuid"`
+
+	OrgID     string
+	Recipient uuid.UUID `gorm:"type:uuid"`
+	Directive string
+	URL       string
+	Metadata  []byte
+
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	MessageID     *string
+	LastError     *string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
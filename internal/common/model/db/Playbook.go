@@ -0,0 +1,15 @@
+package db
+
+import (
+	"time"
+)
+
+// Playbook is a verified Playbook stored in the playbook registry under the digest of its content
+type Playbook struct {
+	OrgID     string
+	Digest    string
+	Content   []byte
+	Signature string
+
+	CreatedAt time.Time
+}
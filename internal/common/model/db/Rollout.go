@@ -0,0 +1,37 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// the runs of the rollout are being created
+	RolloutStatusPending    = "pending"
+	RolloutStatusInProgress = "in_progress"
+	RolloutStatusCompleted  = "completed"
+	RolloutStatusHalted     = "halted"
+)
+
+// Rollout tracks the dispatch of the runs of a run group in waves.
+// Wave 0 is the canary wave. Runs of later waves are held in the scheduled status until their wave is released.
+type Rollout struct {
+	GroupID uuid.UUID `gorm:"type:uuid;primaryKey"`
+
+	WaveSize         int
+	Pause            int
+	SuccessThreshold int
+	FailureThreshold int
+
+	Status     string
+	Wave       int
+	Waves      int
+	NextWaveAt *time.Time
+
+	// runs of the canary wave that were rejected when dispatched (e.g. because the recipient is not connected) count as failed
+	Rejected int
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -9,11 +9,25 @@ import (
 )
 
 const (
-	RunStatusRunning  = "running"
-	RunStatusSuccess  = "success"
-	RunStatusFailure  = "failure"
-	RunStatusTimeout  = "timeout"
-	RunStatusCanceled = "canceled"
+	RunStatusRunning     = "running"
+	RunStatusSuccess     = "success"
+	RunStatusFailure     = "failure"
+	RunStatusTimeout     = "timeout"
+	RunStatusCanceled    = "canceled"
+	RunStatusScheduled   = "scheduled"
+	RunStatusExpired     = "expired"
+	RunStatusUnreachable = "unreachable"
+)
+
+const (
+	RunModeRun   = "run"
+	RunModeCheck = "check"
+)
+
+const (
+	ConcurrencyPolicyAllow  = "allow"
+	ConcurrencyPolicyReject = "reject"
+	ConcurrencyPolicyQueue  = "queue"
 )
 
 type Run struct {
@@ -35,6 +49,28 @@ type Run struct {
 	SatId          *uuid.UUID
 	SatOrgId       *string
 
+	CallbackUrl       *string
+	CallbackSecretRef *string
+
+	NotBefore    *time.Time
+	NotAfter     *time.Time
+	DispatchedAt *time.Time
+
+	IdempotencyKey    *string
+	IdempotencyHash   *string
+	ConcurrencyPolicy *string
+
+	ParentRunID *uuid.UUID `gorm:"type:uuid"`
+	Attempt     int        `gorm:"default:1"`
+	RetryPolicy *RetryPolicy
+
+	GroupID     *uuid.UUID `gorm:"type:uuid"`
+	RolloutWave *int
+
+	PlaybookDigest *string
+	ExtraVars      *ExtraVars
+	Mode           string `gorm:"default:run"`
+
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	Timeout      int
@@ -55,3 +91,86 @@ func (l *Labels) Scan(value interface{}) error {
 
 	return nil
 }
+
+// RetryPolicy defines when a finished run is dispatched again
+type RetryPolicy struct {
+	MaxAttempts int      `json:"max_attempts"`
+	Backoff     int      `json:"backoff"`
+	Statuses    []string `json:"statuses"`
+}
+
+func (p RetryPolicy) Value() (driver.Value, error) {
+	value, err := json.Marshal(p)
+	return string(value), err
+}
+
+func (p *RetryPolicy) Scan(value interface{}) error {
+	return json.Unmarshal(value.([]byte), p)
+}
+
+// ExtraVars are passed to the Playbook as extra vars.
+// The values of the vars listed in Secrets are never returned by the API.
+type ExtraVars struct {
+	Values  map[string]interface{} `json:"values"`
+	Secrets []string               `json:"secrets,omitempty"`
+}
+
+// MinSecretLength is the length a secret string needs to have to be redacted wherever it occurs.
+// Shorter strings would match unrelated parts of the output and are only redacted where they make up a whole value.
+const MinSecretLength = 4
+
+// Secrets are the values of secret extra vars to be redacted from what a run reports
+type Secrets struct {
+	// Strings are redacted wherever they occur
+	Strings []string
+	// Values (numbers and short strings) are redacted only where they make up a whole value
+	Values []interface{}
+}
+
+func (s Secrets) IsEmpty() bool {
+	return len(s.Strings) == 0 && len(s.Values) == 0
+}
+
+// SecretValues returns the values of the secret vars, including those nested in lists and objects.
+// Booleans are left out as they would match every other boolean.
+func (v ExtraVars) SecretValues() Secrets {
+	result := Secrets{}
+
+	for _, name := range v.Secrets {
+		appendScalars(&result, v.Values[name])
+	}
+
+	return result
+}
+
+func appendScalars(result *Secrets, value interface{}) {
+	switch value := value.(type) {
+	case string:
+		if len(value) >= MinSecretLength {
+			result.Strings = append(result.Strings, value)
+		} else if value != "" {
+			result.Values = append(result.Values, value)
+		}
+	case float64:
+		result.Values = append(result.Values, value)
+	case int:
+		result.Values = append(result.Values, float64(value))
+	case map[string]interface{}:
+		for _, item := range value {
+			appendScalars(result, item)
+		}
+	case []interface{}:
+		for _, item := range value {
+			appendScalars(result, item)
+		}
+	}
+}
+
+func (v ExtraVars) Value() (driver.Value, error) {
+	value, err := json.Marshal(v)
+	return string(value), err
+}
+
+func (v *ExtraVars) Scan(value interface{}) error {
+	return json.Unmarshal(value.([]byte), v)
+}
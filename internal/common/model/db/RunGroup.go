@@ -0,0 +1,25 @@
+package db
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// aggregate status of a run group derived from the status of its runs
+const (
+	RunGroupStatusRunning = "running"
+	RunGroupStatusSuccess = "success"
+	RunGroupStatusPartial = "partial"
+	RunGroupStatusFailure = "failure"
+)
+
+// RunGroup ties together the runs dispatched by a single dispatch request
+type RunGroup struct {
+	ID      uuid.UUID `gorm:"type:uuid"`
+	OrgID   string
+	Service string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
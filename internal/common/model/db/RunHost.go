@@ -1,6 +1,8 @@
 package db
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -18,7 +20,66 @@ type RunHost struct {
 
 	Status string
 	Log    string
+	Stats  *RunHostStats
+	Diffs  RunHostDiffs
 
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// RunHostStats is the play recap (playbook_on_stats) of a host
+type RunHostStats struct {
+	Ok          int `json:"ok"`
+	Changed     int `json:"changed"`
+	Failures    int `json:"failures"`
+	Unreachable int `json:"unreachable"`
+	Skipped     int `json:"skipped"`
+	Rescued     int `json:"rescued"`
+	Ignored     int `json:"ignored"`
+}
+
+func (s RunHostStats) Value() (driver.Value, error) {
+	value, err := json.Marshal(s)
+	return string(value), err
+}
+
+func (s *RunHostStats) Scan(value interface{}) error {
+	return json.Unmarshal(value.([]byte), s)
+}
+
+// RunHostDiffs are the changes reported by the tasks run on a host in diff mode
+type RunHostDiffs []TaskDiff
+
+// TaskDiff holds the changes a task made, or would make in check mode, on a host
+type TaskDiff struct {
+	Play    string       `json:"play"`
+	Task    string       `json:"task"`
+	Changes []DiffChange `json:"changes"`
+}
+
+// DiffChange is a single diff reported by a module, e.g. the change of a file
+type DiffChange struct {
+	Before       interface{} `json:"before,omitempty"`
+	After        interface{} `json:"after,omitempty"`
+	BeforeHeader *string     `json:"before_header,omitempty"`
+	AfterHeader  *string     `json:"after_header,omitempty"`
+	Prepared     *string     `json:"prepared,omitempty"`
+}
+
+func (d RunHostDiffs) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+
+	value, err := json.Marshal(d)
+	return string(value), err
+}
+
+func (d *RunHostDiffs) Scan(value interface{}) error {
+	if value == nil {
+		*d = nil
+		return nil
+	}
+
+	return json.Unmarshal(value.([]byte), d)
+}
@@ -1,20 +1,55 @@
 package generic
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type RunInput struct {
-	Recipient     uuid.UUID
-	Account       *string
-	Url           string
-	Hosts         []RunHostsInput
-	Labels        map[string]string
-	Timeout       *int
-	OrgId         string
-	SatId         *uuid.UUID
-	SatOrgId      *string
-	Name          *string
-	WebConsoleUrl *string
-	Principal     *string
+	Recipient         uuid.UUID
+	Account           *string
+	Url               string
+	Hosts             []RunHostsInput
+	Labels            map[string]string
+	Timeout           *int
+	OrgId             string
+	SatId             *uuid.UUID
+	SatOrgId          *string
+	Name              *string
+	WebConsoleUrl     *string
+	Principal         *string
+	Callback          *RunCallbackInput
+	NotBefore         *time.Time
+	NotAfter          *time.Time
+	IdempotencyKey    *string
+	ConcurrencyPolicy *string
+	RetryPolicy       *RunRetryPolicyInput
+	ParentRunId       *uuid.UUID
+	Attempt           *int
+	GroupId           *uuid.UUID
+	RolloutWave       *int
+	PlaybookDigest    *string
+	PlaybookSignature *string
+	Preflight         bool
+	ExtraVars         *RunExtraVarsInput
+	Mode              *string
+}
+
+type RunExtraVarsInput struct {
+	Values  map[string]interface{}
+	Secrets []string
+}
+
+type RunRetryPolicyInput struct {
+	MaxAttempts int
+	Backoff     *int
+	Statuses    []string
+}
+
+type RunCallbackInput struct {
+	Url       string
+	SecretRef string
 }
 
 type CancelInput struct {
@@ -47,6 +47,9 @@ type PlaybookRunResponseMessageYamlEventsElem struct {
 }
 
 type PlaybookRunResponseMessageYamlEventsElemEventData struct {
+	// Changed corresponds to the JSON schema field "changed".
+	Changed PlaybookRunResponseMessageYamlEventsElemEventDataChanged `json:"changed,omitempty" yaml:"changed,omitempty" mapstructure:"changed,omitempty"`
+
 	// CrcDispatcherCorrelationId corresponds to the JSON schema field
 	// "crc_dispatcher_correlation_id".
 	CrcDispatcherCorrelationId *string `json:"crc_dispatcher_correlation_id,omitempty" yaml:"crc_dispatcher_correlation_id,omitempty" mapstructure:"crc_dispatcher_correlation_id,omitempty"`
@@ -59,16 +62,74 @@ type PlaybookRunResponseMessageYamlEventsElemEventData struct {
 	// "crc_dispatcher_error_details".
 	CrcDispatcherErrorDetails *string `json:"crc_dispatcher_error_details,omitempty" yaml:"crc_dispatcher_error_details,omitempty" mapstructure:"crc_dispatcher_error_details,omitempty"`
 
+	// Dark corresponds to the JSON schema field "dark".
+	Dark PlaybookRunResponseMessageYamlEventsElemEventDataDark `json:"dark,omitempty" yaml:"dark,omitempty" mapstructure:"dark,omitempty"`
+
+	// Duration corresponds to the JSON schema field "duration".
+	Duration *float64 `json:"duration,omitempty" yaml:"duration,omitempty" mapstructure:"duration,omitempty"`
+
+	// Failures corresponds to the JSON schema field "failures".
+	Failures PlaybookRunResponseMessageYamlEventsElemEventDataFailures `json:"failures,omitempty" yaml:"failures,omitempty" mapstructure:"failures,omitempty"`
+
 	// Host corresponds to the JSON schema field "host".
 	Host *string `json:"host,omitempty" yaml:"host,omitempty" mapstructure:"host,omitempty"`
 
+	// IgnoreErrors corresponds to the JSON schema field "ignore_errors".
+	IgnoreErrors *bool `json:"ignore_errors,omitempty" yaml:"ignore_errors,omitempty" mapstructure:"ignore_errors,omitempty"`
+
+	// Ignored corresponds to the JSON schema field "ignored".
+	Ignored PlaybookRunResponseMessageYamlEventsElemEventDataIgnored `json:"ignored,omitempty" yaml:"ignored,omitempty" mapstructure:"ignored,omitempty"`
+
+	// Ok corresponds to the JSON schema field "ok".
+	Ok PlaybookRunResponseMessageYamlEventsElemEventDataOk `json:"ok,omitempty" yaml:"ok,omitempty" mapstructure:"ok,omitempty"`
+
+	// Play corresponds to the JSON schema field "play".
+	Play *string `json:"play,omitempty" yaml:"play,omitempty" mapstructure:"play,omitempty"`
+
 	// Playbook corresponds to the JSON schema field "playbook".
 	Playbook *string `json:"playbook,omitempty" yaml:"playbook,omitempty" mapstructure:"playbook,omitempty"`
 
 	// PlaybookUuid corresponds to the JSON schema field "playbook_uuid".
 	PlaybookUuid *string `json:"playbook_uuid,omitempty" yaml:"playbook_uuid,omitempty" mapstructure:"playbook_uuid,omitempty"`
+
+	// Res corresponds to the JSON schema field "res".
+	Res *PlaybookRunResponseMessageYamlEventsElemEventDataRes `json:"res,omitempty" yaml:"res,omitempty" mapstructure:"res,omitempty"`
+
+	// Rescued corresponds to the JSON schema field "rescued".
+	Rescued PlaybookRunResponseMessageYamlEventsElemEventDataRescued `json:"rescued,omitempty" yaml:"rescued,omitempty" mapstructure:"rescued,omitempty"`
+
+	// Skipped corresponds to the JSON schema field "skipped".
+	Skipped PlaybookRunResponseMessageYamlEventsElemEventDataSkipped `json:"skipped,omitempty" yaml:"skipped,omitempty" mapstructure:"skipped,omitempty"`
+
+	// Task corresponds to the JSON schema field "task".
+	Task *string `json:"task,omitempty" yaml:"task,omitempty" mapstructure:"task,omitempty"`
 }
 
+type PlaybookRunResponseMessageYamlEventsElemEventDataChanged map[string]int
+
+type PlaybookRunResponseMessageYamlEventsElemEventDataDark map[string]int
+
+type PlaybookRunResponseMessageYamlEventsElemEventDataFailures map[string]int
+
+type PlaybookRunResponseMessageYamlEventsElemEventDataIgnored map[string]int
+
+type PlaybookRunResponseMessageYamlEventsElemEventDataOk map[string]int
+
+type PlaybookRunResponseMessageYamlEventsElemEventDataRes struct {
+	// Changed corresponds to the JSON schema field "changed".
+	Changed interface{} `json:"changed,omitempty" yaml:"changed,omitempty" mapstructure:"changed,omitempty"`
+
+	// Diff corresponds to the JSON schema field "diff".
+	Diff interface{} `json:"diff,omitempty" yaml:"diff,omitempty" mapstructure:"diff,omitempty"`
+
+	// Msg corresponds to the JSON schema field "msg".
+	Msg interface{} `json:"msg,omitempty" yaml:"msg,omitempty" mapstructure:"msg,omitempty"`
+}
+
+type PlaybookRunResponseMessageYamlEventsElemEventDataRescued map[string]int
+
+type PlaybookRunResponseMessageYamlEventsElemEventDataSkipped map[string]int
+
 // UnmarshalJSON implements json.Unmarshaler.
 func (j *PlaybookRunResponseMessageYamlEventsElemEventData) UnmarshalJSON(b []byte) error {
 	type Plain PlaybookRunResponseMessageYamlEventsElemEventData
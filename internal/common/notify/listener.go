@@ -0,0 +1,145 @@
+package notify
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"playbook-dispatcher/internal/common/utils"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// Listener receives status changes published by Publish and fans them out to in-process subscribers
+type Listener struct {
+	sql           *sql.DB
+	retryInterval time.Duration
+
+	lock        sync.RWMutex
+	subscribers map[chan StatusChange]struct{}
+	stopped     bool
+}
+
+func NewListener(sql *sql.DB, retryInterval time.Duration) *Listener {
+	return &Listener{
+		sql:           sql,
+		retryInterval: retryInterval,
+		subscribers:   make(map[chan StatusChange]struct{}),
+	}
+}
+
+// Start listens for notifications until the given context is canceled.
+// A lost database connection is re-established after the retry interval.
+// Once stopped, the channels of all subscribers are closed.
+func (this *Listener) Start(ctx context.Context) {
+	log := utils.GetLogFromContext(ctx)
+	defer this.stop()
+
+	for {
+		err := this.listen(ctx)
+
+		if ctx.Err() != nil {
+			log.Debug("Status change listener stopped")
+			return
+		}
+
+		log.Errorw("Status change listener failed", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(this.retryInterval):
+		}
+	}
+}
+
+func (this *Listener) listen(ctx context.Context) error {
+	conn, err := this.sql.Conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported database driver: %T", driverConn)
+		}
+
+		pgxConn := stdlibConn.Conn()
+
+		if _, err := pgxConn.Exec(ctx, "LISTEN "+pgx.Identifier{Channel}.Sanitize()); err != nil {
+			return err
+		}
+
+		utils.GetLogFromContext(ctx).Infow("Listening for status changes", "channel", Channel)
+
+		for {
+			notification, err := pgxConn.WaitForNotification(ctx)
+			if err != nil {
+				// the connection is still subscribed to the channel so it must not be returned to the pool
+				return fmt.Errorf("%w: %s", driver.ErrBadConn, err)
+			}
+
+			this.dispatch(ctx, notification.Payload)
+		}
+	})
+}
+
+func (this *Listener) dispatch(ctx context.Context, payload string) {
+	change := StatusChange{}
+
+	if err := json.Unmarshal([]byte(payload), &change); err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error parsing status change", "error", err)
+		return
+	}
+
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	for subscriber := range this.subscribers {
+		select {
+		case subscriber <- change:
+		default:
+			// do not let a slow subscriber block the others
+			utils.GetLogFromContext(ctx).Warnw("Dropping status change for slow subscriber", "run_id", change.RunID)
+		}
+	}
+}
+
+func (this *Listener) stop() {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.stopped = true
+
+	for subscriber := range this.subscribers {
+		close(subscriber)
+		delete(this.subscribers, subscriber)
+	}
+}
+
+// Subscribe registers a new subscriber.
+// The returned function must be called to unsubscribe once the subscriber is no longer interested in status changes.
+func (this *Listener) Subscribe(buffer int) (<-chan StatusChange, func()) {
+	subscriber := make(chan StatusChange, buffer)
+
+	this.lock.Lock()
+	if this.stopped {
+		close(subscriber)
+	} else {
+		this.subscribers[subscriber] = struct{}{}
+	}
+	this.lock.Unlock()
+
+	return subscriber, func() {
+		this.lock.Lock()
+		delete(this.subscribers, subscriber)
+		this.lock.Unlock()
+	}
+}
@@ -0,0 +1,93 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func newPayload(change StatusChange) string {
+	payload, err := json.Marshal(change)
+	Expect(err).ToNot(HaveOccurred())
+	return string(payload)
+}
+
+var _ = Describe("Listener", func() {
+	var (
+		listener *Listener
+		ctx      context.Context
+		change   StatusChange
+	)
+
+	BeforeEach(func() {
+		listener = NewListener(nil, time.Second)
+		ctx = utils.SetLog(context.Background(), utils.GetLoggerOrDie())
+		change = StatusChange{
+			Type:      TypeRun,
+			RunID:     uuid.New(),
+			OrgID:     "5318290",
+			Service:   "remediations",
+			Recipient: uuid.New(),
+			Labels:    map[string]string{"foo": "bar"},
+			Status:    "success",
+			Timestamp: time.Now().UTC().Truncate(time.Second),
+		}
+	})
+
+	It("delivers status changes to all subscribers", func() {
+		first, unsubscribeFirst := listener.Subscribe(1)
+		defer unsubscribeFirst()
+		second, unsubscribeSecond := listener.Subscribe(1)
+		defer unsubscribeSecond()
+
+		listener.dispatch(ctx, newPayload(change))
+
+		Expect(<-first).To(Equal(change))
+		Expect(<-second).To(Equal(change))
+	})
+
+	It("does not deliver status changes after unsubscribing", func() {
+		changes, unsubscribe := listener.Subscribe(1)
+		unsubscribe()
+
+		listener.dispatch(ctx, newPayload(change))
+
+		Expect(changes).ToNot(Receive())
+	})
+
+	It("drops status changes for a subscriber whose buffer is full", func() {
+		changes, unsubscribe := listener.Subscribe(1)
+		defer unsubscribe()
+
+		listener.dispatch(ctx, newPayload(change))
+		listener.dispatch(ctx, newPayload(change))
+
+		Expect(changes).To(HaveLen(1))
+	})
+
+	It("ignores malformed payloads", func() {
+		changes, unsubscribe := listener.Subscribe(1)
+		defer unsubscribe()
+
+		listener.dispatch(ctx, "{")
+
+		Expect(changes).ToNot(Receive())
+	})
+
+	It("closes subscriber channels once stopped", func() {
+		changes, unsubscribe := listener.Subscribe(1)
+		defer unsubscribe()
+
+		listener.stop()
+		Expect(changes).To(BeClosed())
+
+		late, unsubscribeLate := listener.Subscribe(1)
+		defer unsubscribeLate()
+		Expect(late).To(BeClosed())
+	})
+})
@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"playbook-dispatcher/internal/common/utils"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Postgres channel used to propagate status changes of runs and run hosts
+const Channel = "playbook_dispatcher_status_changes"
+
+// Postgres rejects notification payloads of 8000 bytes or more
+const maxPayloadSize = 7999
+
+const (
+	TypeRun     = "run"
+	TypeRunHost = "run_host"
+)
+
+type StatusChange struct {
+	Type        string            `json:"type"`
+	RunID       uuid.UUID         `json:"run_id"`
+	OrgID       string            `json:"org_id"`
+	Service     string            `json:"service"`
+	Recipient   uuid.UUID         `json:"recipient"`
+	Labels      map[string]string `json:"labels,omitempty"`
+	Status      string            `json:"status"`
+	Host        *string           `json:"host,omitempty"`
+	InventoryID *uuid.UUID        `json:"inventory_id,omitempty"`
+	Timestamp   time.Time         `json:"timestamp"`
+}
+
+// Publish emits the given status changes using Postgres NOTIFY.
+// If tx is a transaction the notifications are only delivered once the transaction commits.
+func Publish(ctx context.Context, tx *gorm.DB, changes ...StatusChange) error {
+	for _, change := range changes {
+		payload, err := json.Marshal(change)
+		if err != nil {
+			return err
+		}
+
+		if len(payload) > maxPayloadSize {
+			utils.GetLogFromContext(ctx).Warnw("Status change too large to be published", "run_id", change.RunID, "size", len(payload))
+			continue
+		}
+
+		if result := tx.Exec("SELECT pg_notify(?, ?)", Channel, string(payload)); result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
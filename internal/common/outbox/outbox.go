@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"fmt"
+	"time"
+
+	dbModel "playbook-dispatcher/internal/common/model/db"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Abandon fails the pending signals of the given runs so that the outbox relay does not send them.
+// It is expected to be called in the same transaction that moves the runs out of the running status.
+// Returns the number of signals abandoned.
+func Abandon(tx *gorm.DB, status string, runIDs ...uuid.UUID) (int64, error) {
+	if len(runIDs) == 0 {
+		return 0, nil
+	}
+
+	result := tx.Model(&dbModel.OutboxSignal{}).
+		Where("run_id IN ?", runIDs).
+		Where("status = ?", dbModel.OutboxSignalStatusPending).
+		Updates(map[string]interface{}{
+			"status":     dbModel.OutboxSignalStatusFailed,
+			"last_error": fmt.Sprintf("run moved to the %s status before the signal was sent", status),
+			"updated_at": time.Now(),
+		})
+
+	if result.Error != nil {
+		return 0, fmt.Errorf("error abandoning signals: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
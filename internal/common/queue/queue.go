@@ -0,0 +1,87 @@
+// Package queue contains the leasing and retry logic shared by the workers that deliver rows of a database-backed queue
+// (outbox signals, callback deliveries).
+package queue
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/spf13/viper"
+	"gorm.io/gorm"
+)
+
+const maxErrorLength = 1024
+
+type Options struct {
+	BatchSize   int
+	Lease       time.Duration
+	MaxAttempts int
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+}
+
+// OptionsFromConfig reads the options of a worker from the config keys under the given prefix (e.g. "outbox")
+func OptionsFromConfig(cfg *viper.Viper, prefix string) Options {
+	return Options{
+		BatchSize:   cfg.GetInt(prefix + ".batch.size"),
+		Lease:       time.Duration(cfg.GetInt(prefix+".lease")) * time.Second,
+		MaxAttempts: cfg.GetInt(prefix + ".max.attempts"),
+		BackoffBase: time.Duration(cfg.GetInt(prefix+".backoff.base")) * time.Second,
+		BackoffMax:  time.Duration(cfg.GetInt(prefix+".backoff.max")) * time.Second,
+	}
+}
+
+// Claim leases up to BatchSize rows of the given table that are due and match the given condition.
+// The lease moves next_attempt_at into the future so that the rows are not picked up by other replicas.
+// Should the worker die before the attempt is recorded, the rows become due again once the lease expires.
+func (this Options) Claim(db *gorm.DB, dest interface{}, table string, condition string, args ...interface{}) error {
+	query := fmt.Sprintf(`
+		UPDATE %[1]s
+		SET next_attempt_at = NOW() + ? * interval '1 second', updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM %[1]s
+			WHERE %[2]s AND next_attempt_at <= NOW()
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, table, condition)
+
+	values := append([]interface{}{this.Lease.Seconds()}, args...)
+	values = append(values, this.BatchSize)
+
+	return db.Raw(query, values...).Scan(dest).Error
+}
+
+// Backoff returns the delay before the next attempt, doubling with each failed attempt
+func (this Options) Backoff(attempts int) time.Duration {
+	delay := this.BackoffBase
+
+	for i := 1; i < attempts && delay < this.BackoffMax; i++ {
+		delay *= 2
+	}
+
+	if delay > this.BackoffMax {
+		return this.BackoffMax
+	}
+
+	return delay
+}
+
+// TruncateError returns the message of the given error, shortened to fit the last_error column
+func TruncateError(err error) string {
+	message := err.Error()
+
+	if len(message) <= maxErrorLength {
+		return message
+	}
+
+	// cut at the start of a rune so that no partial UTF-8 sequence is stored
+	end := maxErrorLength
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+
+	return message[:end]
+}
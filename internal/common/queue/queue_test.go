@@ -0,0 +1,44 @@
+package queue
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = DescribeTable("Backoff",
+	func(attempts int, expected time.Duration) {
+		options := Options{
+			BackoffBase: 10 * time.Second,
+			BackoffMax:  time.Hour,
+		}
+
+		Expect(options.Backoff(attempts)).To(Equal(expected))
+	},
+
+	Entry("first retry", 1, 10*time.Second),
+	Entry("second retry", 2, 20*time.Second),
+	Entry("fifth retry", 5, 160*time.Second),
+	Entry("capped", 20, time.Hour),
+)
+
+var _ = Describe("TruncateError", func() {
+	It("keeps a short message", func() {
+		Expect(TruncateError(errors.New("boom"))).To(Equal("boom"))
+	})
+
+	It("truncates a long message", func() {
+		Expect(TruncateError(errors.New(strings.Repeat("x", 2000)))).To(HaveLen(maxErrorLength))
+	})
+
+	It("does not split a multi-byte character", func() {
+		message := TruncateError(errors.New("x" + strings.Repeat("é", 1000)))
+		Expect(message).To(HaveLen(maxErrorLength - 1))
+		Expect(utf8.ValidString(message)).To(BeTrue())
+	})
+})
@@ -48,6 +48,13 @@ func GetSatHostInfo(events []messageModel.PlaybookSatRunResponseMessageYamlEvent
 	return &hostInfo
 }
 
+// IsConnectionError determines whether the event reports a connectivity problem rather than a failure of the playbook itself.
+// That is either Satellite not being able to connect to the host (connection_code) or Satellite not being reachable (satellite_connection_code).
+func IsConnectionError(event messageModel.PlaybookSatRunResponseMessageYamlEventsElem) bool {
+	return (event.ConnectionCode != nil && *event.ConnectionCode != 0) ||
+		(event.SatelliteConnectionCode != nil && *event.SatelliteConnectionCode != 0)
+}
+
 func SortSatEvents(satEvents *[]messageModel.PlaybookSatRunResponseMessageYamlEventsElem) {
 	vSatEvents := *satEvents
 
@@ -80,4 +80,26 @@ var _ = Describe("Satellite", func() {
 			Expect(satHostInfo.Console).To(Equal("host2 | SUCCESS => {\n    \"changed\": false,\n    \"ping\": \"pong\"\n}"))
 		})
 	})
+	Describe("connection errors", func() {
+		It("does not report a connection error for a failed run", func() {
+			events := loadFile("./sat-test-events2.jsonl")
+			for _, event := range events {
+				Expect(IsConnectionError(event)).To(BeFalse())
+			}
+		})
+
+		It("reports a connection error if satellite cannot connect to the host", func() {
+			events := loadFile("./sat-test-events2.jsonl")
+			code := 1
+			events[1].ConnectionCode = &code
+			Expect(IsConnectionError(events[1])).To(BeTrue())
+		})
+
+		It("reports a connection error if satellite is not reachable", func() {
+			events := loadFile("./sat-test-events2.jsonl")
+			code := 1
+			events[2].SatelliteConnectionCode = &code
+			Expect(IsConnectionError(events[2])).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// same limit as the default policy of net/http
+const maxRedirects = 10
+
+// HostAllowlist lists the hosts the dispatcher may send requests to on behalf of its callers.
+// An entry in the "*.<domain>" form matches any subdomain of the domain.
+// An empty allowlist matches no host.
+type HostAllowlist []string
+
+// ParseHostAllowlist parses a comma-separated list of hosts. Empty entries are ignored.
+func ParseHostAllowlist(value string) HostAllowlist {
+	result := HostAllowlist{}
+
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.ToLower(strings.TrimSpace(entry)); entry != "" {
+			result = append(result, entry)
+		}
+	}
+
+	return result
+}
+
+func (this HostAllowlist) allows(host string) bool {
+	host = strings.ToLower(host)
+
+	for _, entry := range this {
+		if domain, ok := strings.CutPrefix(entry, "*."); ok {
+			if strings.HasSuffix(host, "."+domain) {
+				return true
+			}
+		} else if host == entry {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CheckURL returns an error unless the host of the given URL is on the allowlist.
+// If requireHttps is set the URL also needs to use the https scheme.
+func (this HostAllowlist) CheckURL(value string, requireHttps bool) error {
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("Invalid URL: %s", value)
+	}
+
+	if requireHttps && parsed.Scheme != "https" {
+		return fmt.Errorf("URL needs to use https: %s", value)
+	}
+
+	if !this.allows(parsed.Hostname()) {
+		return fmt.Errorf("Host not allowed: %s", parsed.Hostname())
+	}
+
+	return nil
+}
+
+// CheckRedirect returns a redirect policy for http.Client that applies CheckURL to every hop.
+// Otherwise an allowed host could redirect the request anywhere.
+func (this HostAllowlist) CheckRedirect(requireHttps bool) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if len(via) >= maxRedirects {
+			return errors.New("stopped after 10 redirects")
+		}
+
+		return this.CheckURL(req.URL.String(), requireHttps)
+	}
+}
@@ -0,0 +1,60 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestHostAllowlist(t *testing.T) {
+	allowlist := ParseHostAllowlist(" hooks.example.com, ,*.redhat.com")
+
+	cases := []struct {
+		url          string
+		requireHttps bool
+		allowed      bool
+	}{
+		{"https://hooks.example.com/callback", true, true},
+		{"https://HOOKS.example.com:8443/callback", true, true},
+		{"https://api.console.redhat.com/callback", true, true},
+		{"http://hooks.example.com/callback", true, false},
+		{"http://hooks.example.com/playbook.yml", false, true},
+		{"https://redhat.com/callback", true, false},
+		{"https://example.com/callback", true, false},
+		{"https://hooks.example.com.evil.com/callback", true, false},
+		{"https://169.254.169.254/latest/meta-data", true, false},
+		{"blahblah", true, false},
+	}
+
+	for _, c := range cases {
+		if err := allowlist.CheckURL(c.url, c.requireHttps); (err == nil) != c.allowed {
+			t.Errorf("%s: expected allowed=%t, got %v", c.url, c.allowed, err)
+		}
+	}
+}
+
+func TestEmptyHostAllowlist(t *testing.T) {
+	if err := ParseHostAllowlist("").CheckURL("https://hooks.example.com/callback", true); err == nil {
+		t.Error("expected an empty allowlist to reject every host")
+	}
+}
+
+func TestHostAllowlistCheckRedirect(t *testing.T) {
+	checkRedirect := ParseHostAllowlist("hooks.example.com").CheckRedirect(true)
+
+	allowed, _ := http.NewRequest(http.MethodGet, "https://hooks.example.com/next", nil)
+	if err := checkRedirect(allowed, nil); err != nil {
+		t.Errorf("expected a redirect to an allowed host to be followed, got %v", err)
+	}
+
+	for _, target := range []string{"https://169.254.169.254/latest/meta-data", "http://hooks.example.com/next"} {
+		req, _ := http.NewRequest(http.MethodGet, target, nil)
+		if err := checkRedirect(req, nil); err == nil {
+			t.Errorf("%s: expected the redirect to be refused", target)
+		}
+	}
+
+	via := make([]*http.Request, maxRedirects)
+	if err := checkRedirect(allowed, via); err == nil {
+		t.Error("expected the number of redirects to be limited")
+	}
+}
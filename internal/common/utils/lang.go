@@ -1,6 +1,12 @@
 package utils
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const RedactedValue = "[REDACTED]"
 
 func MapKeys(value map[string]interface{}) (result []string) {
 	for key := range value {
@@ -34,6 +40,22 @@ func Max(x, y int) int {
 	return y
 }
 
+// RedactValues returns a copy of the values in which the values of the given secret keys are redacted
+func RedactValues(values map[string]interface{}, secrets []string) map[string]interface{} {
+	result := make(map[string]interface{}, len(values))
+	for key, value := range values {
+		result[key] = value
+	}
+
+	for _, key := range secrets {
+		if _, ok := result[key]; ok {
+			result[key] = RedactedValue
+		}
+	}
+
+	return result
+}
+
 func StringRef(value string) *string {
 	return &value
 }
@@ -42,6 +64,14 @@ func IntRef(value int) *int {
 	return &value
 }
 
+func BoolRef(value bool) *bool {
+	return &value
+}
+
 func UUIDRef(value uuid.UUID) *uuid.UUID {
 	return &value
 }
+
+func TimeRef(value time.Time) *time.Time {
+	return &value
+}
@@ -0,0 +1,16 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/viper"
+)
+
+// NewRedisClient connects to the Redis instance given by redis.host, redis.port and redis.password
+func NewRedisClient(cfg *viper.Viper) *redis.Client {
+	return redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.GetString("redis.host"), cfg.GetInt("redis.port")),
+		Password: cfg.GetString("redis.password"),
+	})
+}
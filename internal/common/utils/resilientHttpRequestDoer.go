@@ -0,0 +1,271 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/spf13/viper"
+)
+
+const (
+	circuitClosed = iota
+	circuitHalfOpen
+	circuitOpen
+)
+
+var circuitStateNames = []string{"closed", "half-open", "open"}
+
+const (
+	rejectedCircuitOpen  = "circuit_open"
+	rejectedBulkheadFull = "bulkhead_full"
+)
+
+var (
+	circuitBreakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "client_circuit_breaker_state",
+		Help: "The state of the circuit breaker of a service (0 - closed, 1 - half-open, 2 - open)",
+	}, []string{"component"})
+
+	requestRejectedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "client_request_rejected_total",
+		Help: "The total number of requests to a service that were failed fast without being sent",
+	}, []string{"component", "reason"})
+
+	// guards are shared by all clients of the given service
+	guards     = map[string]*serviceGuard{}
+	guardsLock sync.Mutex
+)
+
+// Indicates that a request was failed fast because the service is considered unavailable or too many requests to it are in flight
+type ServiceUnavailableError struct {
+	Component string
+	Reason    string
+}
+
+func (this *ServiceUnavailableError) Error() string {
+	return fmt.Sprintf("%s unavailable: %s", this.Component, this.Reason)
+}
+
+func IsServiceUnavailable(err error) bool {
+	var target *ServiceUnavailableError
+	return errors.As(err, &target)
+}
+
+type ResilienceOptions struct {
+	// number of consecutive failures after which the circuit opens, 0 disables the circuit breaker
+	FailureThreshold int
+	// time after which an open circuit lets a single request through to probe the service
+	Cooldown time.Duration
+	// maximum number of requests in flight, 0 disables the bulkhead
+	MaxConcurrent int
+}
+
+// ResilienceOptionsFromConfig reads the resilience options of a service from <prefix>.breaker.failures, <prefix>.breaker.cooldown (in seconds) and <prefix>.bulkhead
+func ResilienceOptionsFromConfig(cfg *viper.Viper, prefix string) ResilienceOptions {
+	return ResilienceOptions{
+		FailureThreshold: cfg.GetInt(prefix + ".breaker.failures"),
+		Cooldown:         time.Duration(cfg.GetInt64(prefix+".breaker.cooldown")) * time.Second,
+		MaxConcurrent:    cfg.GetInt(prefix + ".bulkhead"),
+	}
+}
+
+// NewResilientHttpRequestDoer fails requests to the given service fast with ServiceUnavailableError
+// while its circuit breaker is open or while the maximum number of requests is in flight.
+// A transport error or a 5xx response counts as a failure.
+func NewResilientHttpRequestDoer(delegate HttpRequestDoer, component string, options ResilienceOptions) HttpRequestDoer {
+	return &resilientHttpRequestDoer{
+		delegate: delegate,
+		guard:    getServiceGuard(component, options),
+	}
+}
+
+type resilientHttpRequestDoer struct {
+	delegate HttpRequestDoer
+	guard    *serviceGuard
+}
+
+func (this *resilientHttpRequestDoer) Do(req *http.Request) (*http.Response, error) {
+	if err := this.guard.acquire(time.Now()); err != nil {
+		return nil, err
+	}
+
+	resp, err := this.delegate.Do(req)
+
+	// a request canceled by the caller says nothing about the service
+	if req.Context().Err() == nil {
+		this.guard.record(err == nil && resp.StatusCode < http.StatusInternalServerError, time.Now())
+	} else {
+		this.guard.abandon()
+	}
+
+	this.guard.release()
+	return resp, err
+}
+
+// CheckCircuitBreakers fails while the circuit breaker of any service is open.
+// Once the cooldown has passed the check succeeds again so that requests can probe the service.
+func CheckCircuitBreakers() error {
+	guardsLock.Lock()
+	defer guardsLock.Unlock()
+
+	now := time.Now()
+	open := []string{}
+	for component, guard := range guards {
+		if guard.isOpen(now) {
+			open = append(open, component)
+		}
+	}
+
+	if len(open) > 0 {
+		sort.Strings(open)
+		return fmt.Errorf("circuit breaker open: %v", open)
+	}
+
+	return nil
+}
+
+type serviceGuard struct {
+	component string
+	options   ResilienceOptions
+	bulkhead  chan struct{}
+
+	lock     sync.Mutex
+	state    int
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func getServiceGuard(component string, options ResilienceOptions) *serviceGuard {
+	guardsLock.Lock()
+	defer guardsLock.Unlock()
+
+	if guard, ok := guards[component]; ok {
+		return guard
+	}
+
+	guard := &serviceGuard{
+		component: component,
+		options:   options,
+	}
+
+	if options.MaxConcurrent > 0 {
+		guard.bulkhead = make(chan struct{}, options.MaxConcurrent)
+	}
+
+	circuitBreakerState.WithLabelValues(component).Set(circuitClosed)
+	guards[component] = guard
+	return guard
+}
+
+func (this *serviceGuard) acquire(now time.Time) error {
+	if this.bulkhead != nil {
+		select {
+		case this.bulkhead <- struct{}{}:
+		default:
+			return this.reject(rejectedBulkheadFull)
+		}
+	}
+
+	if !this.allow(now) {
+		this.release()
+		return this.reject(rejectedCircuitOpen)
+	}
+
+	return nil
+}
+
+func (this *serviceGuard) release() {
+	if this.bulkhead != nil {
+		<-this.bulkhead
+	}
+}
+
+func (this *serviceGuard) reject(reason string) error {
+	requestRejectedTotal.WithLabelValues(this.component, reason).Inc()
+	return &ServiceUnavailableError{Component: this.component, Reason: reason}
+}
+
+// allow lets requests through while the circuit is closed. Once the cooldown of an open circuit has passed a single request is let through to probe the service.
+func (this *serviceGuard) allow(now time.Time) bool {
+	if this.options.FailureThreshold <= 0 {
+		return true
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	switch this.state {
+	case circuitOpen:
+		if now.Sub(this.openedAt) < this.options.Cooldown {
+			return false
+		}
+
+		this.setState(circuitHalfOpen)
+		this.probing = true
+		return true
+	case circuitHalfOpen:
+		if this.probing {
+			return false
+		}
+
+		this.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+func (this *serviceGuard) record(success bool, now time.Time) {
+	if this.options.FailureThreshold <= 0 {
+		return
+	}
+
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	if success {
+		this.failures = 0
+		this.probing = false
+		this.setState(circuitClosed)
+		return
+	}
+
+	this.failures++
+
+	if this.state == circuitHalfOpen || this.failures >= this.options.FailureThreshold {
+		this.openedAt = now
+		this.probing = false
+		this.setState(circuitOpen)
+	}
+}
+
+// abandon lets another request probe the service if the probing request was canceled
+func (this *serviceGuard) abandon() {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	this.probing = false
+}
+
+func (this *serviceGuard) isOpen(now time.Time) bool {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+
+	return this.state == circuitOpen && now.Sub(this.openedAt) < this.options.Cooldown
+}
+
+func (this *serviceGuard) setState(state int) {
+	if this.state != state {
+		GetLoggerOrDie().Infow("Circuit breaker state changed", "component", this.component, "from", circuitStateNames[this.state], "to", circuitStateNames[state])
+	}
+
+	this.state = state
+	circuitBreakerState.WithLabelValues(this.component).Set(float64(state))
+}
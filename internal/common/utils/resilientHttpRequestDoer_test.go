@@ -0,0 +1,148 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type blockingHttpRequestDoer struct {
+	started chan struct{}
+	release chan struct{}
+}
+
+func (this *blockingHttpRequestDoer) Do(req *http.Request) (*http.Response, error) {
+	this.started <- struct{}{}
+	<-this.release
+	return &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+// guards are shared by component so every test uses a component of its own
+func testComponent(name string) string {
+	return name + "-" + uuid.New().String()
+}
+
+func doRequest(t *testing.T, doer HttpRequestDoer) error {
+	req, err := http.NewRequest(http.MethodGet, "http://example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = doer.Do(req)
+	return err
+}
+
+func TestCircuitBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	status := http.StatusServiceUnavailable
+	component := testComponent("test-breaker-open")
+	doer := NewResilientHttpRequestDoer(&mockHttpRequestDoer{callback: func(req *http.Request) (int, string, error) {
+		return status, "", nil
+	}}, component, ResilienceOptions{FailureThreshold: 3, Cooldown: time.Hour})
+
+	for i := 0; i < 3; i++ {
+		if err := doRequest(t, doer); err != nil {
+			t.Fatalf("request %d failed fast: %s", i, err)
+		}
+	}
+
+	err := doRequest(t, doer)
+	if !IsServiceUnavailable(err) {
+		t.Fatalf("expected ServiceUnavailableError, got %v", err)
+	}
+
+	if state := testutil.ToFloat64(circuitBreakerState.WithLabelValues(component)); state != circuitOpen {
+		t.Fatalf("expected the circuit to be reported as open, got %v", state)
+	}
+
+	if CheckCircuitBreakers() == nil {
+		t.Fatal("expected the readiness check to fail while the circuit is open")
+	}
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	doer := NewResilientHttpRequestDoer(NewMockHttpRequestDoer(http.StatusNotFound, "", nil), testComponent("test-breaker-client-errors"), ResilienceOptions{FailureThreshold: 1, Cooldown: time.Hour})
+
+	for i := 0; i < 3; i++ {
+		if err := doRequest(t, doer); err != nil {
+			t.Fatalf("request %d failed fast: %s", i, err)
+		}
+	}
+}
+
+func TestCircuitBreakerProbesAfterCooldown(t *testing.T) {
+	var err error = errors.New("connection refused")
+	doer := NewResilientHttpRequestDoer(&mockHttpRequestDoer{callback: func(req *http.Request) (int, string, error) {
+		return http.StatusOK, "", err
+	}}, testComponent("test-breaker-probe"), ResilienceOptions{FailureThreshold: 1, Cooldown: 10 * time.Millisecond})
+
+	doRequest(t, doer)
+	if !IsServiceUnavailable(doRequest(t, doer)) {
+		t.Fatal("expected the circuit to be open")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// the failed probe opens the circuit again
+	doRequest(t, doer)
+	if !IsServiceUnavailable(doRequest(t, doer)) {
+		t.Fatal("expected the circuit to be open after a failed probe")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	// the successful probe closes the circuit
+	err = nil
+	for i := 0; i < 3; i++ {
+		if err := doRequest(t, doer); err != nil {
+			t.Fatalf("request %d failed fast: %s", i, err)
+		}
+	}
+}
+
+func TestCanceledRequestsDoNotCountAsFailures(t *testing.T) {
+	doer := NewResilientHttpRequestDoer(&mockHttpRequestDoer{callback: func(req *http.Request) (int, string, error) {
+		return 0, "", req.Context().Err()
+	}}, testComponent("test-breaker-canceled"), ResilienceOptions{FailureThreshold: 1, Cooldown: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+		if _, err := doer.Do(req); IsServiceUnavailable(err) {
+			t.Fatalf("request %d failed fast: %s", i, err)
+		}
+	}
+}
+
+func TestBulkheadLimitsRequestsInFlight(t *testing.T) {
+	delegate := &blockingHttpRequestDoer{started: make(chan struct{}), release: make(chan struct{})}
+	doer := NewResilientHttpRequestDoer(delegate, testComponent("test-bulkhead"), ResilienceOptions{MaxConcurrent: 2})
+
+	done := make(chan error)
+	for i := 0; i < 2; i++ {
+		go func() { done <- doRequest(t, doer) }()
+		<-delegate.started
+	}
+
+	if err := doRequest(t, doer); !IsServiceUnavailable(err) {
+		t.Fatalf("expected ServiceUnavailableError, got %v", err)
+	}
+
+	close(delegate.release)
+	for i := 0; i < 2; i++ {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	go func() { <-delegate.started }()
+	if err := doRequest(t, doer); err != nil {
+		t.Fatalf("expected the request to be let through once the slots are released, got %v", err)
+	}
+}
@@ -107,6 +107,14 @@ func NewRunsWithLocalhost(org_id string, n int) []dbModel.Run {
 	return runs
 }
 
+func NewRunGroup(orgId string) dbModel.RunGroup {
+	return dbModel.RunGroup{
+		ID:      uuid.New(),
+		OrgID:   orgId,
+		Service: "test",
+	}
+}
+
 func NewRunHost(runID uuid.UUID, status string, inventoryID *uuid.UUID) dbModel.RunHost {
 	return dbModel.RunHost{
 		ID:          uuid.New(),
@@ -0,0 +1,88 @@
+package instrumentation
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	signalSentTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_relay_sent_total",
+		Help: "The total number of signals sent by the outbox relay",
+	})
+
+	signalNotConfirmedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_relay_not_confirmed_total",
+		Help: "The total number of signals whose sender stopped before recording whether they were sent",
+	})
+
+	signalAttemptFailedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "outbox_relay_attempt_failed_total",
+		Help: "The total number of signal attempts that failed and will be retried",
+	})
+
+	signalFailedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "outbox_relay_failed_total",
+		Help: "The total number of signals given up on, failing the run",
+	}, []string{"reason"})
+
+	errorTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "outbox_relay_error_total",
+		Help: "The total number of errors during signal processing",
+	}, []string{"type"})
+)
+
+const (
+	labelDbClaim      = "db_claim"
+	labelDbUpdate     = "db_update"
+	labelNoConnection = "no_connection"
+	labelMaxAttempts  = "max_attempts"
+)
+
+func SignalSent(ctx context.Context, signalId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Infow("Sent pending signal", "signal_id", signalId.String(), "run_id", runId.String(), "attempts", attempts)
+	signalSentTotal.Inc()
+}
+
+func SignalNotConfirmed(ctx context.Context, err error, signalId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Warnw("Sender of signal stopped before recording the result, not sending it again", "error", err, "signal_id", signalId.String(), "run_id", runId.String(), "attempts", attempts)
+	signalNotConfirmedTotal.Inc()
+}
+
+func SignalAttemptFailed(ctx context.Context, err error, signalId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Warnw("Signal attempt failed", "error", err, "signal_id", signalId.String(), "run_id", runId.String(), "attempts", attempts)
+	signalAttemptFailedTotal.Inc()
+}
+
+func SignalRejected(ctx context.Context, err error, signalId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Warnw("Recipient of pending signal not connected, run failed", "error", err, "signal_id", signalId.String(), "run_id", runId.String(), "attempts", attempts)
+	signalFailedTotal.WithLabelValues(labelNoConnection).Inc()
+}
+
+func SignalAbandoned(ctx context.Context, err error, signalId uuid.UUID, runId uuid.UUID, attempts int) {
+	utils.GetLogFromContext(ctx).Errorw("Giving up on signal, run failed", "error", err, "signal_id", signalId.String(), "run_id", runId.String(), "attempts", attempts)
+	signalFailedTotal.WithLabelValues(labelMaxAttempts).Inc()
+}
+
+func ClaimError(ctx context.Context, err error) {
+	utils.GetLogFromContext(ctx).Errorw("Error claiming pending signals", "error", err)
+	errorTotal.WithLabelValues(labelDbClaim).Inc()
+}
+
+func UpdateError(ctx context.Context, err error, signalId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error updating outbox signal", "error", err, "signal_id", signalId.String())
+	errorTotal.WithLabelValues(labelDbUpdate).Inc()
+}
+
+func Start() {
+	// initialize label values
+	// https://www.robustperception.io/existential-issues-with-metrics
+	signalFailedTotal.WithLabelValues(labelNoConnection)
+	signalFailedTotal.WithLabelValues(labelMaxAttempts)
+	errorTotal.WithLabelValues(labelDbClaim)
+	errorTotal.WithLabelValues(labelDbUpdate)
+}
@@ -0,0 +1,75 @@
+package outboxRelay
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/queue"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/outbox-relay/instrumentation"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+func Start(
+	ctx context.Context,
+	cfg *viper.Viper,
+	errors chan<- error,
+	ready, live *utils.ProbeHandler,
+	wg *sync.WaitGroup,
+) {
+	instrumentation.Start()
+
+	db, sql := db.Connect(ctx, cfg)
+	ready.Register(sql.Ping)
+	live.Register(sql.Ping)
+
+	var cloudConnectorClient connectors.CloudConnectorClient
+
+	if cfg.GetString("cloud.connector.impl") == "impl" {
+		cloudConnectorClient = connectors.NewConnectorClient(cfg)
+	} else {
+		cloudConnectorClient = connectors.NewConnectorClientMock()
+		utils.GetLogFromContext(ctx).Warn("Using mock CloudConnectorClient")
+	}
+
+	// dispatching invalidates the connection status of the recipient in a shared cache
+	cloudConnectorClient = connectors.NewConnectorClientWithCache(cfg, cloudConnectorClient)
+
+	rateLimiter := rate.NewLimiter(rate.Limit(cfg.GetInt("cloud.connector.rps")), cfg.GetInt("cloud.connector.req.bucket"))
+
+	relay := &relay{
+		db:              db,
+		dispatchManager: dispatch.NewDispatchManager(cfg, cloudConnectorClient, rateLimiter, db),
+		Options:         queue.OptionsFromConfig(cfg, "outbox"),
+	}
+
+	pollInterval := time.Duration(cfg.GetInt("outbox.poll.interval")) * time.Second
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer utils.GetLogFromContext(ctx).Debug("Outbox relay stopped")
+		defer sql.Close()
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			// keep going without waiting while there is a backlog of pending signals
+			if relay.processBatch(ctx) == relay.BatchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
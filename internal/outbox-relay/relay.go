@@ -0,0 +1,101 @@
+package outboxRelay
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/dispatch"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/queue"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/outbox-relay/instrumentation"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type relay struct {
+	db              *gorm.DB
+	dispatchManager dispatch.DispatchManager
+
+	queue.Options
+}
+
+// processBatch sends a batch of pending signals that are due.
+// Returns the number of signals processed.
+func (this *relay) processBatch(ctx context.Context) int {
+	signals, err := this.claim(ctx)
+	if err != nil {
+		instrumentation.ClaimError(ctx, err)
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	for _, signal := range signals {
+		wg.Add(1)
+		go func(signal dbModel.OutboxSignal) {
+			defer wg.Done()
+			this.process(utils.WithOrgId(ctx, signal.OrgID), signal)
+		}(signal)
+	}
+
+	wg.Wait()
+	return len(signals)
+}
+
+// claim leases pending signals that are due, along with signals whose sender stopped in the middle of sending them.
+// Pending signals of runs that are no longer running are left alone; these are abandoned when the run leaves the running status.
+func (this *relay) claim(ctx context.Context) ([]dbModel.OutboxSignal, error) {
+	var signals []dbModel.OutboxSignal
+
+	err := this.Claim(this.db.WithContext(ctx), &signals, "outbox_signals",
+		"(status = ? OR (status = ? AND EXISTS (SELECT 1 FROM runs WHERE runs.id = outbox_signals.run_id AND runs.status = ?)))",
+		dbModel.OutboxSignalStatusSending, dbModel.OutboxSignalStatusPending, dbModel.RunStatusRunning,
+	)
+
+	return signals, err
+}
+
+func (this *relay) process(ctx context.Context, signal dbModel.OutboxSignal) {
+	attempts := signal.Attempts + 1
+
+	err := this.dispatchManager.DeliverSignal(ctx, signal)
+
+	switch err.(type) {
+	case nil:
+		instrumentation.SignalSent(ctx, signal.ID, signal.RunID, attempts)
+		return
+	case *dispatch.RecipientNotFoundError, *dispatch.RunNotRunningError:
+		instrumentation.SignalRejected(ctx, err, signal.ID, signal.RunID, attempts)
+		return
+	case *dispatch.SignalNotConfirmedError:
+		instrumentation.SignalNotConfirmed(ctx, err, signal.ID, signal.RunID, attempts)
+		return
+	}
+
+	if attempts >= this.MaxAttempts {
+		if failErr := this.dispatchManager.FailSignal(ctx, signal, err); failErr != nil {
+			instrumentation.UpdateError(ctx, failErr, signal.ID)
+			return
+		}
+
+		instrumentation.SignalAbandoned(ctx, err, signal.ID, signal.RunID, attempts)
+		return
+	}
+
+	result := this.db.WithContext(ctx).Model(&dbModel.OutboxSignal{}).
+		Where("id = ?", signal.ID).
+		Where("status = ?", dbModel.OutboxSignalStatusPending).
+		Updates(map[string]interface{}{
+			"attempts":        attempts,
+			"next_attempt_at": time.Now().Add(this.Backoff(attempts)),
+			"last_error":      queue.TruncateError(err),
+			"updated_at":      time.Now(),
+		})
+
+	if result.Error != nil {
+		instrumentation.UpdateError(ctx, result.Error, signal.ID)
+		return
+	}
+
+	instrumentation.SignalAttemptFailed(ctx, err, signal.ID, signal.RunID, attempts)
+}
@@ -0,0 +1,205 @@
+package outboxRelay
+
+import (
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/config"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/model/generic"
+	"playbook-dispatcher/internal/common/queue"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+)
+
+var _ = Describe("relay", func() {
+	db := test.WithDatabase()
+
+	newRelay := func() *relay {
+		return &relay{
+			db:              db(),
+			dispatchManager: dispatch.NewDispatchManager(config.Get(), connectors.NewConnectorClientMock(), rate.NewLimiter(rate.Inf, 1), db()),
+			Options: queue.Options{
+				BatchSize:   100,
+				Lease:       time.Minute,
+				MaxAttempts: 3,
+				BackoffBase: 10 * time.Second,
+				BackoffMax:  time.Hour,
+			},
+		}
+	}
+
+	newSignal := func(recipient uuid.UUID, attempts int) dbModel.OutboxSignal {
+		run := test.NewRun("5318290")
+		run.Recipient = recipient
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host := test.NewRunHost(run.ID, dbModel.RunStatusRunning, nil)
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+		signal := dbModel.OutboxSignal{
+			ID:            uuid.New(),
+			RunID:         run.ID,
+			OrgID:         run.OrgID,
+			Recipient:     run.Recipient,
+			Directive:     "rhc-worker-playbook",
+			URL:           run.URL,
+			Metadata:      []byte(`{"crc_dispatcher_correlation_id":"` + run.CorrelationID.String() + `"}`),
+			Status:        dbModel.OutboxSignalStatusPending,
+			Attempts:      attempts,
+			NextAttemptAt: time.Now().Add(-time.Second),
+		}
+		Expect(db().Create(&signal).Error).ToNot(HaveOccurred())
+
+		return signal
+	}
+
+	getSignal := func(id uuid.UUID) dbModel.OutboxSignal {
+		signal := dbModel.OutboxSignal{}
+		Expect(db().Where("id = ?", id).First(&signal).Error).ToNot(HaveOccurred())
+		return signal
+	}
+
+	getRun := func(id uuid.UUID) dbModel.Run {
+		run := dbModel.Run{}
+		Expect(db().Where("id = ?", id).First(&run).Error).ToNot(HaveOccurred())
+		return run
+	}
+
+	It("sends a pending signal", func() {
+		signal := newSignal(uuid.New(), 0)
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusSent))
+		Expect(updated.Attempts).To(Equal(1))
+		Expect(updated.MessageID).ToNot(BeNil())
+		Expect(getRun(signal.RunID).Status).To(Equal(dbModel.RunStatusRunning))
+	})
+
+	It("reschedules a signal that cannot be sent", func() {
+		signal := newSignal(uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587"), 0)
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusPending))
+		Expect(updated.Attempts).To(Equal(1))
+		Expect(updated.LastError).ToNot(BeNil())
+		Expect(updated.NextAttemptAt).To(BeTemporally("~", time.Now().Add(10*time.Second), 2*time.Second))
+		Expect(getRun(signal.RunID).Status).To(Equal(dbModel.RunStatusRunning))
+	})
+
+	It("fails the run once the maximum number of attempts is reached", func() {
+		signal := newSignal(uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587"), 2)
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusFailed))
+		Expect(updated.Attempts).To(Equal(3))
+		Expect(getRun(signal.RunID).Status).To(Equal(dbModel.RunStatusFailure))
+	})
+
+	It("fails the run if the recipient is not connected", func() {
+		signal := newSignal(uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199"), 0)
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusFailed))
+		Expect(updated.Attempts).To(Equal(1))
+
+		run := getRun(signal.RunID)
+		Expect(run.Status).To(Equal(dbModel.RunStatusFailure))
+
+		host := dbModel.RunHost{}
+		Expect(db().Where("run_id = ?", run.ID).First(&host).Error).ToNot(HaveOccurred())
+		Expect(host.Status).To(Equal(dbModel.RunStatusFailure))
+	})
+
+	It("does not send a signal of a run that is no longer running", func() {
+		signal := newSignal(uuid.New(), 0)
+		Expect(db().Model(&dbModel.Run{}).Where("id = ?", signal.RunID).Update("status", dbModel.RunStatusCanceled).Error).ToNot(HaveOccurred())
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusPending))
+		Expect(updated.Attempts).To(Equal(0))
+	})
+
+	It("abandons a claimed signal of a run that timed out in the meantime", func() {
+		signal := newSignal(uuid.New(), 0)
+		Expect(db().Model(&dbModel.Run{}).Where("id = ?", signal.RunID).Update("status", dbModel.RunStatusTimeout).Error).ToNot(HaveOccurred())
+
+		newRelay().process(test.TestContext(), signal)
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusFailed))
+		Expect(updated.MessageID).To(BeNil())
+		Expect(getRun(signal.RunID).Status).To(Equal(dbModel.RunStatusTimeout))
+	})
+
+	It("cancels a run whose signal has not been sent yet", func() {
+		signal := newSignal(uuid.New(), 0)
+
+		runID, _, err := newRelay().dispatchManager.ProcessCancel(test.TestContext(), signal.OrgID, generic.CancelInput{
+			RunId:     signal.RunID,
+			OrgId:     signal.OrgID,
+			Principal: "test_user",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(runID).To(Equal(signal.RunID))
+
+		Expect(getSignal(signal.ID).Status).To(Equal(dbModel.OutboxSignalStatusFailed))
+		Expect(getRun(signal.RunID).Status).To(Equal(dbModel.RunStatusCanceled))
+
+		newRelay().processBatch(test.TestContext())
+		Expect(getSignal(signal.ID).MessageID).To(BeNil())
+	})
+
+	It("does not send a signal again whose sender stopped in the middle of sending it", func() {
+		signal := newSignal(uuid.New(), 0)
+		Expect(db().Model(&signal).Update("status", dbModel.OutboxSignalStatusSending).Error).ToNot(HaveOccurred())
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusSent))
+		Expect(updated.Attempts).To(Equal(1))
+		Expect(updated.MessageID).To(BeNil())
+		Expect(*updated.LastError).To(ContainSubstring("Signal may not have been sent"))
+		Expect(getRun(signal.RunID).Status).To(Equal(dbModel.RunStatusRunning))
+	})
+
+	It("leaves a signal that is being sent to its sender until the lease expires", func() {
+		signal := newSignal(uuid.New(), 0)
+		Expect(db().Model(&signal).Updates(map[string]interface{}{
+			"status":          dbModel.OutboxSignalStatusSending,
+			"next_attempt_at": time.Now().Add(time.Hour),
+		}).Error).ToNot(HaveOccurred())
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusSending))
+		Expect(updated.Attempts).To(Equal(0))
+	})
+
+	It("does not pick up signals that are not due yet", func() {
+		signal := newSignal(uuid.New(), 0)
+		Expect(db().Model(&signal).Update("next_attempt_at", time.Now().Add(time.Hour)).Error).ToNot(HaveOccurred())
+
+		newRelay().processBatch(test.TestContext())
+
+		updated := getSignal(signal.ID)
+		Expect(updated.Status).To(Equal(dbModel.OutboxSignalStatusPending))
+		Expect(updated.Attempts).To(Equal(0))
+	})
+})
@@ -6,10 +6,13 @@ import (
 	"time"
 
 	"playbook-dispatcher/internal/common/ansible"
+	"playbook-dispatcher/internal/common/callback"
 	"playbook-dispatcher/internal/common/constants"
 	kafkaUtils "playbook-dispatcher/internal/common/kafka"
 	"playbook-dispatcher/internal/common/model/db"
 	"playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/notify"
+	"playbook-dispatcher/internal/common/outbox"
 	"playbook-dispatcher/internal/common/satellite"
 	"playbook-dispatcher/internal/common/utils"
 	"playbook-dispatcher/internal/response-consumer/instrumentation"
@@ -22,9 +25,11 @@ import (
 )
 
 const (
-	EventPlaybookOnStats  = "playbook_on_stats"
-	EventRunnerOnFailed   = "runner_on_failed"
-	EventExecutorOnFailed = "executor_on_failed"
+	EventPlaybookOnStats     = "playbook_on_stats"
+	EventRunnerOnFailed      = "runner_on_failed"
+	EventRunnerOnUnreachable = "runner_on_unreachable"
+	EventExecutorOnFailed    = "executor_on_failed"
+	EventExecutorOnCanceled  = "executor_on_canceled"
 
 	EventSatPlaybookFinished  = "playbook_run_finished"
 	EventSatPlaybookCompleted = "playbook_run_completed"
@@ -83,7 +88,7 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 			Where("org_id = ?", value.OrgId).
 			Where("correlation_id = ?", correlationId)
 
-		selectResult := baseQuery.Select("id", "status", "response_full").First(&run)
+		selectResult := baseQuery.Select("id", "org_id", "service", "recipient", "labels", "status", "response_full", "extra_vars").First(&run)
 
 		if requestType == satMessageHeaderValue {
 			satellite.SortSatEvents(value.SatEvents)
@@ -95,7 +100,7 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 				status = checkSatStatusPartial(value.SatEvents)
 			}
 
-			if run.Status == db.RunStatusFailure || run.Status == db.RunStatusCanceled {
+			if run.Status == db.RunStatusFailure || run.Status == db.RunStatusCanceled || run.Status == db.RunStatusUnreachable {
 				status = run.Status
 			}
 		} else {
@@ -123,7 +128,7 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 			Where("org_id = ?", value.OrgId).
 			Where("correlation_id = ?", correlationId).
 			Where("id = ?", run.ID).
-			Where("status not in ?", []string{db.RunStatusSuccess, db.RunStatusFailure}).
+			Where("status not in ?", []string{db.RunStatusSuccess, db.RunStatusFailure, db.RunStatusUnreachable}).
 			Select("status", "events").
 			Updates(toUpdate)
 		if updateResult.Error != nil {
@@ -133,8 +138,34 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 			runsUpdated = updateResult.RowsAffected
 		}
 
+		if runsUpdated > 0 && status != run.Status {
+			if err := notify.Publish(ctx, tx, newStatusChange(run, notify.TypeRun, status)); err != nil {
+				utils.GetLogFromContext(ctx).Errorw("Error publishing run status change", "error", err)
+				return err
+			}
+
+			if isFinalStatus(status) {
+				if _, err := outbox.Abandon(tx, status, run.ID); err != nil {
+					utils.GetLogFromContext(ctx).Errorw("Error abandoning run signals", "error", err)
+					return err
+				}
+
+				if err := callback.Enqueue(ctx, tx, run.ID); err != nil {
+					utils.GetLogFromContext(ctx).Errorw("Error enqueuing run callback", "error", err)
+					return err
+				}
+			}
+		}
+
 		var toCreate []db.RunHost
 
+		// the output of a run and the diffs show what tasks printed and wrote to the host,
+		// which may include the values of secret extra vars
+		var secrets db.Secrets
+		if run.ExtraVars != nil {
+			secrets = run.ExtraVars.SecretValues()
+		}
+
 		if requestType == runnerMessageHeaderValue {
 			hosts := ansible.GetAnsibleHosts(*value.RunnerEvents)
 
@@ -148,16 +179,31 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 				hosts = []string{"localhost"}
 			}
 
+			previous, err := getRunHostStatuses(tx, run.ID, "host")
+			if err != nil {
+				return err
+			}
+
+			stdout := ansible.RedactText(ansible.GetStdout(*value.RunnerEvents, nil), secrets)
+
 			toCreate = mapHostsToRunHosts(hosts, func(host string) db.RunHost {
 				return db.RunHost{
 					ID:     uuid.New(),
 					RunID:  run.ID,
 					Host:   host,
 					Status: inferStatus(value.RunnerEvents, &host),
-					Log:    ansible.GetStdout(*value.RunnerEvents, nil),
+					Log:    stdout,
+					Stats:  ansible.GetHostStats(*value.RunnerEvents, host),
+					Diffs:  ansible.RedactDiffs(ansible.GetHostDiffs(*value.RunnerEvents, host), secrets),
 				}
 			})
-			return createRecord(ctx, tx, toCreate)
+			if err := createRecord(ctx, tx, toCreate); err != nil {
+				return err
+			}
+
+			return publishRunHostStatusChanges(ctx, tx, run, previous, toCreate, func(runHost db.RunHost) string {
+				return runHost.Host
+			})
 		} else if requestType == satMessageHeaderValue {
 			hosts := satellite.GetSatHosts(*value.SatEvents)
 
@@ -165,6 +211,11 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 				return nil
 			}
 
+			previous, err := getRunHostStatuses(tx, run.ID, "inventory_id")
+			if err != nil {
+				return err
+			}
+
 			toCreate = mapHostsToRunHosts(hosts, func(host string) db.RunHost {
 				satHost := satellite.GetSatHostInfo(*value.SatEvents, &host)
 				inventoryId := uuid.MustParse(host)
@@ -174,10 +225,16 @@ func (this *handler) onMessage(ctx context.Context, msg *k.Message) {
 					InventoryID: &inventoryId,
 					SatSequence: satHost.Sequence,
 					Status:      inferSatHostStatus(value.SatEvents, host),
-					Log:         satHost.Console,
+					Log:         ansible.RedactText(satHost.Console, secrets),
 				}
 			})
-			return satUpdateRecord(ctx, tx, run.ResponseFull, toCreate)
+			if err := satUpdateRecord(ctx, tx, run.ResponseFull, toCreate); err != nil {
+				return err
+			}
+
+			return publishRunHostStatusChanges(ctx, tx, run, previous, toCreate, func(runHost db.RunHost) string {
+				return runHost.InventoryID.String()
+			})
 		}
 
 		return nil
@@ -230,11 +287,86 @@ func satUpdateRecord(ctx context.Context, tx *gorm.DB, responseFull bool, toUpda
 	return nil
 }
 
+// getRunHostStatuses returns the current status of each host of the given run keyed by the given column
+func getRunHostStatuses(tx *gorm.DB, runID uuid.UUID, keyColumn string) (map[string]string, error) {
+	var rows []struct {
+		Key    string
+		Status string
+	}
+
+	result := tx.Model(db.RunHost{}).
+		Select(keyColumn+" as key", "status").
+		Where("run_id = ?", runID).
+		Scan(&rows)
+
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	statuses := make(map[string]string, len(rows))
+	for _, row := range rows {
+		statuses[row.Key] = row.Status
+	}
+
+	return statuses, nil
+}
+
+func publishRunHostStatusChanges(ctx context.Context, tx *gorm.DB, run db.Run, previous map[string]string, hosts []db.RunHost, key func(runHost db.RunHost) string) error {
+	changes := []notify.StatusChange{}
+
+	for _, runHost := range hosts {
+		previousStatus, exists := previous[key(runHost)]
+
+		// hosts in a final state are never updated
+		if exists && (previousStatus == runHost.Status || previousStatus == db.RunStatusSuccess || previousStatus == db.RunStatusFailure || previousStatus == db.RunStatusUnreachable) {
+			continue
+		}
+
+		change := newStatusChange(run, notify.TypeRunHost, runHost.Status)
+		if runHost.Host != "" {
+			change.Host = &runHost.Host
+		}
+		change.InventoryID = runHost.InventoryID
+
+		changes = append(changes, change)
+	}
+
+	if err := notify.Publish(ctx, tx, changes...); err != nil {
+		utils.GetLogFromContext(ctx).Errorw("Error publishing run host status change", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func isFinalStatus(status string) bool {
+	switch status {
+	case db.RunStatusSuccess, db.RunStatusFailure, db.RunStatusCanceled, db.RunStatusTimeout, db.RunStatusUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
+func newStatusChange(run db.Run, changeType string, status string) notify.StatusChange {
+	return notify.StatusChange{
+		Type:      changeType,
+		RunID:     run.ID,
+		OrgID:     run.OrgID,
+		Service:   run.Service,
+		Recipient: run.Recipient,
+		Labels:    run.Labels,
+		Status:    status,
+		Timestamp: time.Now(),
+	}
+}
+
 func createRecord(ctx context.Context, tx *gorm.DB, toCreate []db.RunHost) error {
 
 	successOrFailure := clause.OrConditions{Exprs: []clause.Expression{
 		clause.Eq{Column: "run_hosts.status", Value: db.RunStatusSuccess},
 		clause.Eq{Column: "run_hosts.status", Value: db.RunStatusFailure},
+		clause.Eq{Column: "run_hosts.status", Value: db.RunStatusUnreachable},
 	}}
 
 	notMarkedAsComplete := clause.Where{Exprs: []clause.Expression{clause.Not(successOrFailure)}}
@@ -243,7 +375,7 @@ func createRecord(ctx context.Context, tx *gorm.DB, toCreate []db.RunHost) error
 		Clauses(clause.OnConflict{
 			Where:     notMarkedAsComplete,
 			Columns:   []clause.Column{{Name: "run_id"}, {Name: "host"}},
-			DoUpdates: clause.AssignmentColumns([]string{"status", "log"}),
+			DoUpdates: clause.AssignmentColumns([]string{"status", "log", "stats", "diffs"}),
 		}).
 		Create(&toCreate)
 
@@ -258,6 +390,8 @@ func createRecord(ctx context.Context, tx *gorm.DB, toCreate []db.RunHost) error
 func inferStatus(events *[]message.PlaybookRunResponseMessageYamlEventsElem, host *string) string {
 	finished := false
 	failed := false
+	unreachable := false
+	canceled := false
 
 	for _, event := range *events {
 		if event.Event == EventPlaybookOnStats {
@@ -273,16 +407,29 @@ func inferStatus(events *[]message.PlaybookRunResponseMessageYamlEventsElem, hos
 			failed = true
 		}
 
+		if event.Event == EventRunnerOnUnreachable {
+			unreachable = true
+		}
+
 		if event.Event == EventExecutorOnFailed {
 			failed = true
 			finished = true
 		}
+
+		if event.Event == EventExecutorOnCanceled {
+			canceled = true
+			finished = true
+		}
 	}
 
 	switch {
+	case canceled:
+		return db.RunStatusCanceled
 	case finished && failed:
 		return db.RunStatusFailure
-	case finished && !failed:
+	case finished && unreachable:
+		return db.RunStatusUnreachable
+	case finished:
 		return db.RunStatusSuccess
 	default:
 		return db.RunStatusRunning
@@ -302,12 +449,23 @@ func satStatusEventDbMap(status message.PlaybookSatRunResponseMessageYamlEventsE
 	}
 }
 
+// failures caused by connectivity problems are reported as unreachable
+func satEventStatus(event message.PlaybookSatRunResponseMessageYamlEventsElem) string {
+	status := satStatusEventDbMap(*event.Status)
+
+	if status == db.RunStatusFailure && satellite.IsConnectionError(event) {
+		return db.RunStatusUnreachable
+	}
+
+	return status
+}
+
 func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlEventsElem) string {
 	hostStatusMap := make(map[string]string)
 
 	for _, event := range *events {
 		if event.Type == EventSatPlaybookCompleted {
-			return satStatusEventDbMap(*event.Status)
+			return satEventStatus(event)
 		}
 
 		if event.Host != nil {
@@ -315,12 +473,13 @@ func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlE
 				hostStatusMap[*event.Host] = db.RunStatusRunning
 			}
 			if event.Status != nil {
-				hostStatusMap[*event.Host] = satStatusEventDbMap(*event.Status)
+				hostStatusMap[*event.Host] = satEventStatus(event)
 			}
 		}
 	}
 
 	failed := false
+	unreachable := false
 	canceled := false
 
 	for _, status := range hostStatusMap {
@@ -330,6 +489,9 @@ func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlE
 		if status == db.RunStatusFailure {
 			failed = true
 		}
+		if status == db.RunStatusUnreachable {
+			unreachable = true
+		}
 		if status == db.RunStatusCanceled {
 			canceled = true
 		}
@@ -338,6 +500,8 @@ func inferSatPlaybookStatus(events *[]message.PlaybookSatRunResponseMessageYamlE
 	switch {
 	case failed:
 		return db.RunStatusFailure
+	case unreachable:
+		return db.RunStatusUnreachable
 	case canceled:
 		return db.RunStatusCanceled
 	default:
@@ -351,7 +515,7 @@ func inferSatHostStatus(events *[]message.PlaybookSatRunResponseMessageYamlEvent
 			continue
 		}
 		if event.Type == EventSatPlaybookFinished && event.Status != nil {
-			return satStatusEventDbMap(*event.Status)
+			return satEventStatus(event)
 		}
 	}
 
@@ -365,7 +529,7 @@ func checkSatStatusPartial(events *[]message.PlaybookSatRunResponseMessageYamlEv
 			continue
 		}
 
-		return satStatusEventDbMap(*event.Status)
+		return satEventStatus(event)
 	}
 
 	return db.RunStatusRunning
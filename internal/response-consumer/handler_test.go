@@ -273,6 +273,24 @@ var _ = Describe("handler", func() {
 			checkHost(data.ID, "failure", nil, expectedErrorMsg, nil)
 		})
 
+		It("updates the run status based on executor_on_canceled events", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"runner_on_failed",
+				EventExecutorOnCanceled,
+			)
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("canceled"))
+			checkHost(data.ID, "canceled", nil, "", nil)
+		})
+
 		It("successful runner event - ignore out-of-order updates", func() {
 			var data = test.NewRun(orgId())
 			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
@@ -362,6 +380,62 @@ var _ = Describe("handler", func() {
 			checkHost(data.ID, "failure", nil, "", nil)
 		})
 
+		It("updates the run status based on unreachable runner events", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_play_start",
+				"playbook_on_task_start",
+				"runner_on_start",
+				"runner_on_unreachable",
+				"playbook_on_stats",
+			)
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("unreachable"))
+			checkHost(data.ID, "unreachable", nil, "", nil)
+		})
+
+		It("prefers failure over unreachable", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_play_start",
+				"playbook_on_task_start",
+				"runner_on_start",       // host 1
+				"runner_on_start",       // host 2
+				"runner_on_failed",      // host 1
+				"runner_on_unreachable", // host 2
+				"playbook_on_stats",
+			)
+
+			localhost2 := "localhost2"
+			(*events)[5].EventData.Host = &localhost2
+			(*events)[7].EventData.Host = &localhost2
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			run := fetchRun(data.ID)
+			Expect(run.Status).To(Equal("failure"))
+			hosts := fetchHosts(data.ID)
+			Expect(hosts).To(HaveLen(2))
+
+			sort.Slice(hosts, func(i, j int) bool {
+				return hosts[i].Host == "localhost"
+			})
+
+			Expect(hosts[0].Status).To(Equal("failure"))
+			Expect(hosts[1].Status).To(Equal("unreachable"))
+		})
+
 		It("updates multiple hosts involved in a run", func() {
 			var data = test.NewRun(orgId())
 			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
@@ -392,6 +466,9 @@ var _ = Describe("handler", func() {
 			(*events)[11].Stdout = utils.StringRef("b")
 			(*events)[12].EventData.Host = &localhost2
 			(*events)[12].Stdout = utils.StringRef("2")
+			(*events)[13].EventData.Ok = map[string]int{"localhost": 2, "localhost2": 1}
+			(*events)[13].EventData.Changed = map[string]int{"localhost": 1}
+			(*events)[13].EventData.Failures = map[string]int{"localhost2": 1}
 
 			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
 
@@ -414,6 +491,106 @@ var _ = Describe("handler", func() {
 			Expect(hosts[1].Status).To(Equal("failure"))
 			Expect(hosts[0].Log).To(Equal("a1b2"))
 			Expect(hosts[1].Log).To(Equal("a1b2"))
+			Expect(hosts[0].Stats).To(Equal(&dbModel.RunHostStats{Ok: 2, Changed: 1}))
+			Expect(hosts[1].Stats).To(Equal(&dbModel.RunHostStats{Ok: 1, Failures: 1}))
+		})
+
+		It("stores the diffs reported by the hosts", func() {
+			var data = test.NewRun(orgId())
+			data.Mode = dbModel.RunModeCheck
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_play_start",
+				"playbook_on_task_start",
+				"runner_on_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			(*events)[5].EventData.Play = utils.StringRef("harden hosts")
+			(*events)[5].EventData.Task = utils.StringRef("Disable root login")
+			(*events)[5].EventData.Res = &messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{
+				Changed: true,
+				Diff:    map[string]interface{}{"before": "PermitRootLogin yes\n", "after": "PermitRootLogin no\n"},
+			}
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			hosts := fetchHosts(data.ID)
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].Diffs).To(Equal(dbModel.RunHostDiffs{{
+				Play: "harden hosts",
+				Task: "Disable root login",
+				Changes: []dbModel.DiffChange{{
+					Before: "PermitRootLogin yes\n",
+					After:  "PermitRootLogin no\n",
+				}},
+			}}))
+		})
+
+		It("redacts the values of secret extra vars from the diffs", func() {
+			var data = test.NewRun(orgId())
+			data.Mode = dbModel.RunModeCheck
+			data.ExtraVars = &dbModel.ExtraVars{
+				Values:  map[string]interface{}{"user": "admin", "password": "s3cr3t"},
+				Secrets: []string{"password"},
+			}
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_play_start",
+				"playbook_on_task_start",
+				"runner_on_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			(*events)[5].EventData.Play = utils.StringRef("configure app")
+			(*events)[5].EventData.Task = utils.StringRef("Write credentials")
+			(*events)[5].EventData.Res = &messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{
+				Changed: true,
+				Diff:    map[string]interface{}{"before": "", "after": "user=admin\npassword=s3cr3t\n"},
+			}
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			hosts := fetchHosts(data.ID)
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].Diffs).To(HaveLen(1))
+			Expect(hosts[0].Diffs[0].Changes[0].After).To(Equal("user=admin\npassword=[REDACTED]\n"))
+		})
+
+		It("redacts the values of secret extra vars from the output", func() {
+			var data = test.NewRun(orgId())
+			data.ExtraVars = &dbModel.ExtraVars{
+				Values:  map[string]interface{}{"user": "admin", "password": "s3cr3t"},
+				Secrets: []string{"password"},
+			}
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			events := createRunnerEvents(
+				messageModel.EventExecutorOnStart,
+				"playbook_on_start",
+				"playbook_on_play_start",
+				"playbook_on_task_start",
+				"runner_on_start",
+				"runner_on_ok",
+				"playbook_on_stats",
+			)
+
+			(*events)[5].Stdout = utils.StringRef("ok: [localhost] => {\"msg\": \"admin:s3cr3t\"}")
+
+			instance.onMessage(test.TestContext(), newRunnerResponseMessage(events, data.CorrelationID))
+
+			hosts := fetchHosts(data.ID)
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].Log).To(ContainSubstring("admin:[REDACTED]"))
+			Expect(hosts[0].Log).ToNot(ContainSubstring("s3cr3t"))
 		})
 	})
 
@@ -773,10 +950,37 @@ var _ = Describe("handler", func() {
 
 			instance.onMessage(test.TestContext(), newSatResponseMessage(events, data.CorrelationID))
 
+			run := fetchRun(data.ID)
+			seq := 1
+			Expect(run.Status).To(Equal("unreachable"))
+			checkHost(data.ID, "unreachable", &seq, errorDescription, &inventoryId)
+		})
+
+		It("marks the host as unreachable if satellite cannot connect to it", func() {
+			var data = test.NewRun(orgId())
+			Expect(db().Create(&data).Error).ToNot(HaveOccurred())
+
+			inventoryId := uuid.New()
+			var hostData = test.NewRunHost(data.ID, "running", &inventoryId)
+			inventoryIdString := inventoryId.String()
+
+			Expect(db().Create(&hostData).Error).ToNot(HaveOccurred())
+
+			events := buildSatEvents(
+				data.CorrelationID,
+				satPlaybookRunUpdateEvent(1, inventoryIdString, ""),
+				satPlaybookRunFinishedEvent(inventoryIdString, "failure"),
+				satPlaybookRunCompletedEvent("failure"),
+			)
+
+			(*events)[1].ConnectionCode = utils.IntRef(1)
+
+			instance.onMessage(test.TestContext(), newSatResponseMessage(events, data.CorrelationID))
+
 			run := fetchRun(data.ID)
 			seq := 1
 			Expect(run.Status).To(Equal("failure"))
-			checkHost(data.ID, "failure", &seq, errorDescription, &inventoryId)
+			checkHost(data.ID, "unreachable", &seq, "", &inventoryId)
 		})
 
 		It("copies over satellite_infrastructure_error to console", func() {
@@ -8,8 +8,10 @@ import (
 	"playbook-dispatcher/internal/response-consumer/instrumentation"
 	"sync"
 
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/qri-io/jsonschema"
 	"github.com/spf13/viper"
+	"gorm.io/gorm"
 )
 
 const (
@@ -27,13 +29,6 @@ func Start(
 ) {
 	instrumentation.Start()
 
-	schemaMapper := make(map[string]*jsonschema.Schema)
-	var schemaNames = []string{"schema.message.response", "schema.satmessage.response"}
-
-	schemas := utils.LoadSchemas(cfg, schemaNames)
-	schemaMapper[runnerMessageHeaderValue] = schemas[0]
-	schemaMapper[satMessageHeaderValue] = schemas[1]
-
 	db, sql := db.Connect(ctx, cfg)
 	ready.Register(sql.Ping)
 	live.Register(sql.Ping)
@@ -51,7 +46,7 @@ func Start(
 	}
 
 	headerPredicate := kafka.FilterByHeaderPredicate(utils.GetLogFromContext(ctx), requestTypeHeader, runnerMessageHeaderValue, satMessageHeaderValue)
-	validationPredicate := kafka.SchemaValidationPredicate(ctx, requestTypeHeader, schemaMapper)
+	validationPredicate := kafka.SchemaValidationPredicate(ctx, requestTypeHeader, loadSchemas(cfg))
 
 	start := kafka.NewConsumerEventLoop(ctx, consumer, headerPredicate, validationPredicate, handler.onMessage, errors)
 
@@ -64,3 +59,29 @@ func Start(
 		start()
 	}()
 }
+
+// NewMessageHandler returns a function that validates and processes a response message the same way as if it was consumed from Kafka.
+// Used by the simulator module to feed responses in directly.
+func NewMessageHandler(ctx context.Context, cfg *viper.Viper, db *gorm.DB) func(context.Context, *k.Message) {
+	instrumentation.Start()
+
+	validationPredicate := kafka.SchemaValidationPredicate(ctx, requestTypeHeader, loadSchemas(cfg))
+	handler := &handler{
+		db: db,
+	}
+
+	return func(ctx context.Context, msg *k.Message) {
+		if validationPredicate(msg) {
+			handler.onMessage(ctx, msg)
+		}
+	}
+}
+
+func loadSchemas(cfg *viper.Viper) map[string]*jsonschema.Schema {
+	schemas := utils.LoadSchemas(cfg, []string{"schema.message.response", "schema.satmessage.response"})
+
+	return map[string]*jsonschema.Schema{
+		runnerMessageHeaderValue: schemas[0],
+		satMessageHeaderValue:    schemas[1],
+	}
+}
@@ -0,0 +1,124 @@
+package instrumentation
+
+import (
+	"context"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	runDispatchedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduler_run_dispatched_total",
+		Help: "The total number of scheduled runs dispatched once due",
+	}, []string{"dispatching_service"})
+
+	runExpiredTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduler_run_expired_total",
+		Help: "The total number of scheduled runs that expired before being dispatched",
+	}, []string{"dispatching_service"})
+
+	runRetriedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduler_run_retried_total",
+		Help: "The total number of finished runs retried according to their retry policy",
+	}, []string{"dispatching_service"})
+
+	rolloutWaveReleasedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "scheduler_rollout_wave_released_total",
+		Help: "The total number of rollout waves released",
+	})
+
+	rolloutHaltedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "scheduler_rollout_halted_total",
+		Help: "The total number of rollouts halted because their runs failed",
+	})
+
+	errorTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "scheduler_error_total",
+		Help: "The total number of errors during processing of scheduled runs",
+	}, []string{"type"})
+)
+
+const (
+	labelDbRead       = "db_read"
+	labelDispatch     = "dispatch"
+	labelNoConnection = "no_connection"
+	labelBusy         = "recipient_busy"
+	labelExpire       = "expire"
+	labelRetry        = "retry"
+	labelRollout      = "rollout"
+)
+
+func RunDispatched(ctx context.Context, runId uuid.UUID, service string) {
+	utils.GetLogFromContext(ctx).Infow("Dispatched scheduled run", "run_id", runId.String(), "service", service)
+	runDispatchedTotal.WithLabelValues(service).Inc()
+}
+
+func RunExpired(ctx context.Context, runId uuid.UUID, service string) {
+	utils.GetLogFromContext(ctx).Infow("Scheduled run expired", "run_id", runId.String(), "service", service)
+	runExpiredTotal.WithLabelValues(service).Inc()
+}
+
+func RunRetried(ctx context.Context, runId uuid.UUID, retryId uuid.UUID, attempt int, service string) {
+	utils.GetLogFromContext(ctx).Infow("Retrying finished run", "run_id", runId.String(), "retry_run_id", retryId.String(), "attempt", attempt, "service", service)
+	runRetriedTotal.WithLabelValues(service).Inc()
+}
+
+func RolloutWaveReleased(ctx context.Context, groupId uuid.UUID, wave int, waves int) {
+	utils.GetLogFromContext(ctx).Infow("Released rollout wave", "group_id", groupId.String(), "wave", wave, "waves", waves)
+	rolloutWaveReleasedTotal.Inc()
+}
+
+func RolloutHalted(ctx context.Context, groupId uuid.UUID, wave int) {
+	utils.GetLogFromContext(ctx).Warnw("Halted rollout, runs of remaining waves canceled", "group_id", groupId.String(), "wave", wave)
+	rolloutHaltedTotal.Inc()
+}
+
+func RunRecipientNotFound(ctx context.Context, runId uuid.UUID, recipient uuid.UUID) {
+	utils.GetLogFromContext(ctx).Warnw("Recipient of scheduled run not connected, run failed", "run_id", runId.String(), "recipient", recipient.String())
+	errorTotal.WithLabelValues(labelNoConnection).Inc()
+}
+
+func RunRecipientBusy(ctx context.Context, runId uuid.UUID, recipient uuid.UUID) {
+	utils.GetLogFromContext(ctx).Warnw("Recipient of scheduled run busy, run failed", "run_id", runId.String(), "recipient", recipient.String())
+	errorTotal.WithLabelValues(labelBusy).Inc()
+}
+
+func DispatchError(ctx context.Context, err error, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error dispatching scheduled run, will retry", "error", err, "run_id", runId.String())
+	errorTotal.WithLabelValues(labelDispatch).Inc()
+}
+
+func ExpireError(ctx context.Context, err error, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error expiring scheduled run", "error", err, "run_id", runId.String())
+	errorTotal.WithLabelValues(labelExpire).Inc()
+}
+
+func RetryError(ctx context.Context, err error, runId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error retrying finished run, will retry", "error", err, "run_id", runId.String())
+	errorTotal.WithLabelValues(labelRetry).Inc()
+}
+
+func RolloutError(ctx context.Context, err error, groupId uuid.UUID) {
+	utils.GetLogFromContext(ctx).Errorw("Error advancing rollout, will retry", "error", err, "group_id", groupId.String())
+	errorTotal.WithLabelValues(labelRollout).Inc()
+}
+
+func ReadError(ctx context.Context, err error) {
+	utils.GetLogFromContext(ctx).Errorw("Error reading scheduled runs", "error", err)
+	errorTotal.WithLabelValues(labelDbRead).Inc()
+}
+
+func Start() {
+	// initialize label values
+	// https://www.robustperception.io/existential-issues-with-metrics
+	errorTotal.WithLabelValues(labelDbRead)
+	errorTotal.WithLabelValues(labelDispatch)
+	errorTotal.WithLabelValues(labelNoConnection)
+	errorTotal.WithLabelValues(labelBusy)
+	errorTotal.WithLabelValues(labelExpire)
+	errorTotal.WithLabelValues(labelRetry)
+	errorTotal.WithLabelValues(labelRollout)
+}
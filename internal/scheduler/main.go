@@ -0,0 +1,78 @@
+package scheduler
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/scheduler/instrumentation"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+func Start(
+	ctx context.Context,
+	cfg *viper.Viper,
+	errors chan<- error,
+	ready, live *utils.ProbeHandler,
+	wg *sync.WaitGroup,
+) {
+	instrumentation.Start()
+
+	db, sql := db.Connect(ctx, cfg)
+	ready.Register(sql.Ping)
+	live.Register(sql.Ping)
+
+	var cloudConnectorClient connectors.CloudConnectorClient
+
+	if cfg.GetString("cloud.connector.impl") == "impl" {
+		cloudConnectorClient = connectors.NewConnectorClient(cfg)
+	} else {
+		cloudConnectorClient = connectors.NewConnectorClientMock()
+		utils.GetLogFromContext(ctx).Warn("Using mock CloudConnectorClient")
+	}
+
+	// dispatching invalidates the connection status of the recipient in a shared cache
+	cloudConnectorClient = connectors.NewConnectorClientWithCache(cfg, cloudConnectorClient)
+
+	rateLimiter := rate.NewLimiter(rate.Limit(cfg.GetInt("cloud.connector.rps")), cfg.GetInt("cloud.connector.req.bucket"))
+
+	scheduler := &scheduler{
+		db:              db,
+		dispatchManager: dispatch.NewDispatchManager(cfg, cloudConnectorClient, rateLimiter, db),
+		batchSize:       cfg.GetInt("scheduler.batch.size"),
+	}
+
+	pollInterval := time.Duration(cfg.GetInt("scheduler.poll.interval")) * time.Second
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer utils.GetLogFromContext(ctx).Debug("Scheduler stopped")
+		defer sql.Close()
+
+		ticker := time.NewTicker(pollInterval)
+		defer ticker.Stop()
+
+		for {
+			scheduler.expireRuns(ctx)
+			scheduler.retryRuns(ctx)
+			scheduler.advanceRollouts(ctx)
+
+			// keep going without waiting while there is a backlog of due runs
+			if scheduler.dispatchRuns(ctx) == scheduler.batchSize {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,169 @@
+package scheduler
+
+import (
+	"context"
+	"playbook-dispatcher/internal/api/dispatch"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/scheduler/instrumentation"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+type scheduler struct {
+	db              *gorm.DB
+	dispatchManager dispatch.DispatchManager
+
+	batchSize int
+}
+
+// dispatchRuns sends the signal for a batch of scheduled runs that are due.
+// Returns the number of runs dispatched.
+func (this *scheduler) dispatchRuns(ctx context.Context) int {
+	var runs []dbModel.Run
+
+	result := this.db.WithContext(ctx).
+		Where("status = ?", dbModel.RunStatusScheduled).
+		Where("not_before <= NOW()").
+		Where("not_after IS NULL OR not_after > NOW()").
+		// queued runs wait until the runs running on the recipient finish
+		Where("runs.concurrency_policy IS DISTINCT FROM ? OR NOT "+dispatch.RecipientBusyCondition, dbModel.ConcurrencyPolicyQueue).
+		Order("not_before").
+		Limit(this.batchSize).
+		Find(&runs)
+
+	if result.Error != nil {
+		instrumentation.ReadError(ctx, result.Error)
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	dispatched := 0
+
+	for _, run := range runs {
+		wg.Add(1)
+		go func(run dbModel.Run) {
+			defer wg.Done()
+
+			runCtx := utils.WithOrgId(ctx, run.OrgID)
+			err := this.dispatchManager.ProcessScheduledRun(runCtx, run)
+
+			switch err.(type) {
+			case nil:
+				instrumentation.RunDispatched(runCtx, run.ID, run.Service)
+				lock.Lock()
+				dispatched++
+				lock.Unlock()
+			case *dispatch.RunNotScheduledError:
+				// canceled or dispatched by another replica in the meantime
+				utils.GetLogFromContext(runCtx).Debugw("Skipping run that is no longer scheduled", "run_id", run.ID.String())
+			case *dispatch.RecipientNotFoundError:
+				instrumentation.RunRecipientNotFound(runCtx, run.ID, run.Recipient)
+			case *dispatch.RecipientBusyError:
+				if *run.ConcurrencyPolicy == dbModel.ConcurrencyPolicyReject {
+					instrumentation.RunRecipientBusy(runCtx, run.ID, run.Recipient)
+				} else {
+					// another run started on the recipient after the query
+					utils.GetLogFromContext(runCtx).Debugw("Keeping run queued as the recipient is busy", "run_id", run.ID.String())
+				}
+			default:
+				instrumentation.DispatchError(runCtx, err, run.ID)
+			}
+		}(run)
+	}
+
+	wg.Wait()
+	return dispatched
+}
+
+// expireRuns moves scheduled runs that were not dispatched before not_after to the expired status, the longest overdue first
+func (this *scheduler) expireRuns(ctx context.Context) {
+	var runs []dbModel.Run
+
+	result := this.db.WithContext(ctx).
+		Where("status = ?", dbModel.RunStatusScheduled).
+		Where("not_after <= NOW()").
+		Order("not_after").
+		Limit(this.batchSize).
+		Find(&runs)
+
+	if result.Error != nil {
+		instrumentation.ReadError(ctx, result.Error)
+		return
+	}
+
+	for _, run := range runs {
+		err := this.dispatchManager.ExpireScheduledRun(ctx, run)
+
+		switch err.(type) {
+		case nil:
+			instrumentation.RunExpired(ctx, run.ID, run.Service)
+		case *dispatch.RunNotScheduledError:
+			utils.GetLogFromContext(ctx).Debugw("Skipping run that is no longer scheduled", "run_id", run.ID.String())
+		default:
+			instrumentation.ExpireError(ctx, err, run.ID)
+		}
+	}
+}
+
+// advanceRollouts releases the next wave of rollouts whose released runs succeeded and halts those whose released runs failed
+func (this *scheduler) advanceRollouts(ctx context.Context) {
+	var rollouts []dbModel.Rollout
+
+	result := this.db.WithContext(ctx).
+		Where(dispatch.RolloutCondition).
+		Order("updated_at").
+		Limit(this.batchSize).
+		Find(&rollouts)
+
+	if result.Error != nil {
+		instrumentation.ReadError(ctx, result.Error)
+		return
+	}
+
+	for _, rollout := range rollouts {
+		updated, err := this.dispatchManager.ProcessRollout(ctx, rollout)
+
+		switch {
+		case err != nil:
+			instrumentation.RolloutError(ctx, err, rollout.GroupID)
+		case updated.Status == dbModel.RolloutStatusHalted:
+			instrumentation.RolloutHalted(ctx, rollout.GroupID, rollout.Wave)
+		case updated.Wave > rollout.Wave:
+			instrumentation.RolloutWaveReleased(ctx, rollout.GroupID, updated.Wave, updated.Waves)
+		}
+	}
+}
+
+// retryRuns creates the next attempt of finished runs that qualify for a retry according to their retry policy
+func (this *scheduler) retryRuns(ctx context.Context) {
+	var runs []dbModel.Run
+
+	result := this.db.WithContext(ctx).
+		Where(dispatch.RetryCondition).
+		Order("updated_at").
+		Limit(this.batchSize).
+		Find(&runs)
+
+	if result.Error != nil {
+		instrumentation.ReadError(ctx, result.Error)
+		return
+	}
+
+	for _, run := range runs {
+		runCtx := utils.WithOrgId(ctx, run.OrgID)
+		retryID, err := this.dispatchManager.ProcessRetry(runCtx, run)
+
+		switch err.(type) {
+		case nil:
+			instrumentation.RunRetried(runCtx, run.ID, retryID, run.Attempt+1, run.Service)
+		case *dispatch.RunAlreadyRetriedError:
+			// retried by another replica in the meantime
+			utils.GetLogFromContext(runCtx).Debugw("Skipping run that has been retried already", "run_id", run.ID.String())
+		default:
+			instrumentation.RetryError(runCtx, err, run.ID)
+		}
+	}
+}
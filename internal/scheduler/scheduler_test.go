@@ -0,0 +1,474 @@
+package scheduler
+
+import (
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/dispatch"
+	"playbook-dispatcher/internal/common/config"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	"playbook-dispatcher/internal/common/utils"
+	"playbook-dispatcher/internal/common/utils/test"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/time/rate"
+)
+
+var _ = Describe("scheduler", func() {
+	db := test.WithDatabase()
+
+	newScheduler := func() *scheduler {
+		return &scheduler{
+			db:              db(),
+			dispatchManager: dispatch.NewDispatchManager(config.Get(), connectors.NewConnectorClientMock(), rate.NewLimiter(rate.Inf, 1), db()),
+			batchSize:       100,
+		}
+	}
+
+	newScheduledRun := func(notBefore time.Time, notAfter *time.Time) dbModel.Run {
+		run := test.NewRunWithStatus("5318290", dbModel.RunStatusScheduled)
+		run.NotBefore = &notBefore
+		run.NotAfter = notAfter
+		Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+		host := test.NewRunHost(run.ID, dbModel.RunStatusScheduled, nil)
+		Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+		return run
+	}
+
+	fetchRun := func(id uuid.UUID) dbModel.Run {
+		var run dbModel.Run
+		Expect(db().First(&run, id).Error).ToNot(HaveOccurred())
+		return run
+	}
+
+	fetchHost := func(runID uuid.UUID) dbModel.RunHost {
+		var host dbModel.RunHost
+		Expect(db().Where("run_id = ?", runID).First(&host).Error).ToNot(HaveOccurred())
+		return host
+	}
+
+	Describe("dispatch", func() {
+		It("dispatches a run that is due", func() {
+			run := newScheduledRun(time.Now().Add(-time.Minute), nil)
+
+			newScheduler().dispatchRuns(test.TestContext())
+
+			result := fetchRun(run.ID)
+			Expect(result.Status).To(Equal(dbModel.RunStatusRunning))
+			Expect(result.DispatchedAt).ToNot(BeNil())
+			Expect(fetchHost(run.ID).Status).To(Equal(dbModel.RunStatusRunning))
+
+			var signal dbModel.OutboxSignal
+			Expect(db().Where("run_id = ?", run.ID).First(&signal).Error).ToNot(HaveOccurred())
+			Expect(signal.Status).To(Equal(dbModel.OutboxSignalStatusSent))
+			Expect(signal.MessageID).ToNot(BeNil())
+		})
+
+		It("does not dispatch a run that is not due yet", func() {
+			run := newScheduledRun(time.Now().Add(time.Hour), nil)
+
+			newScheduler().dispatchRuns(test.TestContext())
+
+			result := fetchRun(run.ID)
+			Expect(result.Status).To(Equal(dbModel.RunStatusScheduled))
+			Expect(result.DispatchedAt).To(BeNil())
+		})
+
+		It("leaves the signal to the outbox relay if it cannot be sent", func() {
+			run := test.NewRunWithStatus("5318290", dbModel.RunStatusScheduled)
+			run.Recipient = uuid.MustParse("b31955fb-3064-4f56-ae44-a1c488a28587")
+			run.NotBefore = utils.TimeRef(time.Now().Add(-time.Minute))
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+			newScheduler().dispatchRuns(test.TestContext())
+
+			Expect(fetchRun(run.ID).Status).To(Equal(dbModel.RunStatusRunning))
+
+			var signal dbModel.OutboxSignal
+			Expect(db().Where("run_id = ?", run.ID).First(&signal).Error).ToNot(HaveOccurred())
+			Expect(signal.Status).To(Equal(dbModel.OutboxSignalStatusPending))
+		})
+
+		It("fails the run if the recipient is not connected", func() {
+			run := test.NewRunWithStatus("5318290", dbModel.RunStatusScheduled)
+			run.Recipient = uuid.MustParse("b5fbb740-5590-45a4-8240-89192dc49199")
+			run.NotBefore = utils.TimeRef(time.Now().Add(-time.Minute))
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+			newScheduler().dispatchRuns(test.TestContext())
+
+			Expect(fetchRun(run.ID).Status).To(Equal(dbModel.RunStatusFailure))
+		})
+	})
+
+	Describe("concurrency policy", func() {
+		newQueuedRun := func(recipient uuid.UUID, policy string) dbModel.Run {
+			run := newScheduledRun(time.Now().Add(-time.Minute), nil)
+			Expect(db().Model(&run).Updates(map[string]interface{}{"recipient": recipient, "concurrency_policy": policy}).Error).ToNot(HaveOccurred())
+			run.Recipient = recipient
+			run.ConcurrencyPolicy = &policy
+			return run
+		}
+
+		newRunningRun := func(recipient uuid.UUID) dbModel.Run {
+			run := test.NewRun("5318290")
+			run.Recipient = recipient
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+			return run
+		}
+
+		It("releases a queued run once the recipient is free", func() {
+			recipient := uuid.New()
+			running := newRunningRun(recipient)
+			run := newQueuedRun(recipient, dbModel.ConcurrencyPolicyQueue)
+
+			newScheduler().dispatchRuns(test.TestContext())
+			Expect(fetchRun(run.ID).Status).To(Equal(dbModel.RunStatusScheduled))
+
+			Expect(db().Model(&running).Update("status", dbModel.RunStatusSuccess).Error).ToNot(HaveOccurred())
+
+			newScheduler().dispatchRuns(test.TestContext())
+			Expect(fetchRun(run.ID).Status).To(Equal(dbModel.RunStatusRunning))
+		})
+
+		It("releases one queued run at a time", func() {
+			recipient := uuid.New()
+			first := newQueuedRun(recipient, dbModel.ConcurrencyPolicyQueue)
+			second := newQueuedRun(recipient, dbModel.ConcurrencyPolicyQueue)
+
+			newScheduler().dispatchRuns(test.TestContext())
+
+			statuses := []string{fetchRun(first.ID).Status, fetchRun(second.ID).Status}
+			Expect(statuses).To(ConsistOf(dbModel.RunStatusRunning, dbModel.RunStatusScheduled))
+		})
+
+		It("fails a scheduled run using the reject policy if the recipient is busy", func() {
+			recipient := uuid.New()
+			newRunningRun(recipient)
+			run := newQueuedRun(recipient, dbModel.ConcurrencyPolicyReject)
+
+			newScheduler().dispatchRuns(test.TestContext())
+
+			Expect(fetchRun(run.ID).Status).To(Equal(dbModel.RunStatusFailure))
+			Expect(fetchHost(run.ID).Status).To(Equal(dbModel.RunStatusFailure))
+		})
+	})
+
+	Describe("expiration", func() {
+		It("expires a run that was not dispatched before not_after", func() {
+			run := newScheduledRun(time.Now().Add(-time.Hour), utils.TimeRef(time.Now().Add(-time.Minute)))
+
+			s := newScheduler()
+			s.expireRuns(test.TestContext())
+			s.dispatchRuns(test.TestContext())
+
+			Expect(fetchRun(run.ID).Status).To(Equal(dbModel.RunStatusExpired))
+			Expect(fetchHost(run.ID).Status).To(Equal(dbModel.RunStatusExpired))
+		})
+
+		It("expires at most a batch of runs at a time", func() {
+			first := newScheduledRun(time.Now().Add(-50*time.Hour), utils.TimeRef(time.Now().Add(-48*time.Hour)))
+			second := newScheduledRun(time.Now().Add(-50*time.Hour), utils.TimeRef(time.Now().Add(-47*time.Hour)))
+
+			s := newScheduler()
+			s.batchSize = 1
+			s.expireRuns(test.TestContext())
+
+			Expect(fetchRun(first.ID).Status).To(Equal(dbModel.RunStatusExpired))
+			Expect(fetchRun(second.ID).Status).To(Equal(dbModel.RunStatusScheduled))
+		})
+
+		It("does not expire a run before not_after", func() {
+			run := newScheduledRun(time.Now().Add(time.Hour), utils.TimeRef(time.Now().Add(2*time.Hour)))
+
+			newScheduler().expireRuns(test.TestContext())
+
+			Expect(fetchRun(run.ID).Status).To(Equal(dbModel.RunStatusScheduled))
+		})
+	})
+	Describe("retry policy", func() {
+		newFinishedRun := func(status string, attempt int, policy *dbModel.RetryPolicy) dbModel.Run {
+			run := test.NewRunWithStatus("5318290", status)
+			run.Attempt = attempt
+			run.RetryPolicy = policy
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+			host := test.NewRunHost(run.ID, status, nil)
+			Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+			return run
+		}
+
+		fetchRetries := func(parentID uuid.UUID) []dbModel.Run {
+			var runs []dbModel.Run
+			Expect(db().Where("parent_run_id = ?", parentID).Find(&runs).Error).ToNot(HaveOccurred())
+			return runs
+		}
+
+		policy := func(statuses ...string) *dbModel.RetryPolicy {
+			return &dbModel.RetryPolicy{MaxAttempts: 3, Backoff: 60, Statuses: statuses}
+		}
+
+		It("retries a run that finished with a qualifying status", func() {
+			run := newFinishedRun(dbModel.RunStatusFailure, 1, policy(dbModel.RunStatusFailure))
+
+			newScheduler().retryRuns(test.TestContext())
+
+			retries := fetchRetries(run.ID)
+			Expect(retries).To(HaveLen(1))
+			Expect(retries[0].Attempt).To(Equal(2))
+			Expect(retries[0].Status).To(Equal(dbModel.RunStatusScheduled))
+			Expect(retries[0].Recipient).To(Equal(run.Recipient))
+			Expect(retries[0].URL).To(Equal(run.URL))
+			Expect(retries[0].RetryPolicy).To(Equal(run.RetryPolicy))
+			Expect(*retries[0].NotBefore).To(BeTemporally("~", run.UpdatedAt.Add(time.Minute), time.Second))
+		})
+
+		It("doubles the backoff with each attempt", func() {
+			run := newFinishedRun(dbModel.RunStatusFailure, 2, policy(dbModel.RunStatusFailure))
+
+			newScheduler().retryRuns(test.TestContext())
+
+			retries := fetchRetries(run.ID)
+			Expect(retries).To(HaveLen(1))
+			Expect(*retries[0].NotBefore).To(BeTemporally("~", run.UpdatedAt.Add(2*time.Minute), time.Second))
+		})
+
+		It("retries a run only once", func() {
+			run := newFinishedRun(dbModel.RunStatusFailure, 1, policy(dbModel.RunStatusFailure))
+
+			newScheduler().retryRuns(test.TestContext())
+			newScheduler().retryRuns(test.TestContext())
+
+			Expect(fetchRetries(run.ID)).To(HaveLen(1))
+		})
+
+		It("does not retry a run with a status that does not qualify", func() {
+			run := newFinishedRun(dbModel.RunStatusTimeout, 1, policy(dbModel.RunStatusFailure))
+
+			newScheduler().retryRuns(test.TestContext())
+
+			Expect(fetchRetries(run.ID)).To(BeEmpty())
+		})
+
+		It("does not retry a run once max_attempts is reached", func() {
+			run := newFinishedRun(dbModel.RunStatusFailure, 3, policy(dbModel.RunStatusFailure))
+
+			newScheduler().retryRuns(test.TestContext())
+
+			Expect(fetchRetries(run.ID)).To(BeEmpty())
+		})
+
+		It("does not retry a run without a retry policy", func() {
+			run := newFinishedRun(dbModel.RunStatusFailure, 1, nil)
+
+			newScheduler().retryRuns(test.TestContext())
+
+			Expect(fetchRetries(run.ID)).To(BeEmpty())
+		})
+
+		It("limits the retry of a Satellite run to the hosts with a qualifying status", func() {
+			run := test.NewRunWithStatus("5318290", dbModel.RunStatusUnreachable)
+			run.SatId = utils.UUIDRef(uuid.New())
+			run.SatOrgId = utils.StringRef("1")
+			run.RetryPolicy = policy(dbModel.RunStatusUnreachable)
+			Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+			unreachable := test.NewRunHost(run.ID, dbModel.RunStatusUnreachable, utils.UUIDRef(uuid.New()))
+			success := test.NewRunHost(run.ID, dbModel.RunStatusSuccess, utils.UUIDRef(uuid.New()))
+			Expect(db().Create(&unreachable).Error).ToNot(HaveOccurred())
+			Expect(db().Create(&success).Error).ToNot(HaveOccurred())
+
+			newScheduler().retryRuns(test.TestContext())
+
+			retries := fetchRetries(run.ID)
+			Expect(retries).To(HaveLen(1))
+
+			var hosts []dbModel.RunHost
+			Expect(db().Where("run_id = ?", retries[0].ID).Find(&hosts).Error).ToNot(HaveOccurred())
+			Expect(hosts).To(HaveLen(1))
+			Expect(hosts[0].InventoryID).To(Equal(unreachable.InventoryID))
+		})
+	})
+
+	Describe("rollout", func() {
+		newRollout := func(rollout dbModel.Rollout, waves ...[]string) (dbModel.Rollout, [][]dbModel.Run) {
+			group := test.NewRunGroup("5318290")
+			Expect(db().Create(&group).Error).ToNot(HaveOccurred())
+
+			rollout.GroupID = group.ID
+			rollout.Status = dbModel.RolloutStatusInProgress
+			rollout.Waves = len(waves)
+			Expect(db().Create(&rollout).Error).ToNot(HaveOccurred())
+
+			runs := make([][]dbModel.Run, len(waves))
+			for wave, statuses := range waves {
+				for _, status := range statuses {
+					run := test.NewRunWithStatus("5318290", status)
+					run.GroupID = &group.ID
+					run.RolloutWave = utils.IntRef(wave)
+					Expect(db().Create(&run).Error).ToNot(HaveOccurred())
+
+					host := test.NewRunHost(run.ID, status, nil)
+					Expect(db().Create(&host).Error).ToNot(HaveOccurred())
+
+					runs[wave] = append(runs[wave], run)
+				}
+			}
+
+			return rollout, runs
+		}
+
+		fetchRollout := func(groupID uuid.UUID) dbModel.Rollout {
+			var rollout dbModel.Rollout
+			Expect(db().First(&rollout, "group_id = ?", groupID).Error).ToNot(HaveOccurred())
+			return rollout
+		}
+
+		It("releases the next wave once the released runs succeed", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 100}, []string{"success"}, []string{"scheduled", "scheduled"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			result := fetchRollout(rollout.GroupID)
+			Expect(result.Status).To(Equal(dbModel.RolloutStatusInProgress))
+			Expect(result.Wave).To(Equal(1))
+			Expect(fetchRun(runs[1][0].ID).NotBefore).ToNot(BeNil())
+			Expect(fetchRun(runs[1][1].ID).NotBefore).ToNot(BeNil())
+			Expect(fetchRun(runs[2][0].ID).NotBefore).To(BeNil())
+
+			newScheduler().dispatchRuns(test.TestContext())
+
+			Expect(fetchRun(runs[1][0].ID).Status).To(Equal(dbModel.RunStatusRunning))
+			Expect(fetchRun(runs[2][0].ID).Status).To(Equal(dbModel.RunStatusScheduled))
+		})
+
+		It("completes the rollout once the last wave is released", func() {
+			rollout, _ := newRollout(dbModel.Rollout{SuccessThreshold: 100}, []string{"success"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			result := fetchRollout(rollout.GroupID)
+			Expect(result.Status).To(Equal(dbModel.RolloutStatusCompleted))
+			Expect(result.Wave).To(Equal(1))
+		})
+
+		It("waits for the released runs to succeed", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 50, FailureThreshold: 50}, []string{"running", "running"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			Expect(fetchRollout(rollout.GroupID).Wave).To(Equal(0))
+			Expect(fetchRun(runs[1][0].ID).NotBefore).To(BeNil())
+		})
+
+		It("releases the next wave once the success threshold is reached", func() {
+			rollout, _ := newRollout(dbModel.Rollout{SuccessThreshold: 50, FailureThreshold: 50}, []string{"success", "running"}, []string{"scheduled"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			Expect(fetchRollout(rollout.GroupID).Wave).To(Equal(1))
+		})
+
+		It("pauses between waves", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 100, Pause: 600}, []string{"success"}, []string{"scheduled"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			result := fetchRollout(rollout.GroupID)
+			Expect(result.Wave).To(Equal(0))
+			Expect(*result.NextWaveAt).To(BeTemporally("~", time.Now().Add(10*time.Minute), 5*time.Second))
+			Expect(fetchRun(runs[1][0].ID).NotBefore).To(BeNil())
+
+			Expect(db().Model(&result).Update("next_wave_at", time.Now().Add(-time.Second)).Error).ToNot(HaveOccurred())
+			newScheduler().advanceRollouts(test.TestContext())
+
+			result = fetchRollout(rollout.GroupID)
+			Expect(result.Wave).To(Equal(1))
+			Expect(result.NextWaveAt).To(BeNil())
+			Expect(fetchRun(runs[1][0].ID).NotBefore).ToNot(BeNil())
+		})
+
+		It("halts the rollout once the failure threshold is exceeded", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 50, FailureThreshold: 25}, []string{"failure", "running"}, []string{"scheduled"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			result := fetchRollout(rollout.GroupID)
+			Expect(result.Status).To(Equal(dbModel.RolloutStatusHalted))
+			Expect(result.Wave).To(Equal(0))
+			Expect(fetchRun(runs[0][1].ID).Status).To(Equal(dbModel.RunStatusRunning))
+			Expect(fetchRun(runs[1][0].ID).Status).To(Equal(dbModel.RunStatusCanceled))
+			Expect(fetchHost(runs[1][0].ID).Status).To(Equal(dbModel.RunStatusCanceled))
+			Expect(fetchRun(runs[2][0].ID).Status).To(Equal(dbModel.RunStatusCanceled))
+		})
+
+		It("halts the rollout once the success threshold can no longer be reached", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 100, FailureThreshold: 100}, []string{"success", "timeout"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			Expect(fetchRollout(rollout.GroupID).Status).To(Equal(dbModel.RolloutStatusHalted))
+			Expect(fetchRun(runs[1][0].ID).Status).To(Equal(dbModel.RunStatusCanceled))
+		})
+
+		It("counts canary runs rejected when dispatched as failed", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 50, FailureThreshold: 25, Rejected: 1}, []string{"success"}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			Expect(fetchRollout(rollout.GroupID).Status).To(Equal(dbModel.RolloutStatusHalted))
+			Expect(fetchRun(runs[1][0].ID).Status).To(Equal(dbModel.RunStatusCanceled))
+		})
+
+		It("does not release the next wave if no run of the canary wave exists", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 100}, []string{}, []string{"scheduled"})
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			result := fetchRollout(rollout.GroupID)
+			Expect(result.Status).To(Equal(dbModel.RolloutStatusInProgress))
+			Expect(result.Wave).To(Equal(0))
+			Expect(fetchRun(runs[1][0].ID).NotBefore).To(BeNil())
+		})
+
+		It("does not move a pending rollout forward", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 100}, []string{"success"}, []string{"scheduled"})
+			Expect(db().Model(&rollout).Update("status", dbModel.RolloutStatusPending).Error).ToNot(HaveOccurred())
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			Expect(fetchRollout(rollout.GroupID).Status).To(Equal(dbModel.RolloutStatusPending))
+			Expect(fetchRun(runs[1][0].ID).NotBefore).To(BeNil())
+		})
+
+		It("does not count a run that is about to be retried as failed", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 100}, []string{"failure"}, []string{"scheduled"})
+			Expect(db().Model(&runs[0][0]).Update("retry_policy", &dbModel.RetryPolicy{MaxAttempts: 2, Backoff: 60, Statuses: []string{"failure"}}).Error).ToNot(HaveOccurred())
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			result := fetchRollout(rollout.GroupID)
+			Expect(result.Status).To(Equal(dbModel.RolloutStatusInProgress))
+			Expect(result.Wave).To(Equal(0))
+		})
+
+		It("only counts the latest attempt of a retried run", func() {
+			rollout, runs := newRollout(dbModel.Rollout{SuccessThreshold: 100}, []string{"failure"}, []string{"scheduled"}, []string{"scheduled"})
+
+			retry := test.NewRunWithStatus("5318290", dbModel.RunStatusSuccess)
+			retry.GroupID = &rollout.GroupID
+			retry.RolloutWave = utils.IntRef(0)
+			retry.ParentRunID = &runs[0][0].ID
+			retry.Attempt = 2
+			Expect(db().Create(&retry).Error).ToNot(HaveOccurred())
+
+			newScheduler().advanceRollouts(test.TestContext())
+
+			Expect(fetchRollout(rollout.GroupID).Wave).To(Equal(1))
+		})
+	})
+})
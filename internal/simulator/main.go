@@ -0,0 +1,85 @@
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/api/middleware"
+	"playbook-dispatcher/internal/common/constants"
+	"playbook-dispatcher/internal/common/db"
+	"playbook-dispatcher/internal/common/utils"
+	responseConsumer "playbook-dispatcher/internal/response-consumer"
+
+	"github.com/labstack/echo/v4"
+	echoMiddleware "github.com/labstack/echo/v4/middleware"
+	"github.com/redhatinsights/platform-go-middlewares/v2/request_id"
+	"github.com/spf13/viper"
+)
+
+const shutdownTimeout = 10 * time.Second
+
+// Start runs a fake Cloud Connector for local development and testing.
+// Signals sent to it are answered with simulated responses which are processed directly by the response-consumer, i.e. without Kafka, ingress and validator.
+func Start(
+	ctx context.Context,
+	cfg *viper.Viper,
+	errors chan<- error,
+	ready, live *utils.ProbeHandler,
+	wg *sync.WaitGroup,
+) {
+	log := utils.GetLogFromContext(ctx)
+
+	scenario := cfg.GetString("simulator.scenario")
+	utils.DieOnError(validateScenario(scenario))
+
+	db, sql := db.Connect(ctx, cfg)
+	ready.Register(sql.Ping)
+	live.Register(sql.Ping)
+
+	simulator := &simulator{
+		db:        db,
+		handle:    responseConsumer.NewMessageHandler(ctx, cfg, db),
+		topic:     cfg.GetString("topic.updates"),
+		scenario:  scenario,
+		delay:     time.Duration(cfg.GetInt("simulator.delay")) * time.Second,
+		slowDelay: time.Duration(cfg.GetInt("simulator.slow.delay")) * time.Second,
+		ctx:       ctx,
+	}
+
+	server := echo.New()
+	server.HideBanner = true
+	server.Debug = false
+
+	server.Use(
+		echo.WrapMiddleware(request_id.ConfiguredRequestID(constants.HeaderRequestId)),
+		middleware.ContextLogger,
+		middleware.RequestLogger,
+		echoMiddleware.Recover(),
+	)
+
+	(&cloudConnectorServer{client: simulator}).register(server)
+
+	log.Warnw("Simulating Cloud Connector", "port", cfg.GetInt("simulator.port"), "scenario", scenario)
+
+	wg.Add(1)
+	go func() {
+		errors <- server.Start(fmt.Sprintf("0.0.0.0:%d", cfg.GetInt("simulator.port")))
+	}()
+
+	go func() {
+		defer wg.Done()
+		defer log.Debug("Simulator stopped")
+		defer sql.Close()
+		<-ctx.Done()
+
+		ctx, cancel := context.WithTimeout(utils.SetLog(context.Background(), log), shutdownTimeout)
+		defer cancel()
+
+		utils.StopServer(ctx, server)
+
+		// responses being delivered need the database connection
+		simulator.pending.Wait()
+	}()
+}
@@ -0,0 +1,95 @@
+package simulator
+
+import (
+	"fmt"
+	"strings"
+
+	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+)
+
+const satEventVersion = 3
+
+// satEvents produces the events of a Playbook run as reported by Satellite (foreman_rh_cloud).
+// The events are grouped in steps: a progress update for each task and the completion of the Playbook run.
+// If responseFull is false the console output of an update only contains the output produced since the previous update of the given host.
+func satEvents(correlationID uuid.UUID, hosts []string, scenario string, responseFull bool) [][]messageModel.PlaybookSatRunResponseMessageYamlEventsElem {
+	consoles := make([]string, len(hosts))
+	failed := make([]bool, len(hosts))
+
+	steps := [][]messageModel.PlaybookSatRunResponseMessageYamlEventsElem{}
+
+	for sequence, task := range simulatedTasks {
+		events := []messageModel.PlaybookSatRunResponseMessageYamlEventsElem{}
+
+		for i, host := range hosts {
+			if failed[i] {
+				continue
+			}
+
+			output := fmt.Sprintf("TASK [%s] %s\n", task.name, strings.Repeat("*", 40))
+
+			switch {
+			case task.fails && hostFails(scenario, i):
+				failed[i] = true
+				output += fmt.Sprintf("fatal: [%s]: FAILED! => {\"changed\": false, \"msg\": \"Failed to download packages\"}\n", host)
+			case task.changed:
+				output += fmt.Sprintf("changed: [%s]\n", host)
+			default:
+				output += fmt.Sprintf("ok: [%s]\n", host)
+			}
+
+			consoles[i] += output
+			console := output
+			if responseFull {
+				console = consoles[i]
+			}
+
+			events = append(events, messageModel.PlaybookSatRunResponseMessageYamlEventsElem{
+				Type:          messageModel.PlaybookSatRunResponseMessageYamlEventsElemTypePlaybookRunUpdate,
+				Version:       satEventVersion,
+				CorrelationId: correlationID.String(),
+				Host:          utils.StringRef(host),
+				Sequence:      utils.IntRef(sequence),
+				Console:       &console,
+			})
+		}
+
+		steps = append(steps, events)
+	}
+
+	completed := messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusSuccess
+	finished := []messageModel.PlaybookSatRunResponseMessageYamlEventsElem{}
+
+	for i, host := range hosts {
+		status := messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusSuccess
+		executionCode := 0
+
+		if failed[i] {
+			status = messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusFailure
+			completed = messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusFailure
+			executionCode = 1
+		}
+
+		finished = append(finished, messageModel.PlaybookSatRunResponseMessageYamlEventsElem{
+			Type:           messageModel.PlaybookSatRunResponseMessageYamlEventsElemTypePlaybookRunFinished,
+			Version:        satEventVersion,
+			CorrelationId:  correlationID.String(),
+			Host:           utils.StringRef(host),
+			Status:         &status,
+			ConnectionCode: utils.IntRef(0),
+			ExecutionCode:  &executionCode,
+		})
+	}
+
+	finished = append(finished, messageModel.PlaybookSatRunResponseMessageYamlEventsElem{
+		Type:          messageModel.PlaybookSatRunResponseMessageYamlEventsElemTypePlaybookRunCompleted,
+		Version:       satEventVersion,
+		CorrelationId: correlationID.String(),
+		Status:        &completed,
+	})
+
+	return append(steps, finished)
+}
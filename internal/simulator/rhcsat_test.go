@@ -0,0 +1,73 @@
+package simulator
+
+import (
+	messageModel "playbook-dispatcher/internal/common/model/message"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rhcsat events", func() {
+	correlationID := uuid.New()
+	hosts := []string{"c3ac3ea6-2ab5-4b42-8a44-e8ef7ea2c9a5", "9ec3c02b-8a49-4f6f-9a0d-e0d4c4e7ae7e"}
+
+	finished := func(steps [][]messageModel.PlaybookSatRunResponseMessageYamlEventsElem) map[string]messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatus {
+		result := map[string]messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatus{}
+
+		for _, event := range steps[len(steps)-1] {
+			switch event.Type {
+			case messageModel.PlaybookSatRunResponseMessageYamlEventsElemTypePlaybookRunFinished:
+				result[*event.Host] = *event.Status
+			case messageModel.PlaybookSatRunResponseMessageYamlEventsElemTypePlaybookRunCompleted:
+				result[""] = *event.Status
+			}
+		}
+
+		return result
+	}
+
+	It("reports the progress of every task", func() {
+		steps := satEvents(correlationID, hosts, scenarioSuccess, true)
+
+		Expect(steps).To(HaveLen(len(simulatedTasks) + 1))
+		for sequence, step := range steps[:len(simulatedTasks)] {
+			Expect(step).To(HaveLen(len(hosts)))
+			for _, event := range step {
+				Expect(event.Type).To(Equal(messageModel.PlaybookSatRunResponseMessageYamlEventsElemTypePlaybookRunUpdate))
+				Expect(event.CorrelationId).To(Equal(correlationID.String()))
+				Expect(*event.Sequence).To(Equal(sequence))
+			}
+		}
+	})
+
+	It("completes a successful run", func() {
+		Expect(finished(satEvents(correlationID, hosts, scenarioSuccess, true))).To(Equal(map[string]messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatus{
+			hosts[0]: messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusSuccess,
+			hosts[1]: messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusSuccess,
+			"":       messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusSuccess,
+		}))
+	})
+
+	It("fails the run if any host fails", func() {
+		Expect(finished(satEvents(correlationID, hosts, scenarioPartial, true))).To(Equal(map[string]messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatus{
+			hosts[0]: messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusSuccess,
+			hosts[1]: messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusFailure,
+			"":       messageModel.PlaybookSatRunResponseMessageYamlEventsElemStatusFailure,
+		}))
+	})
+
+	It("reports the full console output", func() {
+		steps := satEvents(correlationID, hosts[:1], scenarioSuccess, true)
+
+		Expect(*steps[2][0].Console).To(ContainSubstring("TASK [Gathering Facts]"))
+		Expect(*steps[2][0].Console).To(ContainSubstring("TASK [Restart sshd]"))
+	})
+
+	It("reports incremental console output", func() {
+		steps := satEvents(correlationID, hosts[:1], scenarioSuccess, false)
+
+		Expect(*steps[2][0].Console).ToNot(ContainSubstring("TASK [Gathering Facts]"))
+		Expect(*steps[2][0].Console).To(ContainSubstring("TASK [Restart sshd]"))
+	})
+})
@@ -0,0 +1,179 @@
+package simulator
+
+import (
+	"fmt"
+	"strings"
+
+	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+)
+
+const (
+	simulatedPlaybook = "simulated-playbook.yml"
+	simulatedPlay     = "Simulated remediation"
+)
+
+type simulatedTask struct {
+	name    string
+	changed bool
+	// hosts that fail the Playbook fail this task
+	fails bool
+	diff  interface{}
+}
+
+var simulatedTasks = []simulatedTask{
+	{name: "Gathering Facts"},
+	{
+		name:    "Update openssl",
+		changed: true,
+		fails:   true,
+		diff:    []map[string]interface{}{{"prepared": "Upgraded: openssl-1:3.0.7-27.el9.x86_64"}},
+	},
+	{
+		name:    "Restart sshd",
+		changed: true,
+		diff:    map[string]interface{}{"before": map[string]string{"state": "started"}, "after": map[string]string{"state": "restarted"}},
+	},
+}
+
+type hostResult struct {
+	ok       int
+	changed  int
+	failures int
+}
+
+// runnerEvents produces the Ansible Runner events of a Playbook run as reported by rhc-worker-playbook.
+// The events are grouped in steps: the start of the Playbook, one step for each task and the play recap.
+// Diffs are only reported in check mode, i.e. when the Playbook runs with --check --diff.
+func runnerEvents(correlationID uuid.UUID, hosts []string, scenario string, check bool) [][]messageModel.PlaybookRunResponseMessageYamlEventsElem {
+	builder := &runnerEventBuilder{playbookUuid: uuid.New().String()}
+	results := make([]hostResult, len(hosts))
+	failed := make([]bool, len(hosts))
+
+	start := []messageModel.PlaybookRunResponseMessageYamlEventsElem{
+		builder.event("executor_on_start", "", &messageModel.PlaybookRunResponseMessageYamlEventsElemEventData{
+			CrcDispatcherCorrelationId: utils.StringRef(correlationID.String()),
+		}),
+		builder.event("playbook_on_start", "", builder.eventData(nil, nil)),
+		builder.event("playbook_on_play_start", fmt.Sprintf("\r\nPLAY [%s] %s", simulatedPlay, strings.Repeat("*", 40)), builder.eventData(nil, nil)),
+	}
+
+	steps := [][]messageModel.PlaybookRunResponseMessageYamlEventsElem{start}
+
+	for _, task := range simulatedTasks {
+		events := []messageModel.PlaybookRunResponseMessageYamlEventsElem{
+			builder.event("playbook_on_task_start", fmt.Sprintf("\r\nTASK [%s] %s", task.name, strings.Repeat("*", 40)), builder.eventData(&task.name, nil)),
+		}
+
+		for i, host := range hosts {
+			// ansible does not run any further tasks on a host that failed
+			if failed[i] {
+				continue
+			}
+
+			events = append(events, builder.event("runner_on_start", "", builder.eventData(&task.name, &host)))
+
+			if task.fails && hostFails(scenario, i) {
+				failed[i] = true
+				results[i].failures++
+
+				data := builder.eventData(&task.name, &host)
+				data.Res = &messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{Changed: false, Msg: "Failed to download packages: Cannot download Packages/openssl-3.0.7-27.el9.x86_64.rpm"}
+				events = append(events, builder.event("runner_on_failed", fmt.Sprintf("fatal: [%s]: FAILED! => {\"changed\": false, \"msg\": \"%s\"}", host, data.Res.Msg), data))
+				continue
+			}
+
+			results[i].ok++
+			data := builder.eventData(&task.name, &host)
+			data.Res = &messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRes{Changed: task.changed}
+
+			if check && task.diff != nil {
+				data.Res.Diff = task.diff
+			}
+
+			stdout := fmt.Sprintf("ok: [%s]", host)
+			if task.changed {
+				results[i].changed++
+				stdout = fmt.Sprintf("changed: [%s]", host)
+			}
+
+			events = append(events, builder.event("runner_on_ok", stdout, data))
+		}
+
+		steps = append(steps, events)
+	}
+
+	return append(steps, []messageModel.PlaybookRunResponseMessageYamlEventsElem{builder.stats(hosts, results)})
+}
+
+type runnerEventBuilder struct {
+	playbookUuid string
+	counter      int
+	line         int
+}
+
+func (this *runnerEventBuilder) eventData(task, host *string) *messageModel.PlaybookRunResponseMessageYamlEventsElemEventData {
+	duration := 0.5
+
+	data := &messageModel.PlaybookRunResponseMessageYamlEventsElemEventData{
+		Playbook:     utils.StringRef(simulatedPlaybook),
+		PlaybookUuid: &this.playbookUuid,
+		Play:         utils.StringRef(simulatedPlay),
+		Task:         task,
+		Host:         host,
+	}
+
+	if host != nil {
+		data.Duration = &duration
+	}
+
+	return data
+}
+
+func (this *runnerEventBuilder) event(event, stdout string, data *messageModel.PlaybookRunResponseMessageYamlEventsElemEventData) messageModel.PlaybookRunResponseMessageYamlEventsElem {
+	this.counter++
+	startLine := this.line
+
+	if stdout != "" {
+		this.line += strings.Count(stdout, "\n") + 1
+	}
+
+	return messageModel.PlaybookRunResponseMessageYamlEventsElem{
+		Event:     event,
+		Uuid:      uuid.New().String(),
+		Counter:   this.counter,
+		Stdout:    &stdout,
+		StartLine: startLine,
+		EndLine:   this.line,
+		EventData: data,
+	}
+}
+
+func (this *runnerEventBuilder) stats(hosts []string, results []hostResult) messageModel.PlaybookRunResponseMessageYamlEventsElem {
+	data := this.eventData(nil, nil)
+	data.Ok = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataOk{}
+	data.Changed = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataChanged{}
+	data.Failures = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataFailures{}
+	data.Dark = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataDark{}
+	data.Skipped = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataSkipped{}
+	data.Ignored = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataIgnored{}
+	data.Rescued = messageModel.PlaybookRunResponseMessageYamlEventsElemEventDataRescued{}
+
+	recap := []string{fmt.Sprintf("\r\nPLAY RECAP %s", strings.Repeat("*", 40))}
+
+	for i, host := range hosts {
+		data.Ok[host] = results[i].ok
+		data.Changed[host] = results[i].changed
+		data.Failures[host] = results[i].failures
+		data.Dark[host] = 0
+		data.Skipped[host] = 0
+		data.Ignored[host] = 0
+		data.Rescued[host] = 0
+
+		recap = append(recap, fmt.Sprintf("%s : ok=%d changed=%d unreachable=0 failed=%d skipped=0 rescued=0 ignored=0", host, results[i].ok, results[i].changed, results[i].failures))
+	}
+
+	return this.event("playbook_on_stats", strings.Join(recap, "\r\n"), data)
+}
@@ -0,0 +1,91 @@
+package simulator
+
+import (
+	"encoding/json"
+
+	"playbook-dispatcher/internal/common/ansible"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// events as received by the response-consumer
+func flatten(steps [][]messageModel.PlaybookRunResponseMessageYamlEventsElem) (events []messageModel.PlaybookRunResponseMessageYamlEventsElem) {
+	for _, step := range steps {
+		events = append(events, step...)
+	}
+
+	Expect(json.Unmarshal(utils.MustMarshal(events), &events)).To(Succeed())
+	return
+}
+
+var _ = Describe("runner events", func() {
+	correlationID := uuid.New()
+	hosts := []string{"host1", "host2"}
+
+	It("starts with the correlation id", func() {
+		steps := runnerEvents(correlationID, hosts, scenarioSuccess, false)
+
+		Expect(steps).To(HaveLen(len(simulatedTasks) + 2))
+		Expect(steps[0][0].Event).To(Equal("executor_on_start"))
+		Expect(*steps[0][0].EventData.CrcDispatcherCorrelationId).To(Equal(correlationID.String()))
+	})
+
+	It("numbers the events", func() {
+		events := flatten(runnerEvents(correlationID, hosts, scenarioSuccess, false))
+
+		for i, event := range events {
+			Expect(event.Counter).To(Equal(i + 1))
+		}
+	})
+
+	It("ends with the play recap", func() {
+		events := flatten(runnerEvents(correlationID, hosts, scenarioSuccess, false))
+
+		Expect(events[len(events)-1].Event).To(Equal("playbook_on_stats"))
+		Expect(ansible.GetAnsibleHosts(events)).To(ConsistOf(hosts))
+	})
+
+	DescribeTable("host results",
+		func(scenario string, host string, expected dbModel.RunHostStats) {
+			events := flatten(runnerEvents(correlationID, hosts, scenario, false))
+			Expect(*ansible.GetHostStats(events, host)).To(Equal(expected))
+		},
+
+		Entry("success", scenarioSuccess, "host2", dbModel.RunHostStats{Ok: 3, Changed: 2}),
+		Entry("failure", scenarioFailure, "host1", dbModel.RunHostStats{Ok: 1, Failures: 1}),
+		Entry("partial (first host)", scenarioPartial, "host1", dbModel.RunHostStats{Ok: 3, Changed: 2}),
+		Entry("partial (other hosts)", scenarioPartial, "host2", dbModel.RunHostStats{Ok: 1, Failures: 1}),
+	)
+
+	It("does not run further tasks on a failed host", func() {
+		events := flatten(runnerEvents(correlationID, hosts, scenarioFailure, false))
+
+		for _, event := range events {
+			if event.EventData.Host != nil {
+				Expect(*event.EventData.Task).ToNot(Equal("Restart sshd"))
+			}
+		}
+	})
+
+	It("reports diffs in check mode", func() {
+		events := flatten(runnerEvents(correlationID, hosts, scenarioSuccess, true))
+
+		diffs := ansible.GetHostDiffs(events, "host1")
+		Expect(diffs).To(HaveLen(2))
+		Expect(diffs[0].Task).To(Equal("Update openssl"))
+		Expect(*diffs[0].Changes[0].Prepared).To(Equal("Upgraded: openssl-1:3.0.7-27.el9.x86_64"))
+		Expect(diffs[1].Task).To(Equal("Restart sshd"))
+		Expect(diffs[1].Changes[0].After).To(Equal(map[string]interface{}{"state": "restarted"}))
+	})
+
+	It("does not report diffs outside of check mode", func() {
+		events := flatten(runnerEvents(correlationID, hosts, scenarioSuccess, false))
+		Expect(ansible.GetHostDiffs(events, "host1")).To(BeNil())
+	})
+})
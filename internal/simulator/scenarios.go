@@ -0,0 +1,64 @@
+package simulator
+
+import (
+	"fmt"
+)
+
+// scenarios describe how the simulated hosts respond to a Playbook run
+const (
+	// every host runs the Playbook successfully
+	scenarioSuccess = "success"
+	// every host fails
+	scenarioFailure = "failure"
+	// the first host succeeds while the remaining hosts fail
+	scenarioPartial = "partial"
+	// the hosts start running the Playbook but never finish
+	scenarioTimeout = "timeout"
+	// every host runs the Playbook successfully but reports progress step by step
+	scenarioSlow = "slow"
+)
+
+// run label that overrides the configured scenario for a single Playbook run
+const scenarioLabel = "simulator-scenario"
+
+var scenarios = []string{scenarioSuccess, scenarioFailure, scenarioPartial, scenarioTimeout, scenarioSlow}
+
+func validateScenario(scenario string) error {
+	for _, known := range scenarios {
+		if scenario == known {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Unknown simulator scenario: %s", scenario)
+}
+
+func hostFails(scenario string, index int) bool {
+	switch scenario {
+	case scenarioFailure:
+		return true
+	case scenarioPartial:
+		return index > 0
+	default:
+		return false
+	}
+}
+
+// deliveries returns the number of steps of a Playbook run that have been reported once each of the responses is delivered.
+// Unless the scenario is slow all the steps are reported in a single response.
+// The last step, in which the hosts finish the Playbook, is never reported in the timeout scenario.
+func deliveries(scenario string, steps int) []int {
+	switch scenario {
+	case scenarioTimeout:
+		return []int{steps - 1}
+	case scenarioSlow:
+		result := make([]int, steps)
+		for i := range result {
+			result[i] = i + 1
+		}
+
+		return result
+	default:
+		return []int{steps}
+	}
+}
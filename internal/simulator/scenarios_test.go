@@ -0,0 +1,25 @@
+package simulator
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("scenarios", func() {
+	It("rejects an unknown scenario", func() {
+		Expect(validateScenario("flaky")).To(MatchError("Unknown simulator scenario: flaky"))
+	})
+
+	DescribeTable("deliveries",
+		func(scenario string, expected []int) {
+			Expect(deliveries(scenario, 5)).To(Equal(expected))
+		},
+
+		Entry("success", scenarioSuccess, []int{5}),
+		Entry("failure", scenarioFailure, []int{5}),
+		Entry("partial", scenarioPartial, []int{5}),
+		Entry("timeout", scenarioTimeout, []int{4}),
+		Entry("slow", scenarioSlow, []int{1, 2, 3, 4, 5}),
+	)
+})
@@ -0,0 +1,72 @@
+package simulator
+
+import (
+	"net/http"
+
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/common/constants"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// cloudConnectorServer exposes the simulator using the subset of the Cloud Connector API used by playbook-dispatcher
+type cloudConnectorServer struct {
+	client connectors.CloudConnectorClient
+}
+
+func (this *cloudConnectorServer) register(server *echo.Echo) {
+	group := server.Group("/api/cloud-connector/v2/connections/:client_id")
+	group.POST("/message", this.postMessage)
+	group.GET("/status", this.getStatus)
+}
+
+func (this *cloudConnectorServer) postMessage(ctx echo.Context) error {
+	recipient, err := uuid.Parse(ctx.Param("client_id"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, echo.Map{"message": err.Error()})
+	}
+
+	var input connectors.MessageRequestV2
+	if err := ctx.Bind(&input); err != nil {
+		return err
+	}
+
+	var directive string
+	if input.Directive != nil {
+		directive = *input.Directive
+	}
+
+	var metadata map[string]string
+	if input.Metadata != nil {
+		metadata = *input.Metadata
+	}
+
+	id, notFound, err := this.client.SendCloudConnectorRequest(ctx.Request().Context(), ctx.Request().Header.Get(constants.HeaderCloudConnectorOrgID), recipient, input.Payload, directive, metadata)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, echo.Map{"message": err.Error()})
+	}
+
+	if notFound {
+		return ctx.NoContent(http.StatusNotFound)
+	}
+
+	messageID := uuid.MustParse(*id)
+	return ctx.JSON(http.StatusCreated, &connectors.MessageResponse{Id: &messageID})
+}
+
+func (this *cloudConnectorServer) getStatus(ctx echo.Context) error {
+	orgID := ctx.Request().Header.Get(constants.HeaderCloudConnectorOrgID)
+	clientID := ctx.Param("client_id")
+
+	status, err := this.client.GetConnectionStatus(ctx.Request().Context(), orgID, clientID)
+	if err != nil {
+		return err
+	}
+
+	return ctx.JSON(http.StatusOK, &connectors.ConnectionStatusResponseV2{
+		ClientId: &clientID,
+		OrgId:    &orgID,
+		Status:   &status,
+	})
+}
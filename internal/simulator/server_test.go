@@ -0,0 +1,105 @@
+package simulator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/common/constants"
+	"playbook-dispatcher/internal/common/utils"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("cloud connector server", func() {
+	var (
+		server   *echo.Echo
+		instance *simulator
+		cancel   context.CancelFunc
+	)
+
+	BeforeEach(func() {
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(utils.SetLog(context.Background(), zap.NewNop().Sugar()))
+
+		// responses are never delivered as the context is cancelled before the delay passes
+		instance = &simulator{scenario: scenarioSuccess, delay: time.Hour, ctx: ctx}
+
+		server = echo.New()
+		(&cloudConnectorServer{client: instance}).register(server)
+	})
+
+	AfterEach(func() {
+		cancel()
+		instance.pending.Wait()
+	})
+
+	send := func(recipient string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/api/cloud-connector/v2/connections/"+recipient+"/message", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(constants.HeaderCloudConnectorOrgID, "5318290")
+		req = req.WithContext(utils.SetLog(req.Context(), zap.NewNop().Sugar()))
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("accepts a runner signal", func() {
+		rec := send(uuid.New().String(), `{"directive": "rhc-worker-playbook", "payload": "http://example.com", "metadata": {"crc_dispatcher_correlation_id": "`+uuid.New().String()+`"}}`)
+
+		Expect(rec.Code).To(Equal(http.StatusCreated))
+
+		var response connectors.MessageResponse
+		Expect(json.Unmarshal(rec.Body.Bytes(), &response)).To(Succeed())
+		Expect(response.Id).ToNot(BeNil())
+	})
+
+	It("accepts a satellite signal", func() {
+		rec := send(uuid.New().String(), `{"directive": "foreman_rh_cloud", "payload": "http://example.com", "metadata": {"correlation_id": "`+uuid.New().String()+`", "hosts": "host1,host2"}}`)
+		Expect(rec.Code).To(Equal(http.StatusCreated))
+	})
+
+	It("accepts a cancel signal", func() {
+		rec := send(uuid.New().String(), `{"directive": "foreman_rh_cloud", "metadata": {"correlation_id": "`+uuid.New().String()+`", "operation": "cancel"}}`)
+		Expect(rec.Code).To(Equal(http.StatusCreated))
+	})
+
+	It("rejects an unknown directive", func() {
+		rec := send(uuid.New().String(), `{"directive": "unknown", "metadata": {}}`)
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("rejects a signal without correlation id", func() {
+		rec := send(uuid.New().String(), `{"directive": "rhc-worker-playbook", "payload": "http://example.com", "metadata": {}}`)
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("rejects an invalid recipient", func() {
+		rec := send("abc", `{"directive": "rhc-worker-playbook", "metadata": {}}`)
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("reports every recipient as connected", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/cloud-connector/v2/connections/"+uuid.New().String()+"/status", nil)
+		req.Header.Set(constants.HeaderCloudConnectorOrgID, "5318290")
+
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+
+		var response connectors.ConnectionStatusResponseV2
+		Expect(json.Unmarshal(rec.Body.Bytes(), &response)).To(Succeed())
+		Expect(*response.Status).To(Equal(connectors.Connected))
+		Expect(*response.OrgId).To(Equal("5318290"))
+	})
+})
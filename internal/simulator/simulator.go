@@ -0,0 +1,257 @@
+package simulator
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"playbook-dispatcher/internal/api/connectors"
+	"playbook-dispatcher/internal/api/dispatch/protocols"
+	"playbook-dispatcher/internal/common/constants"
+	kafkaUtils "playbook-dispatcher/internal/common/kafka"
+	dbModel "playbook-dispatcher/internal/common/model/db"
+	messageModel "playbook-dispatcher/internal/common/model/message"
+	"playbook-dispatcher/internal/common/utils"
+
+	k "github.com/confluentinc/confluent-kafka-go/v2/kafka"
+	"github.com/google/uuid"
+	"github.com/redhatinsights/platform-go-middlewares/v2/identity"
+	"gorm.io/gorm"
+)
+
+const (
+	runnerMessageHeaderValue = "playbook"
+	satMessageHeaderValue    = "playbook-sat"
+)
+
+// simulator is a fake Cloud Connector.
+// Instead of delivering the signal to a recipient it generates the responses the recipient would send back
+// and hands them over to the response-consumer.
+type simulator struct {
+	db        *gorm.DB
+	handle    func(context.Context, *k.Message)
+	topic     string
+	scenario  string
+	delay     time.Duration
+	slowDelay time.Duration
+
+	// lifecycle of the goroutines delivering the responses
+	ctx     context.Context
+	pending sync.WaitGroup
+}
+
+func (this *simulator) SendCloudConnectorRequest(
+	ctx context.Context,
+	orgID string,
+	recipient uuid.UUID,
+	url *string,
+	directive string,
+	metadata map[string]string,
+) (*string, bool, error) {
+	utils.GetLogFromContext(ctx).Debugw("Simulating Cloud Connector message",
+		"directive", directive,
+		"metadata", protocols.RedactMetaData(metadata),
+		"payload", url,
+		"recipient", recipient.String(),
+	)
+
+	var correlationIdRaw string
+
+	switch protocols.Directive(directive) {
+	case protocols.RunnerDirective:
+		correlationIdRaw = metadata["crc_dispatcher_correlation_id"]
+	case protocols.SatelliteDirective:
+		correlationIdRaw = metadata["correlation_id"]
+	default:
+		return nil, false, fmt.Errorf("Unknown directive: %s", directive)
+	}
+
+	correlationID, err := uuid.Parse(correlationIdRaw)
+	if err != nil {
+		return nil, false, err
+	}
+
+	id := uuid.New().String()
+
+	// responses to cancel requests are not simulated
+	if metadata["operation"] == "cancel" {
+		return &id, false, nil
+	}
+
+	this.pending.Add(1)
+	go func() {
+		defer this.pending.Done()
+		this.simulate(utils.WithCorrelationId(utils.WithOrgId(this.ctx, orgID), correlationID.String()), orgID, directive, correlationID, metadata)
+	}()
+
+	return &id, false, nil
+}
+
+func (this *simulator) GetConnectionStatus(
+	ctx context.Context,
+	orgID string,
+	recipient string,
+) (connectors.ConnectionStatus, error) {
+	return connectors.Connected, nil
+}
+
+func (this *simulator) simulate(ctx context.Context, orgID string, directive string, correlationID uuid.UUID, metadata map[string]string) {
+	log := utils.GetLogFromContext(ctx)
+
+	// the signal may be sent before the run is committed
+	if !this.wait(ctx, this.delay) {
+		return
+	}
+
+	run, err := this.getRun(ctx, orgID, correlationID)
+	if err != nil {
+		log.Errorw("Error fetching run from db", "error", err)
+		return
+	}
+
+	scenario := this.scenario
+	if value, ok := run.Labels[scenarioLabel]; ok {
+		if err := validateScenario(value); err != nil {
+			log.Warnw("Ignoring scenario label", "error", err)
+		} else {
+			scenario = value
+		}
+	}
+
+	log.Infow("Simulating Playbook run", "run_id", run.ID, "scenario", scenario)
+
+	var messages []*k.Message
+
+	if protocols.Directive(directive) == protocols.SatelliteDirective {
+		messages = this.satMessages(orgID, correlationID, scenario, metadata)
+	} else {
+		hosts, err := this.getHosts(ctx, run.ID)
+		if err != nil {
+			log.Errorw("Error fetching run hosts from db", "error", err)
+			return
+		}
+
+		messages = this.runnerMessages(orgID, correlationID, hosts, scenario, metadata["crc_dispatcher_mode"] == dbModel.RunModeCheck)
+	}
+
+	for i, msg := range messages {
+		if i > 0 && !this.wait(ctx, this.slowDelay) {
+			return
+		}
+
+		this.handle(ctx, msg)
+	}
+}
+
+func (this *simulator) wait(ctx context.Context, delay time.Duration) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case <-time.After(delay):
+		return true
+	}
+}
+
+func (this *simulator) getRun(ctx context.Context, orgID string, correlationID uuid.UUID) (run dbModel.Run, err error) {
+	err = this.db.WithContext(ctx).
+		Select("id", "labels").
+		Where("org_id = ?", orgID).
+		Where("correlation_id = ?", correlationID).
+		First(&run).Error
+
+	return
+}
+
+// the hosts of a run executed by rhc-worker-playbook are not part of the signal
+func (this *simulator) getHosts(ctx context.Context, runID uuid.UUID) (hosts []string, err error) {
+	err = this.db.WithContext(ctx).
+		Model(&dbModel.RunHost{}).
+		Where("run_id = ?", runID).
+		Order("host").
+		Pluck("host", &hosts).Error
+
+	if len(hosts) == 0 {
+		hosts = []string{"localhost"}
+	}
+
+	return
+}
+
+// runner responses carry all the events reported so far
+func (this *simulator) runnerMessages(orgID string, correlationID uuid.UUID, hosts []string, scenario string, check bool) []*k.Message {
+	steps := runnerEvents(correlationID, hosts, scenario, check)
+	messages := []*k.Message{}
+
+	for _, reported := range deliveries(scenario, len(steps)) {
+		events := []messageModel.PlaybookRunResponseMessageYamlEventsElem{}
+		for _, step := range steps[:reported] {
+			events = append(events, step...)
+		}
+
+		requestID := uuid.New().String()
+		messages = append(messages, this.newMessage(runnerMessageHeaderValue, correlationID, requestID, &messageModel.PlaybookRunResponseMessageYaml{
+			OrgId:           orgID,
+			B64Identity:     systemIdentity(orgID),
+			RequestId:       requestID,
+			UploadTimestamp: time.Now(),
+			Events:          events,
+		}))
+	}
+
+	return messages
+}
+
+// Satellite responses only carry the events reported since the previous response
+func (this *simulator) satMessages(orgID string, correlationID uuid.UUID, scenario string, metadata map[string]string) []*k.Message {
+	hosts := strings.Split(metadata["hosts"], ",")
+	steps := satEvents(correlationID, hosts, scenario, metadata["response_full"] != "false")
+	messages := []*k.Message{}
+
+	previous := 0
+	for _, reported := range deliveries(scenario, len(steps)) {
+		events := []messageModel.PlaybookSatRunResponseMessageYamlEventsElem{}
+		for _, step := range steps[previous:reported] {
+			events = append(events, step...)
+		}
+
+		previous = reported
+
+		requestID := uuid.New().String()
+		messages = append(messages, this.newMessage(satMessageHeaderValue, correlationID, requestID, &messageModel.PlaybookSatRunResponseMessageYaml{
+			OrgId:           orgID,
+			B64Identity:     systemIdentity(orgID),
+			RequestId:       requestID,
+			UploadTimestamp: time.Now(),
+			Events:          events,
+		}))
+	}
+
+	return messages
+}
+
+func (this *simulator) newMessage(requestType string, correlationID uuid.UUID, requestID string, value interface{}) *k.Message {
+	return &k.Message{
+		Value:   utils.MustMarshal(value),
+		Headers: kafkaUtils.Headers(constants.HeaderRequestId, requestID, constants.HeaderCorrelationId, correlationID.String(), constants.HeaderRequestType, requestType),
+		TopicPartition: k.TopicPartition{
+			Topic:     &this.topic,
+			Partition: 0,
+			Offset:    k.OffsetInvalid,
+		},
+	}
+}
+
+func systemIdentity(orgID string) string {
+	value := identity.XRHID{
+		Identity: identity.Identity{
+			OrgID:    orgID,
+			Type:     "System",
+			Internal: identity.Internal{OrgID: orgID},
+		},
+	}
+
+	return base64.StdEncoding.EncodeToString(utils.MustMarshal(value))
+}
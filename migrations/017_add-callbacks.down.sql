@@ -0,0 +1,6 @@
+DROP TABLE callback_deliveries;
+DROP TYPE callback_deliveries_status;
+
+ALTER TABLE runs
+    DROP COLUMN callback_url,
+    DROP COLUMN callback_secret_ref;
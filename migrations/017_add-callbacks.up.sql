@@ -0,0 +1,24 @@
+ALTER TABLE runs
+    ADD COLUMN callback_url varchar,
+    ADD COLUMN callback_secret_ref varchar;
+
+CREATE TYPE callback_deliveries_status AS ENUM('pending', 'delivered', 'failed');
+
+CREATE TABLE callback_deliveries (
+    id uuid PRIMARY KEY,
+    run_id uuid NOT NULL REFERENCES runs ON DELETE CASCADE,
+
+    url varchar NOT NULL,
+    secret_ref varchar NOT NULL,
+    payload jsonb NOT NULL,
+
+    status callback_deliveries_status NOT NULL default 'pending',
+    attempts integer NOT NULL default 0,
+    next_attempt_at timestamptz NOT NULL,
+    last_error varchar,
+
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE INDEX callback_deliveries_pending_index ON callback_deliveries (next_attempt_at) WHERE status = 'pending';
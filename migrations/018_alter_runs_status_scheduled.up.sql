@@ -0,0 +1,2 @@
+ALTER TYPE runs_status ADD VALUE 'scheduled';
+ALTER TYPE runs_status ADD VALUE 'expired';
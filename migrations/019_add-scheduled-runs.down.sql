@@ -0,0 +1,6 @@
+DROP INDEX runs_scheduled_index;
+
+ALTER TABLE runs
+    DROP COLUMN not_before,
+    DROP COLUMN not_after,
+    DROP COLUMN dispatched_at;
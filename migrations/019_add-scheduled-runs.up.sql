@@ -0,0 +1,6 @@
+ALTER TABLE runs
+    ADD COLUMN not_before timestamptz,
+    ADD COLUMN not_after timestamptz,
+    ADD COLUMN dispatched_at timestamptz;
+
+CREATE INDEX runs_scheduled_index ON runs (not_before) WHERE status = 'scheduled';
@@ -0,0 +1,2 @@
+DROP TABLE outbox_signals;
+DROP TYPE outbox_signals_status;
@@ -0,0 +1,23 @@
+CREATE TYPE outbox_signals_status AS ENUM('pending', 'sent', 'failed');
+
+CREATE TABLE outbox_signals (
+    id uuid PRIMARY KEY,
+    run_id uuid NOT NULL REFERENCES runs ON DELETE CASCADE,
+
+    org_id varchar NOT NULL,
+    recipient uuid NOT NULL,
+    directive varchar NOT NULL,
+    url varchar NOT NULL,
+    metadata jsonb NOT NULL,
+
+    status outbox_signals_status NOT NULL default 'pending',
+    attempts integer NOT NULL default 0,
+    next_attempt_at timestamptz NOT NULL,
+    message_id varchar,
+    last_error varchar,
+
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE INDEX outbox_signals_pending_index ON outbox_signals (next_attempt_at) WHERE status = 'pending';
@@ -0,0 +1,4 @@
+DROP INDEX runs_idempotency_key_index;
+
+ALTER TABLE runs
+    DROP COLUMN idempotency_key;
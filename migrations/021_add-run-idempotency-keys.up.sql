@@ -0,0 +1,4 @@
+ALTER TABLE runs
+    ADD COLUMN idempotency_key text;
+
+CREATE UNIQUE INDEX runs_idempotency_key_index ON runs (org_id, service, idempotency_key) WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE runs
+    DROP COLUMN concurrency_policy;
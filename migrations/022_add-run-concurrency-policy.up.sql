@@ -0,0 +1,2 @@
+ALTER TABLE runs
+    ADD COLUMN concurrency_policy varchar(16);
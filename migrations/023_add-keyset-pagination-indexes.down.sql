@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_org_id_created_at_index;
@@ -0,0 +1,2 @@
+ALTER TABLE run_hosts
+    ADD COLUMN stats jsonb;
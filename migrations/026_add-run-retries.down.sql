@@ -0,0 +1,7 @@
+DROP INDEX runs_retry_policy_index;
+DROP INDEX runs_parent_run_id_index;
+
+ALTER TABLE runs
+    DROP COLUMN retry_policy,
+    DROP COLUMN attempt,
+    DROP COLUMN parent_run_id;
@@ -0,0 +1,7 @@
+ALTER TABLE runs
+    ADD COLUMN parent_run_id uuid REFERENCES runs (id) ON DELETE SET NULL,
+    ADD COLUMN attempt integer NOT NULL DEFAULT 1,
+    ADD COLUMN retry_policy jsonb;
+
+CREATE UNIQUE INDEX runs_parent_run_id_index ON runs (parent_run_id) WHERE parent_run_id IS NOT NULL;
+CREATE INDEX runs_retry_policy_index ON runs (updated_at) WHERE retry_policy IS NOT NULL;
@@ -0,0 +1,5 @@
+DROP INDEX runs_group_id_index;
+
+ALTER TABLE runs DROP COLUMN group_id;
+
+DROP TABLE run_groups;
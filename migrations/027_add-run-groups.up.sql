@@ -0,0 +1,15 @@
+CREATE TABLE run_groups (
+    id uuid PRIMARY KEY,
+
+    org_id varchar NOT NULL,
+    service varchar NOT NULL,
+
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE INDEX run_groups_org_id_created_at_index ON run_groups (org_id, created_at, id);
+
+ALTER TABLE runs ADD COLUMN group_id uuid REFERENCES run_groups (id) ON DELETE SET NULL;
+
+CREATE INDEX runs_group_id_index ON runs (group_id) WHERE group_id IS NOT NULL;
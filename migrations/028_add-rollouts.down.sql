@@ -0,0 +1,5 @@
+ALTER TABLE runs DROP COLUMN rollout_wave;
+
+DROP INDEX rollouts_in_progress_index;
+
+DROP TABLE rollouts;
@@ -0,0 +1,20 @@
+CREATE TABLE rollouts (
+    group_id uuid PRIMARY KEY REFERENCES run_groups (id) ON DELETE CASCADE,
+
+    wave_size integer NOT NULL,
+    pause integer NOT NULL,
+    success_threshold integer NOT NULL,
+    failure_threshold integer NOT NULL,
+
+    status varchar NOT NULL,
+    wave integer NOT NULL DEFAULT 0,
+    waves integer NOT NULL,
+    next_wave_at timestamptz,
+
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE INDEX rollouts_in_progress_index ON rollouts (updated_at) WHERE status = 'in_progress';
+
+ALTER TABLE runs ADD COLUMN rollout_wave integer;
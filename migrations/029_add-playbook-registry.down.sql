@@ -0,0 +1,3 @@
+ALTER TABLE runs DROP COLUMN playbook_digest;
+
+DROP TABLE playbooks;
@@ -0,0 +1,13 @@
+CREATE TABLE playbooks (
+    org_id varchar NOT NULL,
+    digest varchar NOT NULL,
+
+    content bytea NOT NULL,
+    signature varchar NOT NULL,
+
+    created_at timestamptz NOT NULL,
+
+    PRIMARY KEY (org_id, digest)
+);
+
+ALTER TABLE runs ADD COLUMN playbook_digest varchar;
@@ -0,0 +1 @@
+ALTER TABLE runs ADD COLUMN extra_vars jsonb;
@@ -0,0 +1,5 @@
+ALTER TABLE run_hosts
+    DROP COLUMN diffs;
+
+ALTER TABLE runs
+    DROP COLUMN mode;
@@ -0,0 +1,5 @@
+ALTER TABLE runs
+    ADD COLUMN mode varchar NOT NULL DEFAULT 'run';
+
+ALTER TABLE run_hosts
+    ADD COLUMN diffs jsonb;
@@ -0,0 +1,2 @@
+ALTER TABLE runs
+    ADD COLUMN idempotency_hash text;
@@ -0,0 +1,2 @@
+ALTER TABLE rollouts
+    DROP COLUMN rejected;
@@ -0,0 +1,2 @@
+ALTER TABLE rollouts
+    ADD COLUMN rejected integer NOT NULL DEFAULT 0;
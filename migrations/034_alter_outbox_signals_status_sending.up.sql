@@ -0,0 +1 @@
+ALTER TYPE outbox_signals_status ADD VALUE 'sending';
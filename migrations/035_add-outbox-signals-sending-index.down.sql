@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS outbox_signals_sending_index;
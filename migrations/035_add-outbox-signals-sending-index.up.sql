@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS outbox_signals_sending_index ON outbox_signals (next_attempt_at) WHERE status = 'sending';
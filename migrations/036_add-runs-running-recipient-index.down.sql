@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS runs_running_recipient_index;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS runs_running_recipient_index ON runs (recipient) WHERE status = 'running';
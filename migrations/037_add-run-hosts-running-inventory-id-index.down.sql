@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS run_hosts_running_inventory_id_index;
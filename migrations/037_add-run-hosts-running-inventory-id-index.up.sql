@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS run_hosts_running_inventory_id_index ON run_hosts (inventory_id) WHERE status = 'running';
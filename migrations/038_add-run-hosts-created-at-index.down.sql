@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS run_hosts_created_at_index;
@@ -0,0 +1 @@
+CREATE INDEX CONCURRENTLY IF NOT EXISTS run_hosts_created_at_index ON run_hosts (created_at, id);